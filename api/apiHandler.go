@@ -99,6 +99,21 @@ func initBaseGroupsWithFacade(facade data.FacadeHandler) (map[string]data.GroupH
 		return nil, err
 	}
 
+	usernamesGroup, err := groups.NewUsernamesGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensGroup, err := groups.NewTokensGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	utilsGroup, err := groups.NewUtilsGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]data.GroupHandler{
 		"/actions":     actionsGroup,
 		"/address":     accountsGroup,
@@ -114,6 +129,9 @@ func initBaseGroupsWithFacade(facade data.FacadeHandler) (map[string]data.GroupH
 		"/vm-values":   vmValuesGroup,
 		"/proof":       proofGroup,
 		"/about":       aboutGroup,
+		"/usernames":   usernamesGroup,
+		"/tokens":      tokensGroup,
+		"/utils":       utilsGroup,
 	}, nil
 }
 
@@ -8,6 +8,9 @@ import (
 // ErrGetAccount signals an error in fetching an account
 var ErrGetAccount = errors.New("cannot get account")
 
+// ErrGetAccountStateDiff signals an error in computing how an account changed between two blocks
+var ErrGetAccountStateDiff = errors.New("cannot get account state diff")
+
 // ErrGetValueForKey signals an error in getting the value of a key for an account
 var ErrGetValueForKey = errors.New("get value for key error")
 
@@ -146,6 +149,160 @@ var ErrOperationNotAllowed = errors.New("operation not allowed")
 // ErrIsDataTrieMigrated signals that an error occurred while trying to verify the migration status of the data trie
 var ErrIsDataTrieMigrated = errors.New("could not verify the migration status of the data trie")
 
+// ErrGetNextNonce signals an error in fetching the next nonce of an address
+var ErrGetNextNonce = errors.New("get next nonce error")
+
+// ErrInvalidWaitForOption signals that an invalid wait target was provided
+var ErrInvalidWaitForOption = errors.New("invalid waitFor option, expected executed or completed")
+
+// ErrGetTransactionsHistory signals an error in fetching the transactions history of an address
+var ErrGetTransactionsHistory = errors.New("get transactions history error")
+
+// ErrTransactionsHistoryNotEnabled signals that no Elasticsearch cluster was configured for serving transactions history
+var ErrTransactionsHistoryNotEnabled = errors.New("transactions history not enabled")
+
+// ErrInvalidPagination signals that invalid pagination parameters were provided
+var ErrInvalidPagination = errors.New("invalid pagination parameters")
+
+// ErrInvalidSortOrder signals that an invalid sort order was provided
+var ErrInvalidSortOrder = errors.New("invalid sort order")
+
+// ErrInvalidTimeFilter signals that invalid time filter parameters were provided
+var ErrInvalidTimeFilter = errors.New("invalid time filter")
+
+// ErrTransactionsTrackingNotEnabled signals that the tracking of the sent transactions is not enabled
+var ErrTransactionsTrackingNotEnabled = errors.New("transactions tracking not enabled")
+
+// ErrAddressWatchNotEnabled signals that the watching of addresses activity is not enabled
+var ErrAddressWatchNotEnabled = errors.New("address watch not enabled")
+
+// ErrInvalidAddressWatchRequest signals that an invalid address watch request was provided
+var ErrInvalidAddressWatchRequest = errors.New("invalid address watch request")
+
+// ErrTooManyAddressWatchSubscriptions signals that the maximum number of address watch subscriptions was reached
+var ErrTooManyAddressWatchSubscriptions = errors.New("too many address watch subscriptions")
+
+// ErrTransactionNotTracked signals that the requested transaction is not tracked
+var ErrTransactionNotTracked = errors.New("transaction not tracked")
+
+// ErrInvalidMempoolCursor signals that an invalid or expired mempool cursor was provided
+var ErrInvalidMempoolCursor = errors.New("invalid or expired mempool cursor")
+
+// ErrMempoolCursorQueryMismatch signals that a mempool cursor was provided with other filters or sort order than the
+// ones of the page it was returned with
+var ErrMempoolCursorQueryMismatch = errors.New("the mempool cursor was returned for other filters or sort order")
+
+// ErrInvalidKeyValuePairsFilter signals that invalid key-value pairs filter parameters were provided
+var ErrInvalidKeyValuePairsFilter = errors.New("invalid key-value pairs filter")
+
+// ErrInvalidMempoolFilter signals that invalid mempool filter parameters were provided
+var ErrInvalidMempoolFilter = errors.New("invalid mempool filter")
+
+// ErrIdempotencyKeyReused signals that an idempotency key was provided again, but for different transactions
+var ErrIdempotencyKeyReused = errors.New("idempotency key already used for different transactions")
+
+// ErrIdempotentRequestInProgress signals that a request with the same idempotency key is still being processed
+var ErrIdempotentRequestInProgress = errors.New("a request with the same idempotency key is in progress")
+
+// ErrTransactionSendInProgress signals that the same transaction is still being sent by another request
+var ErrTransactionSendInProgress = errors.New("the same transaction is being sent by another request")
+
+// ErrOrderedTransactionsSendFailed signals an error while sending the transactions of a batch in order
+var ErrOrderedTransactionsSendFailed = errors.New("ordered transactions sending failed")
+
+// ErrEmptyTransactionsBundle signals that a bundle without transactions was provided
+var ErrEmptyTransactionsBundle = errors.New("empty transactions bundle")
+
+// ErrInvalidWaitTimeout signals that an invalid wait timeout was provided
+var ErrInvalidWaitTimeout = errors.New("invalid timeout for waiting the transaction")
+
+// ErrInvalidBroadcastObservers signals that an invalid number of observers to broadcast the transaction to was provided
+var ErrInvalidBroadcastObservers = errors.New("invalid number of observers to broadcast the transaction to")
+
+// ErrBroadcastWithWaitFor signals that both the broadcast and the wait options were provided for the same transaction
+var ErrBroadcastWithWaitFor = errors.New("the broadcast option cannot be combined with the waitFor option")
+
+// ErrInvalidChainID signals that a transaction with an invalid chain ID was provided
+var ErrInvalidChainID = errors.New("invalid chain ID")
+
+// ErrInvalidTransactionVersion signals that a transaction with an invalid version was provided
+var ErrInvalidTransactionVersion = errors.New("invalid transaction version")
+
+// ErrInvalidTransactionOptions signals that a transaction with invalid options was provided
+var ErrInvalidTransactionOptions = errors.New("invalid transaction options")
+
+// ErrInsufficientGasPrice signals that a transaction with a gas price lower than the minimum was provided
+var ErrInsufficientGasPrice = errors.New("insufficient gas price")
+
+// ErrInsufficientGasLimit signals that a transaction with a gas limit lower than the minimum was provided
+var ErrInsufficientGasLimit = errors.New("insufficient gas limit")
+
+// ErrInvalidFeeRequest signals that an invalid fee computation request was provided
+var ErrInvalidFeeRequest = errors.New("invalid fee request")
+
+// ErrInvalidAccountsBulkRequest signals that an invalid bulk accounts request was provided
+var ErrInvalidAccountsBulkRequest = errors.New("invalid bulk accounts request")
+
+// ErrInvalidBlockNoncesRange signals that the provided block nonces do not form a valid range
+var ErrInvalidBlockNoncesRange = errors.New("fromNonce and toNonce must be provided and fromNonce must be lower than toNonce")
+
+// ErrInvalidBuildRequest signals that an invalid transaction build request was provided
+var ErrInvalidBuildRequest = errors.New("invalid transaction build request")
+
+// ErrInvalidSignature signals that a transaction with an invalid signature was provided
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// ErrInvalidGuardianSignature signals that a transaction with an invalid guardian signature was provided
+var ErrInvalidGuardianSignature = errors.New("invalid guardian signature")
+
+// ErrInvalidRelayerSignature signals that a transaction with an invalid relayer signature was provided
+var ErrInvalidRelayerSignature = errors.New("invalid relayer signature")
+
+// ErrInvalidRelayerAddress signals that a wrong format for relayer address was provided
+var ErrInvalidRelayerAddress = errors.New("invalid relayer address")
+
+// ErrResolveUsername signals an error in resolving the address of a username
+var ErrResolveUsername = errors.New("cannot resolve username")
+
+// ErrUsernameNotFound signals that the requested username is not registered
+var ErrUsernameNotFound = errors.New("username not found")
+
+// ErrInvalidUsernamesRequest signals that an invalid usernames resolution request was provided
+var ErrInvalidUsernamesRequest = errors.New("invalid usernames request")
+
+// ErrGetTokenProfile signals an error in fetching the profile of a token
+var ErrGetTokenProfile = errors.New("cannot get token profile")
+
+// ErrInvalidTokenIdentifier signals that an invalid token identifier was provided
+var ErrInvalidTokenIdentifier = errors.New("invalid token identifier")
+
+// ErrTokenNotFound signals that the requested token is not registered
+var ErrTokenNotFound = errors.New("token not found")
+
+// ErrTooManyNonceReservations signals that the address reached the maximum number of reserved nonces
+var ErrTooManyNonceReservations = errors.New("too many nonces reserved for the address")
+
+// ErrNonceReservationNotFound signals that no reservation of the nonce was found for the provided token
+var ErrNonceReservationNotFound = errors.New("no reservation of the nonce was found for the provided token")
+
+// ErrReleaseNonceReservation signals an error in releasing a nonce reservation
+var ErrReleaseNonceReservation = errors.New("release nonce reservation error")
+
+// ErrEmptyReservationToken signals that an empty reservation token has been provided
+var ErrEmptyReservationToken = errors.New("empty reservation token")
+
+// ErrGetStakingPosition signals an error in fetching the staking position of an address
+var ErrGetStakingPosition = errors.New("cannot get staking position")
+
+// ErrInvalidAddress signals that an invalid address was provided
+var ErrInvalidAddress = errors.New("invalid address")
+
+// ErrInvalidAddressesBatch signals that an invalid batch of addresses was provided
+var ErrInvalidAddressesBatch = errors.New("invalid addresses batch")
+
+// ErrAddressUtils signals an error in computing the details of an address
+var ErrAddressUtils = errors.New("cannot compute address details")
+
 // ErrInvalidTxFields signals that one or more field of a transaction are invalid
 type ErrInvalidTxFields struct {
 	Message string
@@ -156,3 +313,22 @@ type ErrInvalidTxFields struct {
 func (eitx *ErrInvalidTxFields) Error() string {
 	return fmt.Sprintf("%s : %s", eitx.Message, eitx.Reason)
 }
+
+// NewErrInvalidTxFields creates a new ErrInvalidTxFields error
+func NewErrInvalidTxFields(message string, reason string) error {
+	return &ErrInvalidTxFields{
+		Message: message,
+		Reason:  reason,
+	}
+}
+
+// ErrInvalidRequest signals that the parameters of a request which does not carry a transaction are invalid
+type ErrInvalidRequest struct {
+	Message string
+	Reason  string
+}
+
+// Error returns the string message of the ErrInvalidRequest custom error struct
+func (eir *ErrInvalidRequest) Error() string {
+	return fmt.Sprintf("%s : %s", eir.Message, eir.Reason)
+}
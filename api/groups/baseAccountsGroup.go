@@ -1,15 +1,28 @@
 package groups
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
+const (
+	ndjsonContentType          = "application/x-ndjson"
+	keyValuePairsFlushInterval = 100
+
+	addressWatchKeepAliveInterval = 15 * time.Second
+	addressWatchSubscribedEvent   = "subscribed"
+	addressWatchActivityEvent     = "activity"
+	addressWatchClosedEvent       = "closed"
+)
+
 type accountsGroup struct {
 	facade AccountsFacadeHandler
 	*baseGroup
@@ -32,9 +45,15 @@ func NewAccountsGroup(facadeHandler data.FacadeHandler) (*accountsGroup, error)
 		{Path: "/:address/balance", Handler: ag.getBalance, Method: http.MethodGet},
 		{Path: "/:address/username", Handler: ag.getUsername, Method: http.MethodGet},
 		{Path: "/:address/nonce", Handler: ag.getNonce, Method: http.MethodGet},
+		{Path: "/:address/next-nonce", Handler: ag.getNextNonce, Method: http.MethodGet},
+		{Path: "/:address/next-nonce/reservation", Handler: ag.reserveNextNonce, Method: http.MethodPost},
+		{Path: "/:address/next-nonce/reservation/:nonce", Handler: ag.releaseNonceReservation, Method: http.MethodDelete},
+		{Path: "/:address/transactions", Handler: ag.getTransactions, Method: http.MethodGet},
 		{Path: "/:address/shard", Handler: ag.getShard, Method: http.MethodGet},
 		{Path: "/:address/code-hash", Handler: ag.getCodeHash, Method: http.MethodGet},
 		{Path: "/:address/keys", Handler: ag.getKeyValuePairs, Method: http.MethodGet},
+		{Path: "/:address/keys/stream", Handler: ag.streamKeyValuePairs, Method: http.MethodGet},
+		{Path: "/:address/keys/page", Handler: ag.getKeyValuePairsPage, Method: http.MethodGet},
 		{Path: "/:address/key/:key", Handler: ag.getValueForKey, Method: http.MethodGet},
 		{Path: "/:address/esdt", Handler: ag.getESDTTokens, Method: http.MethodGet},
 		{Path: "/:address/esdt/:tokenIdentifier", Handler: ag.getESDTTokenData, Method: http.MethodGet},
@@ -44,7 +63,12 @@ func NewAccountsGroup(facadeHandler data.FacadeHandler) (*accountsGroup, error)
 		{Path: "/:address/nft/:tokenIdentifier/nonce/:nonce", Handler: ag.getESDTNftTokenData, Method: http.MethodGet},
 		{Path: "/:address/guardian-data", Handler: ag.getGuardianData, Method: http.MethodGet},
 		{Path: "/:address/is-data-trie-migrated", Handler: ag.isDataTrieMigrated, Method: http.MethodGet},
+		{Path: "/:address/diff", Handler: ag.getAccountStateDiff, Method: http.MethodGet},
+		{Path: "/:address/staking", Handler: ag.getStakingPosition, Method: http.MethodGet},
 		{Path: "/bulk", Handler: ag.getAccounts, Method: http.MethodPost},
+		{Path: "/bulk/detailed", Handler: ag.getAccountsBulk, Method: http.MethodPost},
+		{Path: "/watch", Handler: ag.watchAddresses, Method: http.MethodGet},
+		{Path: "/watch", Handler: ag.watchAddresses, Method: http.MethodPost},
 	}
 	ag.baseGroup.endpoints = baseRoutesHandlers
 
@@ -99,6 +123,155 @@ func (group *accountsGroup) getNonce(c *gin.Context) {
 	})
 }
 
+// getAccountStateDiff returns how the account changed between the fromNonce and toNonce blocks
+func (group *accountsGroup) getAccountStateDiff(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountStateDiff, errors.ErrEmptyAddress)
+		return
+	}
+
+	fromNonce, err := parseUint64UrlParam(c, common.UrlParameterFromNonce)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+	toNonce, err := parseUint64UrlParam(c, common.UrlParameterToNonce)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+	if !fromNonce.HasValue || !toNonce.HasValue || fromNonce.Value >= toNonce.Value {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, errors.ErrInvalidBlockNoncesRange)
+		return
+	}
+
+	diff, err := group.facade.GetAccountStateDiff(addr, fromNonce.Value, toNonce.Value)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetAccountStateDiff, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"diff": diff}, "", data.ReturnCodeSuccess)
+}
+
+// getStakingPosition returns the direct stake, the nodes and the delegations of the address parameter
+func (group *accountsGroup) getStakingPosition(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetStakingPosition, errors.ErrEmptyAddress)
+		return
+	}
+
+	position, err := group.facade.GetStakingPosition(addr)
+	if err != nil {
+		_, isInvalidRequest := err.(*errors.ErrInvalidRequest)
+		if isInvalidRequest {
+			shared.RespondWithBadRequest(c, err.Error())
+			return
+		}
+
+		shared.RespondWithInternalError(c, errors.ErrGetStakingPosition, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"staking": position}, "", data.ReturnCodeSuccess)
+}
+
+// getNextNonce returns the recommended nonce for the next transaction of the address parameter, without reserving it
+func (group *accountsGroup) getNextNonce(c *gin.Context) {
+	group.respondWithNextNonce(c, false)
+}
+
+// reserveNextNonce reserves for a short period the recommended nonce for the next transaction of the address parameter
+func (group *accountsGroup) reserveNextNonce(c *gin.Context) {
+	group.respondWithNextNonce(c, true)
+}
+
+func (group *accountsGroup) respondWithNextNonce(c *gin.Context, reserve bool) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetNextNonce, errors.ErrEmptyAddress)
+		return
+	}
+
+	options := common.NextNonceOptions{
+		Reserve:          reserve,
+		ReservationToken: parseStringUrlParam(c, common.UrlParameterReservationToken),
+	}
+	nextNonce, err := group.facade.GetNextNonce(addr, options)
+	if err == errors.ErrTooManyNonceReservations {
+		shared.RespondWith(c, http.StatusTooManyRequests, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetNextNonce, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, nextNonce, "", data.ReturnCodeSuccess)
+}
+
+// releaseNonceReservation releases a nonce previously reserved for the address parameter
+func (group *accountsGroup) releaseNonceReservation(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrReleaseNonceReservation, errors.ErrEmptyAddress)
+		return
+	}
+
+	nonce, err := shared.FetchNonceFromRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrReleaseNonceReservation, errors.ErrCannotParseNonce)
+		return
+	}
+
+	token := parseStringUrlParam(c, common.UrlParameterReservationToken)
+	if token == "" {
+		shared.RespondWithValidationError(c, errors.ErrReleaseNonceReservation, errors.ErrEmptyReservationToken)
+		return
+	}
+
+	err = group.facade.ReleaseNonceReservation(addr, nonce, token)
+	if err == errors.ErrNonceReservationNotFound {
+		shared.RespondWith(c, http.StatusNotFound, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrReleaseNonceReservation, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"released": true}, "", data.ReturnCodeSuccess)
+}
+
+// getTransactions returns the transactions history of the address parameter, fetched from the database
+func (group *accountsGroup) getTransactions(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetTransactionsHistory, errors.ErrEmptyAddress)
+		return
+	}
+
+	options, err := parseTransactionsHistoryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	transactions, err := group.facade.GetTransactions(addr, options)
+	if err == errors.ErrTransactionsHistoryNotEnabled {
+		shared.RespondWithBadRequest(c, err.Error())
+		return
+	}
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetTransactionsHistory, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"transactions": transactions}, "", data.ReturnCodeSuccess)
+}
+
 // getCodeHash returns the code hash for the address parameter
 func (group *accountsGroup) getCodeHash(c *gin.Context) {
 	address := c.Param("address")
@@ -146,6 +319,105 @@ func (group *accountsGroup) getAccounts(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
 }
 
+// getAccountsBulk will handle the request for a bulk of accounts, each one at its own block, together with the
+// balances of the requested tokens
+func (group *accountsGroup) getAccountsBulk(c *gin.Context) {
+	request := data.AccountsBulkRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrInvalidAccountsBulkRequest, err)
+		return
+	}
+
+	addr := ""
+	if len(request.Accounts) > 0 && request.Accounts[0] != nil {
+		addr = request.Accounts[0].Address
+	}
+
+	options, err := parseAccountQueryOptions(c, addr)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrInvalidFields, err)
+		return
+	}
+
+	response, err := group.facade.GetAccountsBulk(&request, options)
+	if err != nil {
+		_, isInvalidRequest := err.(*errors.ErrInvalidRequest)
+		if isInvalidRequest {
+			shared.RespondWithBadRequest(c, err.Error())
+			return
+		}
+
+		shared.RespondWithInternalError(c, errors.ErrCannotGetAddresses, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
+}
+
+// watchAddresses streams, as server-sent events, the activity of the requested addresses in the new hyperblocks. The
+// addresses and tokens are read from the JSON body of a POST request or from the URL parameters of a GET request
+func (group *accountsGroup) watchAddresses(c *gin.Context) {
+	request := &data.AddressWatchRequest{
+		Addresses: parseStringListUrlParam(c, common.UrlParameterAddresses),
+		Tokens:    parseStringListUrlParam(c, common.UrlParameterTokensFilter),
+	}
+	if c.Request.Method == http.MethodPost {
+		request = &data.AddressWatchRequest{}
+		err := c.ShouldBindJSON(request)
+		if err != nil {
+			shared.RespondWithValidationError(c, errors.ErrInvalidAddressWatchRequest, err)
+			return
+		}
+	}
+
+	subscription, err := group.facade.SubscribeToAddressActivity(request)
+	if err != nil {
+		respondWithAddressWatchError(c, err)
+		return
+	}
+	defer group.facade.UnsubscribeFromAddressActivity(subscription.ID)
+
+	c.SSEvent(addressWatchSubscribedEvent, gin.H{"subscription": subscription.ID})
+	c.Writer.Flush()
+
+	keepAliveTicker := time.NewTicker(addressWatchKeepAliveInterval)
+	defer keepAliveTicker.Stop()
+
+	for {
+		select {
+		case event, isOpen := <-subscription.Events:
+			if !isOpen {
+				c.SSEvent(addressWatchClosedEvent, gin.H{"subscription": subscription.ID})
+				c.Writer.Flush()
+				return
+			}
+			c.SSEvent(addressWatchActivityEvent, event)
+		case <-keepAliveTicker.C:
+			// comment lines keep the idle connection open through proxies and load balancers
+			_, _ = c.Writer.WriteString(": keep-alive\n\n")
+		case <-c.Request.Context().Done():
+			return
+		}
+
+		c.Writer.Flush()
+	}
+}
+
+func respondWithAddressWatchError(c *gin.Context, err error) {
+	_, isInvalidRequest := err.(*errors.ErrInvalidRequest)
+	if isInvalidRequest || err == errors.ErrAddressWatchNotEnabled {
+		shared.RespondWithBadRequest(c, err.Error())
+		return
+	}
+	if err == errors.ErrTooManyAddressWatchSubscriptions {
+		shared.RespondWith(c, http.StatusTooManyRequests, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+}
+
 // getKeyValuePairs returns the key-value pairs for the address parameter
 func (group *accountsGroup) getKeyValuePairs(c *gin.Context) {
 	addr := c.Param("address")
@@ -169,6 +441,96 @@ func (group *accountsGroup) getKeyValuePairs(c *gin.Context) {
 	c.JSON(http.StatusOK, keyValuePairs)
 }
 
+// streamKeyValuePairs writes the key-value pairs of the address parameter as newline delimited JSON, while they are
+// read from the observer. An error occurring after the stream has started is written as the last line
+func (group *accountsGroup) streamKeyValuePairs(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetKeyValuePairs, errors.ErrEmptyAddress)
+		return
+	}
+
+	options, err := parseAccountQueryOptions(c, addr)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetKeyValuePairs, err)
+		return
+	}
+
+	filter, err := parseKeyValuePairsQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	streamStarted := false
+	numPairs := 0
+	encoder := json.NewEncoder(c.Writer)
+	startStream := func() {
+		c.Header("Content-Type", ndjsonContentType)
+		c.Status(http.StatusOK)
+		c.Writer.WriteHeaderNow()
+		streamStarted = true
+	}
+	err = group.facade.StreamKeyValuePairs(addr, options, filter, func(pair *data.KeyValuePair) error {
+		if !streamStarted {
+			startStream()
+		}
+
+		errEncode := encoder.Encode(pair)
+		if errEncode != nil {
+			return errEncode
+		}
+
+		numPairs++
+		if numPairs%keyValuePairsFlushInterval == 0 {
+			c.Writer.Flush()
+		}
+
+		return nil
+	})
+	if err != nil && !streamStarted {
+		shared.RespondWithInternalError(c, errors.ErrGetKeyValuePairs, err)
+		return
+	}
+	if !streamStarted {
+		startStream()
+	}
+	if err != nil {
+		_ = encoder.Encode(gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), err.Error())})
+	}
+
+	c.Writer.Flush()
+}
+
+// getKeyValuePairsPage returns a page of the key-value pairs of the address parameter, sorted by key
+func (group *accountsGroup) getKeyValuePairsPage(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetKeyValuePairs, errors.ErrEmptyAddress)
+		return
+	}
+
+	options, err := parseAccountQueryOptions(c, addr)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetKeyValuePairs, err)
+		return
+	}
+
+	filter, err := parseKeyValuePairsPageOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	page, err := group.facade.GetKeyValuePairsPage(addr, options, filter)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetKeyValuePairs, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, page, "", data.ReturnCodeSuccess)
+}
+
 // getValueForKey returns the value for the given address and key
 func (group *accountsGroup) getValueForKey(c *gin.Context) {
 	addr := c.Param("address")
@@ -350,6 +712,22 @@ func (group *accountsGroup) getESDTNftTokenData(c *gin.Context) {
 		return
 	}
 
+	withDecodedData, err := parseBoolUrlParam(c, common.UrlParameterWithDecodedData)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetESDTTokenData, err)
+		return
+	}
+	if withDecodedData {
+		decodedTokenData, errDecode := group.facade.GetDecodedESDTNftTokenData(addr, tokenIdentifier, nonce, options)
+		if errDecode != nil {
+			shared.RespondWithInternalError(c, errors.ErrGetESDTTokenData, errDecode)
+			return
+		}
+
+		shared.RespondWith(c, http.StatusOK, decodedTokenData, "", data.ReturnCodeSuccess)
+		return
+	}
+
 	esdtTokenResponse, err := group.facade.GetESDTNftTokenData(addr, tokenIdentifier, nonce, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetESDTTokenData, err)
@@ -394,6 +772,23 @@ func (group *accountsGroup) getESDTTokens(c *gin.Context) {
 		shared.RespondWithValidationError(c, errors.ErrGetESDTTokenData, err)
 		return
 	}
+
+	withDecodedData, err := parseBoolUrlParam(c, common.UrlParameterWithDecodedData)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetESDTTokenData, err)
+		return
+	}
+	if withDecodedData {
+		decodedTokens, errDecode := group.facade.GetDecodedAllESDTTokens(addr, options)
+		if errDecode != nil {
+			shared.RespondWithInternalError(c, errors.ErrGetESDTTokenData, errDecode)
+			return
+		}
+
+		shared.RespondWith(c, http.StatusOK, decodedTokens, "", data.ReturnCodeSuccess)
+		return
+	}
+
 	tokens, err := group.facade.GetAllESDTTokens(addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetESDTTokenData, err)
@@ -56,6 +56,34 @@ type accountsResponse struct {
 	Data accountsResponseData `json:"data"`
 }
 
+type accountsBulkResponse struct {
+	GeneralResponse
+	Data data.AccountsBulkModel `json:"data"`
+}
+
+type accountStateDiffResponseData struct {
+	Diff *data.AccountStateDiff `json:"diff"`
+}
+
+type accountStateDiffResponse struct {
+	GeneralResponse
+	Data accountStateDiffResponseData `json:"data"`
+}
+
+type stakingPositionResponseData struct {
+	Staking *data.StakingPosition `json:"staking"`
+}
+
+type stakingPositionResponse struct {
+	GeneralResponse
+	Data stakingPositionResponseData `json:"data"`
+}
+
+type keyValuePairsPageResponse struct {
+	GeneralResponse
+	Data data.KeyValuePairsPage `json:"data"`
+}
+
 type usernameResponseData struct {
 	Username string `json:"username"`
 }
@@ -339,6 +367,87 @@ func TestGetAccounts_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, accountsResponse.Error)
 }
 
+func TestGetAccountsBulk(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("POST", "/address/bulk/detailed", bytes.NewBuffer([]byte(`{"accounts":"erd1alice"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidAccountsBulkRequest.Error())
+	})
+	t.Run("invalid entries should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountsBulkHandler: func(request *data.AccountsBulkRequest, options common.AccountQueryOptions) (*data.AccountsBulkModel, error) {
+				return nil, &apiErrors.ErrInvalidRequest{Message: apiErrors.ErrInvalidAccountsBulkRequest.Error(), Reason: "reason"}
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		body := `{"accounts":[{"address":"erd1alice","blockNonce":10,"blockHash":"aa"}]}`
+		req, _ := http.NewRequest("POST", "/address/bulk/detailed", bytes.NewBuffer([]byte(body)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidAccountsBulkRequest.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		accounts := map[string]*data.AccountBulkModel{
+			"erd1alice": {
+				Account: &data.Account{Address: "erd1alice", Balance: "100"},
+				Tokens:  map[string]string{"TKN-123456": "5", "NFT-abcdef-01": "0"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetAccountsBulkHandler: func(request *data.AccountsBulkRequest, options common.AccountQueryOptions) (*data.AccountsBulkModel, error) {
+				require.Len(t, request.Accounts, 1)
+				require.Equal(t, "erd1alice", request.Accounts[0].Address)
+				require.Equal(t, uint64(10), *request.Accounts[0].BlockNonce)
+				require.Equal(t, []string{"TKN-123456", "NFT-abcdef-01"}, request.Tokens)
+				require.True(t, options.OnFinalBlock)
+				return &data.AccountsBulkModel{Accounts: accounts}, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		body := `{"accounts":[{"address":"erd1alice","blockNonce":10}],"tokens":["TKN-123456","NFT-abcdef-01"]}`
+		req, _ := http.NewRequest("POST", "/address/bulk/detailed?onFinalBlock=true", bytes.NewBuffer([]byte(body)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := accountsBulkResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, accounts, response.Data.Accounts)
+		assert.Empty(t, response.Error)
+	})
+}
+
 //------- GetBalance
 
 func TestGetBalance_ReturnsSuccessfully(t *testing.T) {
@@ -440,6 +549,335 @@ func TestGetNonce_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, nonceResponse.Error)
 }
 
+type nextNonceResponse struct {
+	GeneralResponse
+	Data data.NextNonceResponseData `json:"data"`
+}
+
+func TestGetNextNonce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("get should not reserve", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetNextNonceHandler: func(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error) {
+				assert.False(t, options.Reserve)
+				return &data.NextNonceResponseData{Nonce: 5, AccountNonce: 5}, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/next-nonce?reserve=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := nextNonceResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.False(t, response.Data.Reserved)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetNextNonceHandler: func(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/next-nonce", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := nextNonceResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("too many reservations should return too many requests", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetNextNonceHandler: func(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error) {
+				return nil, apiErrors.ErrTooManyNonceReservations
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("POST", "/address/test/next-nonce/reservation", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	})
+	t.Run("reservation should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedResponse := data.NextNonceResponseData{
+			Nonce:                7,
+			AccountNonce:         5,
+			NonceGaps:            []data.NonceGap{{From: 6, To: 6}},
+			Reserved:             true,
+			ReservationExpiresAt: 1000,
+			ReservationToken:     "token",
+		}
+		facade := &mock.FacadeStub{
+			GetNextNonceHandler: func(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error) {
+				assert.Equal(t, "test", address)
+				assert.True(t, options.Reserve)
+				assert.Equal(t, "token", options.ReservationToken)
+				return &expectedResponse, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("POST", "/address/test/next-nonce/reservation?reservationToken=token", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := nextNonceResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedResponse, response.Data)
+	})
+}
+
+func TestReleaseNonceReservation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("DELETE", "/address/test/next-nonce/reservation/abc?reservationToken=token", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrCannotParseNonce.Error()))
+	})
+	t.Run("missing token should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("DELETE", "/address/test/next-nonce/reservation/5", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrEmptyReservationToken.Error()))
+	})
+	t.Run("unknown reservation should return not found", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ReleaseNonceReservationHandler: func(address string, nonce uint64, token string) error {
+				return apiErrors.ErrNonceReservationNotFound
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("DELETE", "/address/test/next-nonce/reservation/5?reservationToken=token", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := &mock.FacadeStub{
+			ReleaseNonceReservationHandler: func(address string, nonce uint64, token string) error {
+				wasCalled = true
+				assert.Equal(t, "test", address)
+				assert.Equal(t, uint64(5), nonce)
+				assert.Equal(t, "token", token)
+				return nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("DELETE", "/address/test/next-nonce/reservation/5?reservationToken=token", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+}
+
+type transactionsHistoryResponse struct {
+	GeneralResponse
+	Data struct {
+		Transactions []data.DatabaseTransaction `json:"transactions"`
+	} `json:"data"`
+}
+
+func TestGetTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid url params should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		invalidQueries := []string{
+			"size=0",
+			"size=101",
+			"from=9990&size=20",
+			"from=-1",
+			"order=random",
+			"after=yesterday",
+			"after=20&before=10",
+		}
+		for _, query := range invalidQueries {
+			req, _ := http.NewRequest("GET", "/address/test/transactions?"+query, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := transactionsHistoryResponse{}
+			loadResponse(resp.Body, &response)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()), query)
+		}
+	})
+	t.Run("disabled history should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsHandler: func(address string, options common.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+				return nil, apiErrors.ErrTransactionsHistoryNotEnabled
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/transactions", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionsHistoryResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrTransactionsHistoryNotEnabled.Error(), response.Error)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetTransactionsHandler: func(address string, options common.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/transactions", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionsHistoryResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTxs := []data.DatabaseTransaction{{Hash: "hash1", Fee: "100"}, {Hash: "hash2", Fee: "200"}}
+		facade := &mock.FacadeStub{
+			GetTransactionsHandler: func(address string, options common.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+				assert.Equal(t, "test", address)
+				assert.Equal(t, common.TransactionsHistoryOptions{
+					From:     20,
+					Size:     10,
+					Order:    common.OrderAscending,
+					Sender:   "sender",
+					Receiver: "receiver",
+					After:    100,
+					Before:   200,
+				}, options)
+				return expectedTxs, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		url := "/address/test/transactions?from=20&size=10&order=asc&sender=sender&receiver=receiver&after=100&before=200"
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionsHistoryResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedTxs, response.Data.Transactions)
+	})
+	t.Run("default options", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsHandler: func(address string, options common.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+				assert.Equal(t, common.TransactionsHistoryOptions{
+					Size:  common.DefaultTransactionsHistorySize,
+					Order: common.OrderDescending,
+				}, options)
+				return make([]data.DatabaseTransaction, 0), nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/transactions", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+}
+
 // ---- GetShard
 
 func TestGetShard_FailWhenFacadeErrors(t *testing.T) {
@@ -547,6 +985,77 @@ func TestGetESDTTokens_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, shardResponse.Error)
 }
 
+func TestGetESDTTokens_WithDecodedData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid parameter should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/esdt?withDecodedData=maybe", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetDecodedAllESDTTokensCalled: func(_ string, _ common.AccountQueryOptions) (*data.ESDTTokensMetadataResponseData, error) {
+				return nil, errors.New("invalid token data")
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/esdt?withDecodedData=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetESDTTokenData.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedResponseData := data.ESDTTokensMetadataResponseData{
+			Tokens: map[string]*data.ESDTTokenMetadata{
+				"TKN-123456":    {Identifier: "TKN-123456", Collection: "TKN-123456", Balance: "1000"},
+				"NFT-abcdef-0a": {Identifier: "NFT-abcdef-0a", Collection: "NFT-abcdef", Nonce: 10, Balance: "1", IsHashValid: true},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetDecodedAllESDTTokensCalled: func(_ string, _ common.AccountQueryOptions) (*data.ESDTTokensMetadataResponseData, error) {
+				return &expectedResponseData, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/esdt?withDecodedData=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := struct {
+			Data  data.ESDTTokensMetadataResponseData `json:"data"`
+			Error string                              `json:"error"`
+		}{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedResponseData, response.Data)
+	})
+}
+
 // ---- GetGuardianData
 
 func TestGetGuardianData(t *testing.T) {
@@ -800,6 +1309,52 @@ func TestGetESDTNftTokenData_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, response.Error)
 }
 
+func TestGetESDTNftTokenData_WithDecodedData(t *testing.T) {
+	t.Parallel()
+
+	expectedResponseData := data.ESDTTokenMetadataResponseData{
+		TokenData: &data.ESDTTokenMetadata{
+			Identifier: "NFT-abcdef-0a",
+			Collection: "NFT-abcdef",
+			Nonce:      10,
+			Balance:    "1",
+			Royalties:  750,
+			URIs:       []string{"https://ipfs.io/ipfs/Qm/10.png"},
+			Attributes: &data.ESDTTokenAttributes{Raw: []byte("tags:art"), Text: "tags:art", Tags: []string{"art"}},
+		},
+		BlockInfo: data.BlockInfo{Nonce: 7},
+	}
+	facade := &mock.FacadeStub{
+		GetESDTNftTokenDataCalled: func(_ string, _ string, _ uint64, _ common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+			require.Fail(t, "the raw token data should not be requested")
+			return nil, nil
+		},
+		GetDecodedESDTNftTokenDataCalled: func(address string, key string, nonce uint64, _ common.AccountQueryOptions) (*data.ESDTTokenMetadataResponseData, error) {
+			require.Equal(t, "test", address)
+			require.Equal(t, "NFT-abcdef", key)
+			require.Equal(t, uint64(10), nonce)
+			return &expectedResponseData, nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest("GET", "/address/test/nft/NFT-abcdef/nonce/10?withDecodedData=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data  data.ESDTTokenMetadataResponseData `json:"data"`
+		Error string                             `json:"error"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedResponseData, response.Data)
+	assert.Empty(t, response.Error)
+}
+
 // ---- GetESDTsWithRole
 
 func TestGetESDTsWithRole_FailWhenFacadeErrors(t *testing.T) {
@@ -1083,3 +1638,378 @@ func TestAccountsGroup_IsDataTrieMigrated(t *testing.T) {
 		assert.Empty(t, actualResponse.Error)
 	})
 }
+
+func TestAccountsGroup_GetAccountStateDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid nonces range should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		for _, query := range []string{"", "?fromNonce=10", "?fromNonce=10&toNonce=10", "?fromNonce=20&toNonce=10", "?fromNonce=a&toNonce=10"} {
+			req, _ := http.NewRequest("GET", "/address/test/diff"+query, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := data.GenericAPIResponse{}
+			loadResponse(resp.Body, &response)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+		}
+	})
+	t.Run("should return error when facade returns error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("internal err")
+		facade := &mock.FacadeStub{
+			GetAccountStateDiffHandler: func(address string, fromNonce uint64, toNonce uint64) (*data.AccountStateDiff, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/diff?fromNonce=10&toNonce=20", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should return successfully", func(t *testing.T) {
+		t.Parallel()
+
+		expectedDiff := &data.AccountStateDiff{
+			Address:       "test",
+			FromBlock:     data.BlockInfo{Nonce: 10},
+			ToBlock:       data.BlockInfo{Nonce: 20},
+			BalanceDelta:  "-5",
+			NonceDelta:    1,
+			TokensAdded:   []*data.TokenBalanceDiff{{Identifier: "TKN-123456", ToBalance: "3", Delta: "3"}},
+			TokensRemoved: []*data.TokenBalanceDiff{},
+			TokensChanged: []*data.TokenBalanceDiff{},
+			StorageChanged: []*data.StorageKeyDiff{
+				{Key: "aa", FromValue: "01", ToValue: "02"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetAccountStateDiffHandler: func(address string, fromNonce uint64, toNonce uint64) (*data.AccountStateDiff, error) {
+				require.Equal(t, "test", address)
+				require.Equal(t, uint64(10), fromNonce)
+				require.Equal(t, uint64(20), toNonce)
+				return expectedDiff, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/diff?fromNonce=10&toNonce=20", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := accountStateDiffResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedDiff, response.Data.Diff)
+	})
+}
+
+func TestAccountsGroup_GetStakingPosition(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetStakingPositionHandler: func(address string) (*data.StakingPosition, error) {
+				return nil, &apiErrors.ErrInvalidRequest{Message: apiErrors.ErrInvalidAddress.Error(), Reason: "invalid checksum"}
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/staking", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidAddress.Error())
+	})
+	t.Run("should return error when facade returns error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("internal err")
+		facade := &mock.FacadeStub{
+			GetStakingPositionHandler: func(address string) (*data.StakingPosition, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/staking", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetStakingPosition.Error())
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should return successfully", func(t *testing.T) {
+		t.Parallel()
+
+		expectedPosition := &data.StakingPosition{
+			Address: "test",
+			DirectStake: &data.DirectStakePosition{
+				TotalStaked: "2500",
+				TopUp:       "0",
+				Unstaked:    []*data.UnstakedAmount{},
+				Nodes:       []*data.StakedNode{{BLSKey: "aa", Status: "staked"}},
+			},
+			Delegations: []*data.DelegationPosition{
+				{Provider: "provider", ActiveStake: "10", ClaimableRewards: "1"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetStakingPositionHandler: func(address string) (*data.StakingPosition, error) {
+				require.Equal(t, "test", address)
+				return expectedPosition, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/staking", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := stakingPositionResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedPosition, response.Data.Staking)
+	})
+}
+
+func TestAccountsGroup_StreamKeyValuePairs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid filter should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/keys/stream?prefix=zz", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidKeyValuePairsFilter.Error())
+	})
+	t.Run("error before the stream started should respond with internal error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("observers offline")
+		facade := &mock.FacadeStub{
+			StreamKeyValuePairsHandler: func(address string, options common.AccountQueryOptions, filter common.KeyValuePairsQueryOptions, handler func(pair *data.KeyValuePair) error) error {
+				return expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/keys/stream", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should write the pairs as newline delimited JSON", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			StreamKeyValuePairsHandler: func(address string, options common.AccountQueryOptions, filter common.KeyValuePairsQueryOptions, handler func(pair *data.KeyValuePair) error) error {
+				require.Equal(t, common.KeyValuePairsQueryOptions{Prefix: "aa", Cursor: "aa01"}, filter)
+				require.Equal(t, uint64(37), options.BlockNonce.Value)
+
+				_ = handler(&data.KeyValuePair{Key: "aa02", Value: "02"})
+				_ = handler(&data.KeyValuePair{Key: "aa03", Value: "03"})
+				return errors.New("observer disconnected")
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/keys/stream?prefix=AA&cursor=aa01&blockNonce=37", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "application/x-ndjson", resp.Header().Get("Content-Type"))
+		lines := strings.Split(strings.TrimSpace(resp.Body.String()), "\n")
+		require.Len(t, lines, 3)
+		assert.Equal(t, `{"key":"aa02","value":"02"}`, lines[0])
+		assert.Equal(t, `{"key":"aa03","value":"03"}`, lines[1])
+		assert.Contains(t, lines[2], "observer disconnected")
+	})
+}
+
+func TestAccountsGroup_GetKeyValuePairsPage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid size should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/address/test/keys/page?size=%d", common.MaxKeyValuePairsPageSize+1), nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidPagination.Error())
+	})
+	t.Run("should return the page", func(t *testing.T) {
+		t.Parallel()
+
+		expectedPage := &data.KeyValuePairsPage{
+			Pairs:      []*data.KeyValuePair{{Key: "aa01", Value: "01"}},
+			BlockInfo:  data.BlockInfo{Nonce: 37},
+			NextCursor: "aa01",
+		}
+		facade := &mock.FacadeStub{
+			GetKeyValuePairsPageHandler: func(address string, options common.AccountQueryOptions, filter common.KeyValuePairsQueryOptions) (*data.KeyValuePairsPage, error) {
+				require.Equal(t, common.KeyValuePairsQueryOptions{Prefix: "a", Size: common.DefaultKeyValuePairsPageSize}, filter)
+				return expectedPage, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/keys/page?prefix=a", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := keyValuePairsPageResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedPage, &response.Data)
+	})
+}
+
+func TestAccountsGroup_WatchAddresses(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("POST", "/address/watch", bytes.NewBufferString("not a json"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidAddressWatchRequest.Error())
+	})
+	t.Run("too many subscriptions should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SubscribeToAddressActivityHandler: func(request *data.AddressWatchRequest) (*data.AddressWatchSubscription, error) {
+				return nil, apiErrors.ErrTooManyAddressWatchSubscriptions
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/watch?addresses=erd1a", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+		assert.Equal(t, apiErrors.ErrTooManyAddressWatchSubscriptions.Error(), response.Error)
+	})
+	t.Run("should stream the events until the subscription ends", func(t *testing.T) {
+		t.Parallel()
+
+		events := make(chan *data.AddressActivityEvent, 1)
+		events <- &data.AddressActivityEvent{Address: "erd1a", HyperblockNonce: 10, Transactions: []string{"txHash"}}
+		close(events)
+
+		unsubscribedID := ""
+		facade := &mock.FacadeStub{
+			SubscribeToAddressActivityHandler: func(request *data.AddressWatchRequest) (*data.AddressWatchSubscription, error) {
+				require.Equal(t, &data.AddressWatchRequest{Addresses: []string{"erd1a", "erd1b"}, Tokens: []string{"TKN-123456"}}, request)
+				return &data.AddressWatchSubscription{ID: "7", Events: events}, nil
+			},
+			UnsubscribeFromAddressActivityHandler: func(subscriptionID string) {
+				unsubscribedID = subscriptionID
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/watch?addresses=erd1a,erd1b&tokens=TKN-123456", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "text/event-stream", resp.Header().Get("Content-Type"))
+		assert.Equal(t, "7", unsubscribedID)
+
+		body := resp.Body.String()
+		subscribedIndex := strings.Index(body, "event:subscribed\ndata:{\"subscription\":\"7\"}")
+		activityIndex := strings.Index(body, "event:activity\ndata:{\"address\":\"erd1a\",\"hyperblockNonce\":10")
+		closedIndex := strings.Index(body, "event:closed\n")
+		assert.True(t, subscribedIndex >= 0 && subscribedIndex < activityIndex && activityIndex < closedIndex, body)
+	})
+}
@@ -75,6 +75,10 @@ func (group *blockGroup) byHashHandler(c *gin.Context) {
 		return
 	}
 
+	if options.WithDecodedData {
+		blockByHashResponse.Data.DecodedData = decodeBlockTransactions(group.facade, &blockByHashResponse.Data.Block)
+	}
+
 	c.JSON(http.StatusOK, blockByHashResponse)
 }
 
@@ -116,6 +120,10 @@ func (group *blockGroup) byNonceHandler(c *gin.Context) {
 		return
 	}
 
+	if options.WithDecodedData {
+		blockByNonceResponse.Data.DecodedData = decodeBlockTransactions(group.facade, &blockByNonceResponse.Data.Block)
+	}
+
 	c.JSON(http.StatusOK, blockByNonceResponse)
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
@@ -267,6 +268,52 @@ func TestGetBlockByHash_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, apiResp.Error)
 }
 
+func TestGetBlockByHash_WithDecodedDataShouldWork(t *testing.T) {
+	t.Parallel()
+
+	decodedTransfer := &data.DecodedTransactionData{Operation: "ESDTTransfer"}
+	facade := &mock.FacadeStub{
+		GetBlockByHashCalled: func(_ uint32, _ string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+			require.True(t, options.WithDecodedData)
+
+			return &data.BlockApiResponse{
+				Data: data.BlockApiResponsePayload{Block: api.Block{
+					MiniBlocks: []*api.MiniBlock{
+						{
+							Transactions: []*transaction.ApiTransactionResult{
+								{Hash: "tx1", Data: []byte("ESDTTransfer@aa@01")},
+								{Hash: "tx2"},
+							},
+						},
+					},
+				}},
+			}, nil
+		},
+		DecodeTransactionDataCalled: func(dataField []byte, _ string, _ string) *data.DecodedTransactionData {
+			if len(dataField) == 0 {
+				return nil
+			}
+
+			return decodedTransfer
+		},
+	}
+
+	blockGroup, err := groups.NewBlockGroup(facade)
+	require.NoError(t, err)
+
+	ws := startProxyServer(blockGroup, blockPath)
+
+	req, _ := http.NewRequest("GET", "/block/0/by-hash/aaaa?withTxs=true&withDecodedData=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	apiResp := data.BlockApiResponse{}
+	loadResponse(resp.Body, &apiResp)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, map[string]*data.DecodedTransactionData{"tx1": decodedTransfer}, apiResp.Data.DecodedData)
+}
+
 func getAlteredAccounts(t *testing.T, ws *gin.Engine, url string, expectedRespCode int) *data.AlteredAccountsApiResponse {
 	req, _ := http.NewRequest("GET", url, nil)
 	resp := httptest.NewRecorder()
@@ -57,6 +57,10 @@ func (group *hyperBlockGroup) hyperBlockByHashHandler(c *gin.Context) {
 		return
 	}
 
+	if options.WithDecodedData {
+		blockByHashResponse.Data.DecodedData = decodeHyperblockTransactions(group.facade, &blockByHashResponse.Data.Hyperblock)
+	}
+
 	c.JSON(http.StatusOK, blockByHashResponse)
 }
 
@@ -80,5 +84,9 @@ func (group *hyperBlockGroup) hyperBlockByNonceHandler(c *gin.Context) {
 		return
 	}
 
+	if options.WithDecodedData {
+		blockByNonceResponse.Data.DecodedData = decodeHyperblockTransactions(group.facade, &blockByNonceResponse.Data.Hyperblock)
+	}
+
 	c.JSON(http.StatusOK, blockByNonceResponse)
 }
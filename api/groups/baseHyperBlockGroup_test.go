@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
 	"github.com/multiversx/mx-chain-proxy-go/common"
@@ -95,6 +96,39 @@ func TestGetHyperblockByNonce(t *testing.T) {
 	require.Equal(t, "invalid block hash parameter", response.Error)
 }
 
+func TestGetHyperblockByNonce_WithDecodedData(t *testing.T) {
+	decodedCall := &data.DecodedTransactionData{Operation: "scCall", Function: "claim"}
+	facade := &mock.FacadeStub{
+		GetHyperBlockByNonceCalled: func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+			require.True(t, options.WithDecodedData)
+
+			return data.NewHyperblockApiResponse(api.Hyperblock{
+				Nonce: nonce,
+				Transactions: []*transaction.ApiTransactionResult{
+					{Hash: "tx1", Data: []byte("claim"), Sender: "sender", Receiver: "receiver"},
+				},
+			}), nil
+		},
+		DecodeTransactionDataCalled: func(dataField []byte, sender string, receiver string) *data.DecodedTransactionData {
+			require.Equal(t, []byte("claim"), dataField)
+			require.Equal(t, "sender", sender)
+			require.Equal(t, "receiver", receiver)
+
+			return decodedCall
+		},
+	}
+
+	response := data.HyperblockApiResponse{}
+	statusCode := doGet(t, facade, "/hyperblock/by-nonce/42?withDecodedData=true", &response)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, map[string]*data.DecodedTransactionData{"tx1": decodedCall}, response.Data.DecodedData)
+
+	// Bad parameter
+	response = data.HyperblockApiResponse{}
+	statusCode = doGet(t, facade, "/hyperblock/by-nonce/42?withDecodedData=maybe", &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+}
+
 func doGet(t *testing.T, facade interface{}, url string, response interface{}) int {
 	hyperBlockGroup, err := groups.NewHyperBlockGroup(facade)
 	require.NoError(t, err)
@@ -0,0 +1,60 @@
+package groups
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type tokensGroup struct {
+	facade TokensFacadeHandler
+	*baseGroup
+}
+
+// NewTokensGroup returns a new instance of tokensGroup
+func NewTokensGroup(facadeHandler data.FacadeHandler) (*tokensGroup, error) {
+	facade, ok := facadeHandler.(TokensFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	tg := &tokensGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/:identifier", Handler: tg.getTokenProfile, Method: http.MethodGet},
+	}
+	tg.baseGroup.endpoints = baseRoutesHandlers
+
+	return tg, nil
+}
+
+// getTokenProfile returns the supply, the properties and the special roles of the token identifier parameter
+func (group *tokensGroup) getTokenProfile(c *gin.Context) {
+	profile, err := group.facade.GetTokenProfile(c.Param("identifier"))
+	if err != nil {
+		respondWithTokensError(c, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"token": profile}, "", data.ReturnCodeSuccess)
+}
+
+func respondWithTokensError(c *gin.Context, err error) {
+	_, isInvalidRequest := err.(*errors.ErrInvalidRequest)
+	if isInvalidRequest {
+		shared.RespondWithBadRequest(c, err.Error())
+		return
+	}
+	if err == errors.ErrTokenNotFound {
+		shared.RespondWith(c, http.StatusNotFound, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	shared.RespondWithInternalError(c, errors.ErrGetTokenProfile, err)
+}
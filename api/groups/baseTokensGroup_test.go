@@ -0,0 +1,155 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tokensPath = "/tokens"
+
+type tokenProfileResponseData struct {
+	Token data.ESDTTokenProfile `json:"token"`
+}
+
+type tokenProfileResponse struct {
+	Data  tokenProfileResponseData `json:"data"`
+	Error string                   `json:"error"`
+	Code  string                   `json:"code"`
+}
+
+func TestNewTokensGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wrong facade, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		wrongFacade := &mock.WrongFacade{}
+		group, err := groups.NewTokensGroup(wrongFacade)
+		require.Nil(t, group)
+		require.Equal(t, groups.ErrWrongTypeAssertion, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		group, err := groups.NewTokensGroup(&mock.FacadeStub{})
+		require.Nil(t, err)
+		require.NotNil(t, group)
+	})
+}
+
+func TestTokensGroup_GetTokenProfile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid identifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTokenProfileCalled: func(identifier string) (*data.ESDTTokenProfile, error) {
+				return nil, &apiErrors.ErrInvalidRequest{Message: apiErrors.ErrInvalidTokenIdentifier.Error(), Reason: "invalid nonce"}
+			},
+		}
+		tokensGroup, err := groups.NewTokensGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(tokensGroup, tokensPath)
+
+		req, _ := http.NewRequest("GET", "/tokens/NFT-abcdef-zz", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidTokenIdentifier.Error())
+	})
+	t.Run("token not registered should return not found", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTokenProfileCalled: func(identifier string) (*data.ESDTTokenProfile, error) {
+				return nil, apiErrors.ErrTokenNotFound
+			},
+		}
+		tokensGroup, err := groups.NewTokensGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(tokensGroup, tokensPath)
+
+		req, _ := http.NewRequest("GET", "/tokens/TKN-abcdef", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+		assert.Equal(t, apiErrors.ErrTokenNotFound.Error(), response.Error)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTokenProfileCalled: func(identifier string) (*data.ESDTTokenProfile, error) {
+				return nil, errors.New("observers offline")
+			},
+		}
+		tokensGroup, err := groups.NewTokensGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(tokensGroup, tokensPath)
+
+		req, _ := http.NewRequest("GET", "/tokens/TKN-abcdef", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetTokenProfile.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedProfile := data.ESDTTokenProfile{
+			Identifier: "NFT-abcdef-0a",
+			Collection: "NFT-abcdef",
+			Nonce:      10,
+			Ticker:     "NFT",
+			Name:       "Collection",
+			Type:       "NonFungibleESDT",
+			Owner:      "erd1owner",
+			Supply:     data.ESDTSupply{Supply: "1", Minted: "1", Burned: "0"},
+			Properties: data.ESDTTokenProperties{CanUpgrade: true},
+			Roles:      []*data.ESDTAddressRoles{{Address: "erd1creator", Roles: []string{"ESDTRoleNFTCreate"}}},
+			Epoch:      7,
+		}
+		facade := &mock.FacadeStub{
+			GetTokenProfileCalled: func(identifier string) (*data.ESDTTokenProfile, error) {
+				require.Equal(t, "NFT-abcdef-0a", identifier)
+				return &expectedProfile, nil
+			},
+		}
+		tokensGroup, err := groups.NewTokensGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(tokensGroup, tokensPath)
+
+		req, _ := http.NewRequest("GET", "/tokens/NFT-abcdef-0a", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := tokenProfileResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedProfile, response.Data.Token)
+	})
+}
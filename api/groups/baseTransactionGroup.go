@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
 	"github.com/multiversx/mx-chain-proxy-go/common"
@@ -32,13 +33,21 @@ func NewTransactionGroup(facadeHandler data.FacadeHandler) (*transactionGroup, e
 	baseRoutesHandlers := []*data.EndpointHandlerData{
 		{Path: "/send", Handler: tg.sendTransaction, Method: http.MethodPost},
 		{Path: "/simulate", Handler: tg.simulateTransaction, Method: http.MethodPost},
+		{Path: "/simulate-bundle", Handler: tg.simulateTransactionsBundle, Method: http.MethodPost},
 		{Path: "/send-multiple", Handler: tg.sendMultipleTransactions, Method: http.MethodPost},
 		{Path: "/send-user-funds", Handler: tg.sendUserFunds, Method: http.MethodPost},
 		{Path: "/cost", Handler: tg.requestTransactionCost, Method: http.MethodPost},
+		{Path: "/fee", Handler: tg.computeTransactionFee, Method: http.MethodPost},
+		{Path: "/build", Handler: tg.buildTransaction, Method: http.MethodPost},
 		{Path: "/:txhash/status", Handler: tg.getTransactionStatus, Method: http.MethodGet},
 		{Path: "/:txhash/process-status", Handler: tg.getProcessedTransactionStatus, Method: http.MethodGet},
+		{Path: "/:txhash/tracking", Handler: tg.getTrackedTransaction, Method: http.MethodGet},
+		{Path: "/:txhash/graph", Handler: tg.getTransactionExecutionGraph, Method: http.MethodGet},
 		{Path: "/:txhash", Handler: tg.getTransaction, Method: http.MethodGet},
 		{Path: "/pool", Handler: tg.getTransactionsPool, Method: http.MethodGet},
+		{Path: "/pool/explorer", Handler: tg.getMempoolPage, Method: http.MethodGet},
+		{Path: "/pool/statistics", Handler: tg.getMempoolStatistics, Method: http.MethodGet},
+		{Path: "/gas-price-recommendation", Handler: tg.getGasPriceRecommendation, Method: http.MethodGet},
 	}
 	tg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -60,6 +69,21 @@ func (group *transactionGroup) sendTransaction(c *gin.Context) {
 		return
 	}
 
+	options, err := parseTransactionSendOptions(c)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	if options.ShouldWait() {
+		group.sendTransactionAndWait(c, &tx, options)
+		return
+	}
+	if options.ShouldBroadcast() {
+		group.broadcastTransaction(c, &tx, options)
+		return
+	}
+
 	statusCode, txHash, err := group.facade.SendTransaction(&tx)
 	if err != nil {
 		shared.RespondWith(c, statusCode, nil, err.Error(), data.ReturnCodeInternalError)
@@ -69,6 +93,31 @@ func (group *transactionGroup) sendTransaction(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"txHash": txHash}, "", data.ReturnCodeSuccess)
 }
 
+func (group *transactionGroup) sendTransactionAndWait(c *gin.Context, tx *data.Transaction, options common.TransactionSendOptions) {
+	statusCode, response, err := group.facade.SendTransactionAndWait(c.Request.Context(), tx, options)
+	if err != nil && response != nil {
+		// the transaction was sent, so its hash and its last known status are returned together with the error
+		shared.RespondWith(c, statusCode, response, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+	if err != nil {
+		shared.RespondWith(c, statusCode, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
+}
+
+func (group *transactionGroup) broadcastTransaction(c *gin.Context, tx *data.Transaction, options common.TransactionSendOptions) {
+	statusCode, response, err := group.facade.BroadcastTransaction(tx, options.BroadcastObservers)
+	if err != nil {
+		shared.RespondWith(c, statusCode, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
+}
+
 // sendUserFunds will receive an address from the client and propagate a transaction for sending some ERD to that address
 func (group *transactionGroup) sendUserFunds(c *gin.Context) {
 	if !group.facade.IsFaucetEnabled() {
@@ -125,7 +174,23 @@ func (group *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 		return
 	}
 
-	response, err := group.facade.SendMultipleTransactions(txs)
+	options, err := parseTransactionsBatchOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	if options.Ordered {
+		group.sendOrderedTransactions(c, txs, options)
+		return
+	}
+
+	idempotencyKey := c.GetHeader(common.HeaderIdempotencyKey)
+	response, err := group.facade.SendMultipleTransactions(txs, idempotencyKey)
+	if err == errors.ErrIdempotencyKeyReused || err == errors.ErrIdempotentRequestInProgress {
+		shared.RespondWith(c, http.StatusConflict, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
 	if err != nil {
 		shared.RespondWith(
 			c,
@@ -137,18 +202,39 @@ func (group *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 		return
 	}
 
+	responseData := gin.H{
+		"numOfSentTxs": response.NumOfTxs,
+		"txsHashes":    response.TxsHashes,
+	}
+	if len(response.InvalidTxs) > 0 {
+		responseData["invalidTxs"] = response.InvalidTxs
+	}
+
 	shared.RespondWith(
 		c,
 		http.StatusOK,
-		gin.H{
-			"numOfSentTxs": response.NumOfTxs,
-			"txsHashes":    response.TxsHashes,
-		},
+		responseData,
 		"",
 		data.ReturnCodeSuccess,
 	)
 }
 
+func (group *transactionGroup) sendOrderedTransactions(c *gin.Context, txs []*data.Transaction, options common.TransactionsBatchOptions) {
+	response, err := group.facade.SendOrderedTransactions(txs, options.StopOnRejection)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrOrderedTransactionsSendFailed.Error(), err.Error()),
+			data.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
+}
+
 // simulateTransaction will receive a transaction from the client and will send it for simulation purpose
 func (group *transactionGroup) simulateTransaction(c *gin.Context) {
 	var tx = data.Transaction{}
@@ -182,6 +268,40 @@ func (group *transactionGroup) simulateTransaction(c *gin.Context) {
 	)
 }
 
+// simulateTransactionsBundle will receive an ordered list of transactions and will simulate them one by one
+func (group *transactionGroup) simulateTransactionsBundle(c *gin.Context) {
+	var txs []*data.Transaction
+	err := c.ShouldBindJSON(&txs)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+	if len(txs) == 0 {
+		shared.RespondWithBadRequest(c, errors.ErrEmptyTransactionsBundle.Error())
+		return
+	}
+
+	options, err := parseTransactionSimulationOptions(c)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrValidatorQueryParameterCheckSignature.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	response, err := group.facade.SimulateTransactionsBundle(txs, options.CheckSignature)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"bundle": response}, "", data.ReturnCodeSuccess)
+}
+
 // requestTransactionCost will return an estimation of how many gas unit a transaction will cost
 func (group *transactionGroup) requestTransactionCost(c *gin.Context) {
 	var tx = data.Transaction{}
@@ -206,6 +326,55 @@ func (group *transactionGroup) requestTransactionCost(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, cost, "", data.ReturnCodeSuccess)
 }
 
+// computeTransactionFee will return the initially paid fee, the refund and the final fee of a transaction
+func (group *transactionGroup) computeTransactionFee(c *gin.Context) {
+	var request = data.TransactionFeeRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	fee, err := group.facade.ComputeTransactionFee(&request)
+	if err != nil {
+		_, isInvalidRequest := err.(*errors.ErrInvalidTxFields)
+		if isInvalidRequest {
+			shared.RespondWithBadRequest(c, err.Error())
+			return
+		}
+
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"fee": fee}, "", data.ReturnCodeSuccess)
+}
+
+// buildTransaction will return an unsigned transaction with the missing fields filled in, together with the payload
+// to be signed
+func (group *transactionGroup) buildTransaction(c *gin.Context) {
+	var request = data.TransactionBuildRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	response, err := group.facade.BuildTransaction(&request)
+	if err != nil {
+		_, isInvalidRequest := err.(*errors.ErrInvalidTxFields)
+		if isInvalidRequest {
+			shared.RespondWithBadRequest(c, err.Error())
+			return
+		}
+
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"build": response}, "", data.ReturnCodeSuccess)
+}
+
 // getTransactionStatus will return the transaction's status
 func (group *transactionGroup) getTransactionStatus(c *gin.Context) {
 	txHash := c.Param("txhash")
@@ -235,7 +404,7 @@ func (group *transactionGroup) getTransaction(c *gin.Context) {
 
 	sndAddr := c.Request.URL.Query().Get("sender")
 	if sndAddr != "" {
-		getTransactionByHashAndSenderAddress(c, group.facade, txHash, sndAddr, options.WithResults)
+		getTransactionByHashAndSenderAddress(c, group.facade, txHash, sndAddr, options)
 		return
 	}
 
@@ -245,7 +414,32 @@ func (group *transactionGroup) getTransaction(c *gin.Context) {
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"transaction": tx}, "", data.ReturnCodeSuccess)
+	respondWithTransaction(c, group.facade, tx, options.WithDecodedData)
+}
+
+// getTrackedTransaction returns the lifecycle of a transaction tracked by the proxy
+func (group *transactionGroup) getTrackedTransaction(c *gin.Context) {
+	txHash := c.Param("txhash")
+	if txHash == "" {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrTransactionHashMissing.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	trackedTx, err := group.facade.GetTrackedTransaction(txHash)
+	if err == errors.ErrTransactionsTrackingNotEnabled {
+		shared.RespondWithBadRequest(c, err.Error())
+		return
+	}
+	if err == errors.ErrTransactionNotTracked {
+		shared.RespondWith(c, http.StatusNotFound, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"tracking": trackedTx}, "", data.ReturnCodeSuccess)
 }
 
 func (group *transactionGroup) getProcessedTransactionStatus(c *gin.Context) {
@@ -264,8 +458,35 @@ func (group *transactionGroup) getProcessedTransactionStatus(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"status": status.Status, "reason": status.Reason}, "", data.ReturnCodeSuccess)
 }
 
-func getTransactionByHashAndSenderAddress(c *gin.Context, ef TransactionFacadeHandler, txHash string, sndAddr string, withEvents bool) {
-	tx, statusCode, err := ef.GetTransactionByHashAndSenderAddress(txHash, sndAddr, withEvents)
+// getTransactionExecutionGraph returns the execution of a transaction as a tree of the transaction and its smart contract results
+func (group *transactionGroup) getTransactionExecutionGraph(c *gin.Context) {
+	txHash := c.Param("txhash")
+	if txHash == "" {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrTransactionHashMissing.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	graph, err := group.facade.GetTransactionExecutionGraph(txHash)
+	if err == errors.ErrTransactionNotFound {
+		shared.RespondWith(c, http.StatusNotFound, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"graph": graph}, "", data.ReturnCodeSuccess)
+}
+
+func getTransactionByHashAndSenderAddress(
+	c *gin.Context,
+	ef TransactionFacadeHandler,
+	txHash string,
+	sndAddr string,
+	options common.TransactionQueryOptions,
+) {
+	tx, statusCode, err := ef.GetTransactionByHashAndSenderAddress(txHash, sndAddr, options.WithResults)
 	if err != nil {
 		internalCode := data.ReturnCodeInternalError
 		if statusCode == http.StatusBadRequest {
@@ -275,7 +496,16 @@ func getTransactionByHashAndSenderAddress(c *gin.Context, ef TransactionFacadeHa
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"transaction": tx}, "", data.ReturnCodeSuccess)
+	respondWithTransaction(c, ef, tx, options.WithDecodedData)
+}
+
+func respondWithTransaction(c *gin.Context, ef TransactionFacadeHandler, tx *transaction.ApiTransactionResult, withDecodedData bool) {
+	response := gin.H{"transaction": tx}
+	if withDecodedData {
+		response[decodedDataField] = decodeTransaction(ef, tx)
+	}
+
+	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
 }
 
 // getTransactionsPool should return transactions from pool
@@ -294,7 +524,7 @@ func (group *transactionGroup) getTransactionsPool(c *gin.Context) {
 
 	if options.Sender == "" {
 		if options.ShardID == "" {
-			getTxPool(c, group.facade, options.Fields)
+			getTxPool(c, group.facade, options.Fields, options.WithDecodedData)
 			return
 		}
 
@@ -303,7 +533,7 @@ func (group *transactionGroup) getTransactionsPool(c *gin.Context) {
 			shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrBadUrlParams.Error(), data.ReturnCodeRequestError)
 			return
 		}
-		getTxPoolForShard(c, group.facade, uint32(shardID), options.Fields)
+		getTxPoolForShard(c, group.facade, uint32(shardID), options.Fields, options.WithDecodedData)
 		return
 	}
 
@@ -317,7 +547,56 @@ func (group *transactionGroup) getTransactionsPool(c *gin.Context) {
 		return
 	}
 
-	getTxPoolForSender(c, group.facade, options.Sender, options.Fields)
+	getTxPoolForSender(c, group.facade, options.Sender, options.Fields, options.WithDecodedData)
+}
+
+// getMempoolPage returns a page of the transactions pool of all shards, filtered and sorted as requested
+func (group *transactionGroup) getMempoolPage(c *gin.Context) {
+	options, err := parseMempoolQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	page, err := group.facade.GetMempoolPage(options)
+	if err == errors.ErrInvalidMempoolCursor || err == errors.ErrMempoolCursorQueryMismatch {
+		shared.RespondWithBadRequest(c, err.Error())
+		return
+	}
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"mempool": page}, "", data.ReturnCodeSuccess)
+}
+
+// getMempoolStatistics returns aggregate statistics of the transactions pool of all shards
+func (group *transactionGroup) getMempoolStatistics(c *gin.Context) {
+	stats, err := group.facade.GetMempoolStatistics()
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"statistics": stats}, "", data.ReturnCodeSuccess)
+}
+
+// getGasPriceRecommendation returns the low, medium and high gas price suggestions for the requested shard
+func (group *transactionGroup) getGasPriceRecommendation(c *gin.Context) {
+	shardID, err := parseUint32UrlParam(c, common.UrlParameterShardID)
+	if err != nil || !shardID.HasValue {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrInvalidShardIDParam.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	recommendation, err := group.facade.GetGasPriceRecommendation(shardID.Value)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"recommendation": recommendation}, "", data.ReturnCodeSuccess)
 }
 
 func validateOptions(options common.TransactionsPoolOptions) error {
@@ -364,23 +643,33 @@ func validateFields(fields string) error {
 	return nil
 }
 
-func getTxPool(c *gin.Context, ef TransactionFacadeHandler, fields string) {
+func getTxPool(c *gin.Context, ef TransactionFacadeHandler, fields string, withDecodedData bool) {
 	txPool, err := ef.GetTransactionsPool(fields)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
+	if withDecodedData {
+		decodePoolTransactions(ef, txPool.RegularTransactions)
+		decodePoolTransactions(ef, txPool.SmartContractResults)
+	}
+
 	shared.RespondWith(c, http.StatusOK, gin.H{"txPool": txPool}, "", data.ReturnCodeSuccess)
 }
 
-func getTxPoolForShard(c *gin.Context, ef TransactionFacadeHandler, shardID uint32, fields string) {
+func getTxPoolForShard(c *gin.Context, ef TransactionFacadeHandler, shardID uint32, fields string, withDecodedData bool) {
 	txPool, err := ef.GetTransactionsPoolForShard(shardID, fields)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
+	if withDecodedData {
+		decodePoolTransactions(ef, txPool.RegularTransactions)
+		decodePoolTransactions(ef, txPool.SmartContractResults)
+	}
+
 	shared.RespondWith(c, http.StatusOK, gin.H{"txPool": txPool}, "", data.ReturnCodeSuccess)
 }
 
@@ -404,12 +693,16 @@ func getTxPoolNonceGapsForSender(c *gin.Context, ef TransactionFacadeHandler, se
 	shared.RespondWith(c, http.StatusOK, gin.H{"nonceGaps": nonceGaps}, "", data.ReturnCodeSuccess)
 }
 
-func getTxPoolForSender(c *gin.Context, ef TransactionFacadeHandler, sender, fields string) {
+func getTxPoolForSender(c *gin.Context, ef TransactionFacadeHandler, sender, fields string, withDecodedData bool) {
 	txPool, err := ef.GetTransactionsPoolForSender(sender, fields)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
+	if withDecodedData {
+		decodePoolTransactions(ef, txPool.Transactions)
+	}
+
 	shared.RespondWith(c, http.StatusOK, gin.H{"txPool": txPool}, "", data.ReturnCodeSuccess)
 }
@@ -2,16 +2,22 @@ package groups_test
 
 import (
 	"bytes"
+	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,8 +35,19 @@ type TxHashResponse struct {
 	Data txHashResponseData
 }
 
+type sendAndWaitResponse struct {
+	GeneralResponse
+	Data data.TransactionSendAndWaitResponseData `json:"data"`
+}
+
+type broadcastResponse struct {
+	GeneralResponse
+	Data data.TransactionBroadcastResponseData `json:"data"`
+}
+
 type numOfSentTxsResponseData struct {
-	Num uint64 `json:"numOfSentTxs"`
+	Num        uint64                               `json:"numOfSentTxs"`
+	InvalidTxs map[int]*data.InvalidTransactionData `json:"invalidTxs"`
 }
 
 // MultiTxsResponse structure
@@ -199,6 +216,143 @@ func TestSendTransaction_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, string(data.ReturnCodeSuccess), response.GeneralResponse.Code)
 }
 
+func TestSendTransaction_InvalidWaitOptionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+			require.Fail(t, "should have not been called")
+			return 0, "", nil
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	testCases := map[string]error{
+		"/transaction/send?waitFor=final":                    apiErrors.ErrInvalidWaitForOption,
+		"/transaction/send?waitFor=executed&timeout=abc":     apiErrors.ErrInvalidWaitTimeout,
+		"/transaction/send?waitFor=executed&timeout=-1s":     apiErrors.ErrInvalidWaitTimeout,
+		"/transaction/send?waitFor=completed&timeout=10000s": apiErrors.ErrInvalidWaitTimeout,
+		"/transaction/send?broadcast=abc":                    apiErrors.ErrInvalidBroadcastObservers,
+		"/transaction/send?broadcast=100":                    apiErrors.ErrInvalidBroadcastObservers,
+		"/transaction/send?broadcast=2&waitFor=executed":     apiErrors.ErrBroadcastWithWaitFor,
+	}
+	for path, expectedErr := range testCases {
+		req, _ := http.NewRequest("POST", path, bytes.NewBuffer([]byte(`{"nonce": 1}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code, path)
+		assert.Equal(t, expectedErr.Error(), response.Error, path)
+	}
+}
+
+func TestSendTransaction_WithWaitForShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &data.TransactionSendAndWaitResponseData{
+		TxHash: "tx hash",
+		Status: "success",
+		Transaction: &transaction.ApiTransactionResult{
+			Hash:   "tx hash",
+			Status: transaction.TxStatusSuccess,
+		},
+	}
+	facade := &mock.FacadeStub{
+		SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+			require.Fail(t, "should have not been called")
+			return 0, "", nil
+		},
+		SendTransactionAndWaitHandler: func(ctx context.Context, tx *data.Transaction, options common.TransactionSendOptions) (int, *data.TransactionSendAndWaitResponseData, error) {
+			assert.NotNil(t, ctx)
+			assert.Equal(t, common.TransactionWaitForCompleted, options.WaitFor)
+			assert.Equal(t, 12*time.Second, options.Timeout)
+			return http.StatusOK, expectedResponse, nil
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("POST", "/transaction/send?waitFor=completed&timeout=12s", bytes.NewBuffer([]byte(`{"nonce": 1}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := sendAndWaitResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, *expectedResponse, response.Data)
+}
+
+func TestSendTransaction_WithWaitForCancelledShouldReturnTheLastKnownStatus(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &data.TransactionSendAndWaitResponseData{
+		TxHash:   "tx hash",
+		Status:   "pending",
+		TimedOut: true,
+	}
+	facade := &mock.FacadeStub{
+		SendTransactionAndWaitHandler: func(ctx context.Context, tx *data.Transaction, options common.TransactionSendOptions) (int, *data.TransactionSendAndWaitResponseData, error) {
+			return http.StatusRequestTimeout, expectedResponse, context.Canceled
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("POST", "/transaction/send?waitFor=executed", bytes.NewBuffer([]byte(`{"nonce": 1}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := sendAndWaitResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusRequestTimeout, resp.Code)
+	assert.Equal(t, context.Canceled.Error(), response.Error)
+	assert.Equal(t, *expectedResponse, response.Data)
+}
+
+func TestSendTransaction_WithBroadcastShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &data.TransactionBroadcastResponseData{
+		TxHash:     "tx hash",
+		SentTo:     []string{"observer0", "observer1"},
+		AcceptedBy: []string{"observer1"},
+	}
+	facade := &mock.FacadeStub{
+		SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+			require.Fail(t, "should have not been called")
+			return 0, "", nil
+		},
+		BroadcastTransactionHandler: func(tx *data.Transaction, numObservers int) (int, *data.TransactionBroadcastResponseData, error) {
+			assert.Equal(t, 2, numObservers)
+			return http.StatusOK, expectedResponse, nil
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("POST", "/transaction/send?broadcast=2", bytes.NewBuffer([]byte(`{"nonce": 1}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := broadcastResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, *expectedResponse, response.Data)
+}
+
 func TestSimulateTransaction_WrongParametersShouldErrorOnValidation(t *testing.T) {
 	t.Parallel()
 
@@ -366,10 +520,13 @@ func TestSendMultipleTransactions_ReturnsSuccessfully(t *testing.T) {
 		SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
 			return 0, txHash, nil
 		},
-		SendMultipleTransactionsHandler: func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+		SendMultipleTransactionsHandler: func(txs []*data.Transaction, _ string) (data.MultipleTransactionsResponseData, error) {
 			return data.MultipleTransactionsResponseData{
 				NumOfTxs:  10,
 				TxsHashes: nil,
+				InvalidTxs: map[int]*data.InvalidTransactionData{
+					1: {Message: "invalid", Reason: "reason"},
+				},
 			}, nil
 		},
 	}
@@ -398,6 +555,167 @@ func TestSendMultipleTransactions_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.Code)
 	assert.Empty(t, response.Error)
 	assert.Equal(t, uint64(10), response.Data.Num)
+	assert.Equal(t, "reason", response.Data.InvalidTxs[1].Reason)
+}
+
+func TestSendMultipleTransactions_IdempotencyKeyHeader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("header should be forwarded to the facade", func(t *testing.T) {
+		t.Parallel()
+
+		providedKey := ""
+		facade := &mock.FacadeStub{
+			SendMultipleTransactionsHandler: func(txs []*data.Transaction, idempotencyKey string) (data.MultipleTransactionsResponseData, error) {
+				providedKey = idempotencyKey
+				return data.MultipleTransactionsResponseData{NumOfTxs: 1}, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/send-multiple", bytes.NewBuffer([]byte(`[{"nonce": 1}]`)))
+		req.Header.Set(common.HeaderIdempotencyKey, "key")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "key", providedKey)
+	})
+	t.Run("reused key should return conflict", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SendMultipleTransactionsHandler: func(txs []*data.Transaction, idempotencyKey string) (data.MultipleTransactionsResponseData, error) {
+				return data.MultipleTransactionsResponseData{}, apiErrors.ErrIdempotencyKeyReused
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/send-multiple", bytes.NewBuffer([]byte(`[{"nonce": 1}]`)))
+		req.Header.Set(common.HeaderIdempotencyKey, "key")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusConflict, resp.Code)
+		assert.Equal(t, apiErrors.ErrIdempotencyKeyReused.Error(), response.Error)
+	})
+	t.Run("key of a request in progress should return conflict", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SendMultipleTransactionsHandler: func(txs []*data.Transaction, idempotencyKey string) (data.MultipleTransactionsResponseData, error) {
+				return data.MultipleTransactionsResponseData{}, apiErrors.ErrIdempotentRequestInProgress
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/send-multiple", bytes.NewBuffer([]byte(`[{"nonce": 1}]`)))
+		req.Header.Set(common.HeaderIdempotencyKey, "key")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusConflict, resp.Code)
+		assert.Equal(t, apiErrors.ErrIdempotentRequestInProgress.Error(), response.Error)
+	})
+}
+
+type orderedTxsResponse struct {
+	GeneralResponse
+	Data data.OrderedTransactionsResponseData `json:"data"`
+}
+
+func TestSendMultipleTransactions_OrderedShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &data.OrderedTransactionsResponseData{
+		NumOfSentTxs: 1,
+		Transactions: []*data.OrderedTransactionResult{
+			{Index: 0, Sender: "alice", Nonce: 1, TxHash: "hash", Status: data.OrderedTxStatusAccepted},
+			{Index: 1, Sender: "alice", Nonce: 3, Status: data.OrderedTxStatusRejected, Reason: "nonce gap, expected nonce 2"},
+		},
+	}
+	facade := &mock.FacadeStub{
+		SendMultipleTransactionsHandler: func(txs []*data.Transaction, _ string) (data.MultipleTransactionsResponseData, error) {
+			require.Fail(t, "should have not been called")
+			return data.MultipleTransactionsResponseData{}, nil
+		},
+		SendOrderedTransactionsHandler: func(txs []*data.Transaction, stopOnRejection bool) (*data.OrderedTransactionsResponseData, error) {
+			assert.Equal(t, 2, len(txs))
+			assert.True(t, stopOnRejection)
+			return expectedResponse, nil
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	jsonStr := `[{"sender": "alice", "nonce": 1}, {"sender": "alice", "nonce": 3}]`
+	req, _ := http.NewRequest("POST", "/transaction/send-multiple?ordered=true&stopOnRejection=true", bytes.NewBuffer([]byte(jsonStr)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := orderedTxsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, *expectedResponse, response.Data)
+}
+
+func TestSendMultipleTransactions_OrderedErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.FacadeStub{
+		SendOrderedTransactionsHandler: func(txs []*data.Transaction, stopOnRejection bool) (*data.OrderedTransactionsResponseData, error) {
+			return nil, expectedErr
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	jsonStr := `[{"sender": "alice", "nonce": 1}]`
+	req, _ := http.NewRequest("POST", "/transaction/send-multiple?ordered=true", bytes.NewBuffer([]byte(jsonStr)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrOrderedTransactionsSendFailed.Error())
+	assert.Contains(t, response.Error, expectedErr.Error())
+}
+
+func TestSendMultipleTransactions_InvalidBatchOptionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("POST", "/transaction/send-multiple?ordered=yes", bytes.NewBuffer([]byte(`[]`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
 }
 
 func TestSendUserFunds_ErrorWhenFacadeSendUserFundsError(t *testing.T) {
@@ -691,6 +1009,116 @@ func TestGetTransactionsPoolForSender_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, providedTxPool, &response.Data.TxPool)
 }
 
+type txWithDecodedDataResp struct {
+	GeneralResponse
+	Data struct {
+		Transaction *transaction.ApiTransactionResult `json:"transaction"`
+		DecodedData *data.DecodedTransactionData      `json:"decodedData"`
+	} `json:"data"`
+}
+
+func TestGetTransaction_WithDecodedDataShouldWork(t *testing.T) {
+	t.Parallel()
+
+	providedTx := &transaction.ApiTransactionResult{
+		Hash:     "hash",
+		Sender:   "sender",
+		Receiver: "receiver",
+		Data:     []byte("claim"),
+	}
+	decodedCall := &data.DecodedTransactionData{Operation: "scCall", Function: "claim"}
+	facade := &mock.FacadeStub{
+		GetTransactionHandler: func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			return providedTx, nil
+		},
+		DecodeTransactionDataCalled: func(dataField []byte, sender string, receiver string) *data.DecodedTransactionData {
+			require.Equal(t, providedTx.Data, dataField)
+			require.Equal(t, providedTx.Sender, sender)
+			require.Equal(t, providedTx.Receiver, receiver)
+
+			return decodedCall
+		},
+	}
+
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("GET", "/transaction/hash?withDecodedData=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txWithDecodedDataResp{}
+	loadResponse(resp.Body, &response)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, providedTx, response.Data.Transaction)
+	require.Equal(t, decodedCall, response.Data.DecodedData)
+
+	req, _ = http.NewRequest("GET", "/transaction/hash", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response = txWithDecodedDataResp{}
+	loadResponse(resp.Body, &response)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Nil(t, response.Data.DecodedData)
+}
+
+func TestGetTransactionsPoolForSender_WithDecodedDataShouldWork(t *testing.T) {
+	t.Parallel()
+
+	providedTxPool := &data.TransactionsPoolForSender{
+		Transactions: []data.WrappedTransaction{
+			{
+				TxFields: map[string]interface{}{
+					"hash":     "hash1",
+					"sender":   "sender",
+					"receiver": "receiver",
+					"data":     base64.StdEncoding.EncodeToString([]byte("claim")),
+				},
+			},
+			{
+				TxFields: map[string]interface{}{
+					"hash": "hash2",
+				},
+			},
+		},
+	}
+	facade := &mock.FacadeStub{
+		GetTransactionsPoolForSenderHandler: func(sender, fields string) (*data.TransactionsPoolForSender, error) {
+			return providedTxPool, nil
+		},
+		DecodeTransactionDataCalled: func(dataField []byte, sender string, receiver string) *data.DecodedTransactionData {
+			require.Equal(t, []byte("claim"), dataField)
+			require.Equal(t, "sender", sender)
+			require.Equal(t, "receiver", receiver)
+
+			return &data.DecodedTransactionData{Operation: "scCall", Function: "claim"}
+		},
+	}
+
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("GET", "/transaction/pool?by-sender=sender&fields=hash,sender,receiver,data&withDecodedData=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txPoolForSenderResp{}
+	loadResponse(resp.Body, &response)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Len(t, response.Data.TxPool.Transactions, 2)
+	require.Equal(t, map[string]interface{}{
+		"operation": "scCall",
+		"function":  "claim",
+	}, response.Data.TxPool.Transactions[0].TxFields["decodedData"])
+	require.NotContains(t, response.Data.TxPool.Transactions[1].TxFields, "decodedData")
+}
+
 func TestLastPoolNonceForSender_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 
@@ -829,3 +1257,814 @@ func TestTransactionGroup_getProcessedTransactionStatus(t *testing.T) {
 		assert.Equal(t, status.Reason, response.Data.Reason)
 	})
 }
+
+type trackedTxResponse struct {
+	GeneralResponse
+	Data struct {
+		Tracking data.TrackedTransaction `json:"tracking"`
+	} `json:"data"`
+}
+
+func TestTransactionGroup_getTrackedTransaction(t *testing.T) {
+	t.Parallel()
+
+	hash := "hash"
+	t.Run("tracking not enabled, should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTrackedTransactionHandler: func(txHash string) (*data.TrackedTransaction, error) {
+				return nil, apiErrors.ErrTransactionsTrackingNotEnabled
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"/tracking", nil)
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrTransactionsTrackingNotEnabled.Error(), response.Error)
+	})
+	t.Run("transaction not tracked, should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTrackedTransactionHandler: func(txHash string) (*data.TrackedTransaction, error) {
+				return nil, apiErrors.ErrTransactionNotTracked
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"/tracking", nil)
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+		assert.Equal(t, apiErrors.ErrTransactionNotTracked.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		trackedTx := &data.TrackedTransaction{
+			TxHash:          hash,
+			Sender:          "sender",
+			Nonce:           7,
+			Status:          data.TrackedTxStatusRebroadcast,
+			NumRebroadcasts: 1,
+			Events: []data.TrackedTransactionEvent{
+				{Timestamp: 10, Status: data.TrackedTxStatusSent},
+				{Timestamp: 40, Status: data.TrackedTxStatusRebroadcast},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetTrackedTransactionHandler: func(txHash string) (*data.TrackedTransaction, error) {
+				assert.Equal(t, hash, txHash)
+				return trackedTx, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"/tracking", nil)
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := trackedTxResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, *trackedTx, response.Data.Tracking)
+	})
+}
+
+type transactionFeeResponse struct {
+	GeneralResponse
+	Data struct {
+		Fee data.TransactionFeeResponseData `json:"fee"`
+	} `json:"data"`
+}
+
+func TestTransactionGroup_computeTransactionFee(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/fee", bytes.NewBuffer([]byte("invalid")))
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ComputeTransactionFeeHandler: func(request *data.TransactionFeeRequest) (*data.TransactionFeeResponseData, error) {
+				return nil, &apiErrors.ErrInvalidTxFields{Message: apiErrors.ErrInsufficientGasLimit.Error(), Reason: "reason"}
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/fee", bytes.NewBuffer([]byte(`{"gasLimit":10}`)))
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInsufficientGasLimit.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			ComputeTransactionFeeHandler: func(request *data.TransactionFeeRequest) (*data.TransactionFeeResponseData, error) {
+				return nil, expectedErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/fee", bytes.NewBuffer([]byte(`{"gasLimit":50000}`)))
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedFee := data.TransactionFeeResponseData{
+			GasLimit:         5000000,
+			GasPrice:         1000000000,
+			GasUsed:          1000000,
+			InitiallyPaidFee: "108410000000000",
+			Refund:           "40000000000000",
+			Fee:              "68410000000000",
+		}
+		facade := &mock.FacadeStub{
+			ComputeTransactionFeeHandler: func(request *data.TransactionFeeRequest) (*data.TransactionFeeResponseData, error) {
+				require.NotNil(t, request.Transaction)
+				require.Equal(t, uint64(5000000), request.Transaction.GasLimit)
+				require.Equal(t, []byte("add@01"), request.Transaction.Data)
+				return &expectedFee, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		body := `{"transaction":{"gasLimit":5000000,"gasPrice":1000000000,"data":"YWRkQDAx"}}`
+		req, _ := http.NewRequest("POST", "/transaction/fee", bytes.NewBuffer([]byte(body)))
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionFeeResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, expectedFee, response.Data.Fee)
+	})
+}
+
+type transactionBuildResponse struct {
+	GeneralResponse
+	Data struct {
+		Build data.TransactionBuildResponseData `json:"build"`
+	} `json:"data"`
+}
+
+func TestTransactionGroup_buildTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/build", bytes.NewBuffer([]byte("invalid")))
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			BuildTransactionHandler: func(request *data.TransactionBuildRequest) (*data.TransactionBuildResponseData, error) {
+				return nil, &apiErrors.ErrInvalidTxFields{Message: apiErrors.ErrInvalidSenderAddress.Error(), Reason: "reason"}
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/build", bytes.NewBuffer([]byte(`{"sender":"invalid"}`)))
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidSenderAddress.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			BuildTransactionHandler: func(request *data.TransactionBuildRequest) (*data.TransactionBuildResponseData, error) {
+				return nil, expectedErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/build", bytes.NewBuffer([]byte(`{"sender":"erd1sender"}`)))
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedResponse := data.TransactionBuildResponseData{
+			Transaction: &data.Transaction{
+				Nonce:    7,
+				Value:    "0",
+				Receiver: "erd1receiver",
+				Sender:   "erd1sender",
+				GasPrice: 1000000000,
+				GasLimit: 50000,
+				ChainID:  "T",
+				Version:  1,
+			},
+			SigningPayload: "payload",
+		}
+		facade := &mock.FacadeStub{
+			BuildTransactionHandler: func(request *data.TransactionBuildRequest) (*data.TransactionBuildResponseData, error) {
+				require.Equal(t, "erd1sender", request.Sender)
+				require.Equal(t, "erd1receiver", request.Receiver)
+				require.Nil(t, request.Nonce)
+				return &expectedResponse, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		body := `{"sender":"erd1sender","receiver":"erd1receiver"}`
+		req, _ := http.NewRequest("POST", "/transaction/build", bytes.NewBuffer([]byte(body)))
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionBuildResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, expectedResponse, response.Data.Build)
+	})
+}
+
+type mempoolPageResponse struct {
+	GeneralResponse
+	Data struct {
+		Mempool data.MempoolPage `json:"mempool"`
+	} `json:"data"`
+}
+
+func TestTransactionGroup_getMempoolPage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid options should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		invalidQueries := []string{
+			"size=0",
+			"size=501",
+			"sortBy=value",
+			"order=random",
+			"minGasPrice=-1",
+			"shard-id=abc",
+			"minAge=10",
+			"minAge=10m&maxAge=1m",
+		}
+		for _, query := range invalidQueries {
+			req, _ := http.NewRequest("GET", "/transaction/pool/explorer?"+query, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+		}
+	})
+	t.Run("invalid cursor should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetMempoolPageHandler: func(options common.MempoolQueryOptions) (*data.MempoolPage, error) {
+				return nil, apiErrors.ErrInvalidMempoolCursor
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/pool/explorer?cursor=expired", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrInvalidMempoolCursor.Error(), response.Error)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetMempoolPageHandler: func(options common.MempoolQueryOptions) (*data.MempoolPage, error) {
+				return nil, apiErrors.ErrOperationNotAllowed
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/pool/explorer", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedPage := data.MempoolPage{
+			Transactions: []*data.MempoolTransaction{
+				{Hash: "hash", Type: data.MempoolTxTypeRegular, Nonce: 1, Sender: "alice", GasPrice: 1000000000, Function: "swap"},
+			},
+			NumTransactions:   5,
+			NextCursor:        "next",
+			SnapshotTimestamp: 1700000000,
+		}
+		facade := &mock.FacadeStub{
+			GetMempoolPageHandler: func(options common.MempoolQueryOptions) (*data.MempoolPage, error) {
+				require.Equal(t, common.MempoolQueryOptions{
+					Cursor:      "cursor",
+					Size:        1,
+					Receiver:    "bob",
+					Function:    "swap",
+					MinGasPrice: 1000000000,
+					ShardID:     core.OptionalUint32{Value: 1, HasValue: true},
+					MinAge:      30 * time.Second,
+					MaxAge:      0,
+					SortBy:      common.MempoolSortByNonce,
+					Order:       common.OrderDescending,
+				}, options)
+				return &expectedPage, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		query := "cursor=cursor&size=1&receiver=bob&function=swap&minGasPrice=1000000000&shard-id=1&minAge=30s&sortBy=nonce"
+		req, _ := http.NewRequest("GET", "/transaction/pool/explorer?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := mempoolPageResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, expectedPage, response.Data.Mempool)
+	})
+}
+
+type mempoolStatisticsResponse struct {
+	GeneralResponse
+	Data struct {
+		Statistics data.MempoolStatistics `json:"statistics"`
+	} `json:"data"`
+}
+
+func TestTransactionGroup_getMempoolStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetMempoolStatisticsHandler: func() (*data.MempoolStatistics, error) {
+				return nil, apiErrors.ErrOperationNotAllowed
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/pool/statistics", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, apiErrors.ErrOperationNotAllowed.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedStats := data.MempoolStatistics{
+			NumTransactions:      3,
+			TransactionsPerShard: map[uint32]int{0: 2, 1: 1},
+			TransactionsPerType:  map[string]int{data.MempoolTxTypeRegular: 3},
+			GasPricePercentiles:  data.MempoolGasPricePercentiles{Min: 1, P25: 1, P50: 2, P75: 3, P90: 3, Max: 3},
+			TopSenders:           []*data.MempoolSenderStatistics{{Address: "alice", NumTransactions: 2}},
+			SnapshotTimestamp:    1700000000,
+		}
+		facade := &mock.FacadeStub{
+			GetMempoolStatisticsHandler: func() (*data.MempoolStatistics, error) {
+				return &expectedStats, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/pool/statistics", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := mempoolStatisticsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, expectedStats, response.Data.Statistics)
+	})
+}
+
+type gasPriceRecommendationResponse struct {
+	GeneralResponse
+	Data struct {
+		Recommendation data.GasPriceRecommendation `json:"recommendation"`
+	} `json:"data"`
+}
+
+func TestTransactionGroup_getGasPriceRecommendation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/gas-price-recommendation", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrInvalidShardIDParam.Error(), response.Error)
+	})
+	t.Run("invalid shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/gas-price-recommendation?shard-id=abc", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrInvalidShardIDParam.Error(), response.Error)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetGasPriceRecommendationHandler: func(shardID uint32) (*data.GasPriceRecommendation, error) {
+				return nil, apiErrors.ErrOperationNotAllowed
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/gas-price-recommendation?shard-id=1", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, apiErrors.ErrOperationNotAllowed.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedRecommendation := data.GasPriceRecommendation{
+			ShardID:                1,
+			MinGasPrice:            1000000000,
+			RecentBlocksFullness:   0.5,
+			NumPendingTransactions: 10,
+			PendingGas:             500000,
+			Low:                    data.GasPriceSuggestion{GasPrice: 1000000000, EstimatedBlocks: 3},
+			Medium:                 data.GasPriceSuggestion{GasPrice: 1000000000, EstimatedBlocks: 3},
+			High:                   data.GasPriceSuggestion{GasPrice: 1500000001, EstimatedBlocks: 1},
+			Timestamp:              1700000000,
+		}
+		facade := &mock.FacadeStub{
+			GetGasPriceRecommendationHandler: func(shardID uint32) (*data.GasPriceRecommendation, error) {
+				require.Equal(t, uint32(1), shardID)
+				return &expectedRecommendation, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/gas-price-recommendation?shard-id=1", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := gasPriceRecommendationResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, expectedRecommendation, response.Data.Recommendation)
+	})
+}
+
+type transactionExecutionGraphResponse struct {
+	GeneralResponse
+	Data struct {
+		Graph data.TransactionExecutionGraph `json:"graph"`
+	} `json:"data"`
+}
+
+func TestTransactionGroup_getTransactionExecutionGraph(t *testing.T) {
+	t.Parallel()
+
+	hash := "hash"
+	t.Run("transaction not found, should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionExecutionGraphHandler: func(txHash string) (*data.TransactionExecutionGraph, error) {
+				return nil, apiErrors.ErrTransactionNotFound
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"/graph", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+		assert.Equal(t, apiErrors.ErrTransactionNotFound.Error(), response.Error)
+	})
+	t.Run("facade error, should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionExecutionGraphHandler: func(txHash string) (*data.TransactionExecutionGraph, error) {
+				return nil, apiErrors.ErrOperationNotAllowed
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"/graph", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, apiErrors.ErrOperationNotAllowed.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedGraph := data.TransactionExecutionGraph{
+			Status: "fail",
+			Root: &data.TransactionExecutionNode{
+				Hash:  hash,
+				Type:  data.ExecutionNodeTypeTransaction,
+				Value: "0",
+				Children: []*data.TransactionExecutionNode{
+					{
+						Hash:           "scr",
+						Type:           data.ExecutionNodeTypeSmartContractResult,
+						CallType:       "asynchronousCall",
+						ExecutionShard: 1,
+						Value:          "0",
+						Failed:         true,
+						FailureReason:  "insufficient funds",
+					},
+				},
+			},
+			FailurePoint:  "scr",
+			FailureReason: "insufficient funds",
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionExecutionGraphHandler: func(txHash string) (*data.TransactionExecutionGraph, error) {
+				require.Equal(t, hash, txHash)
+				return &expectedGraph, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"/graph", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionExecutionGraphResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, expectedGraph, response.Data.Graph)
+	})
+}
+
+type bundleSimulationResponse struct {
+	GeneralResponse
+	Data struct {
+		Bundle data.BundleSimulationResponseData `json:"bundle"`
+	} `json:"data"`
+}
+
+func TestTransactionGroup_simulateTransactionsBundle(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer([]byte(`{"nonce": 1}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("empty bundle should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer([]byte(`[]`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrEmptyTransactionsBundle.Error(), response.Error)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SimulateTransactionsBundleHandler: func(txs []*data.Transaction, checkSignature bool) (*data.BundleSimulationResponseData, error) {
+				return nil, apiErrors.ErrOperationNotAllowed
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer([]byte(`[{"nonce": 1}]`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, apiErrors.ErrOperationNotAllowed.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedBundle := data.BundleSimulationResponseData{
+			NumSimulated: 1,
+			Transactions: []*data.BundleSimulationTransactionResult{
+				{Index: 0, Sender: "alice", Nonce: 1, Status: data.BundleTxStatusFail, FailReason: "insufficient funds"},
+				{Index: 1, Sender: "alice", Nonce: 2, Status: data.BundleTxStatusSkipped},
+			},
+		}
+		providedCheckSignature := true
+		facade := &mock.FacadeStub{
+			SimulateTransactionsBundleHandler: func(txs []*data.Transaction, checkSignature bool) (*data.BundleSimulationResponseData, error) {
+				require.Len(t, txs, 2)
+				providedCheckSignature = checkSignature
+				return &expectedBundle, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		body := []byte(`[{"sender": "alice", "nonce": 1}, {"sender": "alice", "nonce": 2}]`)
+		req, _ := http.NewRequest("POST", "/transaction/simulate-bundle?checkSignature=false", bytes.NewBuffer(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := bundleSimulationResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.False(t, providedCheckSignature)
+		assert.Equal(t, expectedBundle, response.Data.Bundle)
+	})
+}
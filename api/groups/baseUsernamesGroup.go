@@ -0,0 +1,80 @@
+package groups
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type usernamesGroup struct {
+	facade UsernamesFacadeHandler
+	*baseGroup
+}
+
+// NewUsernamesGroup returns a new instance of usernamesGroup
+func NewUsernamesGroup(facadeHandler data.FacadeHandler) (*usernamesGroup, error) {
+	facade, ok := facadeHandler.(UsernamesFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	ug := &usernamesGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/:username", Handler: ug.resolveUsername, Method: http.MethodGet},
+		{Path: "/resolve", Handler: ug.resolveUsernames, Method: http.MethodPost},
+	}
+	ug.baseGroup.endpoints = baseRoutesHandlers
+
+	return ug, nil
+}
+
+// resolveUsername returns the address the username parameter resolves to
+func (group *usernamesGroup) resolveUsername(c *gin.Context) {
+	resolution, err := group.facade.ResolveUsername(c.Param("username"))
+	if err != nil {
+		respondWithUsernamesError(c, err)
+		return
+	}
+	if !resolution.Found {
+		shared.RespondWith(c, http.StatusNotFound, nil, errors.ErrUsernameNotFound.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"username": resolution}, "", data.ReturnCodeSuccess)
+}
+
+// resolveUsernames returns the addresses the usernames of the request body resolve to. The usernames which are not
+// registered are returned as not found
+func (group *usernamesGroup) resolveUsernames(c *gin.Context) {
+	var usernames []string
+	err := c.ShouldBindJSON(&usernames)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrInvalidUsernamesRequest, err)
+		return
+	}
+
+	resolutions, err := group.facade.ResolveUsernames(usernames)
+	if err != nil {
+		respondWithUsernamesError(c, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, resolutions, "", data.ReturnCodeSuccess)
+}
+
+func respondWithUsernamesError(c *gin.Context, err error) {
+	_, isInvalidRequest := err.(*errors.ErrInvalidRequest)
+	if isInvalidRequest {
+		shared.RespondWithBadRequest(c, err.Error())
+		return
+	}
+
+	shared.RespondWithInternalError(c, errors.ErrResolveUsername, err)
+}
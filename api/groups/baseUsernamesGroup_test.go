@@ -0,0 +1,207 @@
+package groups_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const usernamesPath = "/usernames"
+
+type usernameResolutionResponseData struct {
+	Username data.UsernameResolution `json:"username"`
+}
+
+type usernameResolutionResponse struct {
+	Data  usernameResolutionResponseData `json:"data"`
+	Error string                         `json:"error"`
+	Code  string                         `json:"code"`
+}
+
+type usernamesResolutionResponse struct {
+	Data  data.UsernamesResolutionResponseData `json:"data"`
+	Error string                               `json:"error"`
+	Code  string                               `json:"code"`
+}
+
+func TestNewUsernamesGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wrong facade, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		wrongFacade := &mock.WrongFacade{}
+		group, err := groups.NewUsernamesGroup(wrongFacade)
+		require.Nil(t, group)
+		require.Equal(t, groups.ErrWrongTypeAssertion, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		group, err := groups.NewUsernamesGroup(&mock.FacadeStub{})
+		require.Nil(t, err)
+		require.NotNil(t, group)
+	})
+}
+
+func TestUsernamesGroup_ResolveUsername(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ResolveUsernameCalled: func(username string) (*data.UsernameResolution, error) {
+				return nil, errors.New("observers offline")
+			},
+		}
+		usernamesGroup, err := groups.NewUsernamesGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(usernamesGroup, usernamesPath)
+
+		req, _ := http.NewRequest("GET", "/usernames/alice.elrond", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrResolveUsername.Error())
+	})
+	t.Run("username not registered should return not found", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ResolveUsernameCalled: func(username string) (*data.UsernameResolution, error) {
+				return &data.UsernameResolution{Username: username}, nil
+			},
+		}
+		usernamesGroup, err := groups.NewUsernamesGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(usernamesGroup, usernamesPath)
+
+		req, _ := http.NewRequest("GET", "/usernames/alice.elrond", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+		assert.Equal(t, apiErrors.ErrUsernameNotFound.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedResolution := data.UsernameResolution{
+			Username:   "alice.elrond",
+			DnsAddress: "erd1dns",
+			Found:      true,
+			Address:    "erd1alice",
+			ShardID:    1,
+		}
+		facade := &mock.FacadeStub{
+			ResolveUsernameCalled: func(username string) (*data.UsernameResolution, error) {
+				require.Equal(t, "alice.elrond", username)
+				return &expectedResolution, nil
+			},
+		}
+		usernamesGroup, err := groups.NewUsernamesGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(usernamesGroup, usernamesPath)
+
+		req, _ := http.NewRequest("GET", "/usernames/alice.elrond", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := usernameResolutionResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedResolution, response.Data.Username)
+	})
+}
+
+func TestUsernamesGroup_ResolveUsernames(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		usernamesGroup, err := groups.NewUsernamesGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(usernamesGroup, usernamesPath)
+
+		req, _ := http.NewRequest("POST", "/usernames/resolve", bytes.NewBufferString(`{"usernames": "alice.elrond"}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidUsernamesRequest.Error())
+	})
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ResolveUsernamesCalled: func(usernames []string) (*data.UsernamesResolutionResponseData, error) {
+				return nil, &apiErrors.ErrInvalidRequest{Message: apiErrors.ErrInvalidUsernamesRequest.Error(), Reason: "too many usernames"}
+			},
+		}
+		usernamesGroup, err := groups.NewUsernamesGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(usernamesGroup, usernamesPath)
+
+		req, _ := http.NewRequest("POST", "/usernames/resolve", bytes.NewBufferString(`["alice.elrond"]`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, "too many usernames")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedResolutions := data.UsernamesResolutionResponseData{
+			Usernames: map[string]*data.UsernameResolution{
+				"alice.elrond": {Username: "alice.elrond", Found: true, Address: "erd1alice"},
+				"bob.elrond":   {Username: "bob.elrond"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			ResolveUsernamesCalled: func(usernames []string) (*data.UsernamesResolutionResponseData, error) {
+				require.Equal(t, []string{"alice.elrond", "bob.elrond"}, usernames)
+				return &expectedResolutions, nil
+			},
+		}
+		usernamesGroup, err := groups.NewUsernamesGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(usernamesGroup, usernamesPath)
+
+		req, _ := http.NewRequest("POST", "/usernames/resolve", bytes.NewBufferString(`["alice.elrond", "bob.elrond"]`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := usernamesResolutionResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedResolutions, response.Data)
+	})
+}
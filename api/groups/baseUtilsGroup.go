@@ -0,0 +1,106 @@
+package groups
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type utilsGroup struct {
+	facade UtilsFacadeHandler
+	*baseGroup
+}
+
+// NewUtilsGroup returns a new instance of utilsGroup
+func NewUtilsGroup(facadeHandler data.FacadeHandler) (*utilsGroup, error) {
+	facade, ok := facadeHandler.(UtilsFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	ug := &utilsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/address/convert/:address", Handler: ug.convertAddress, Method: http.MethodGet},
+		{Path: "/address/shard", Handler: ug.computeShardIDs, Method: http.MethodPost},
+		{Path: "/address/contract/:deployer/nonce/:nonce", Handler: ug.computeContractAddress, Method: http.MethodGet},
+		{Path: "/address/details/:address", Handler: ug.getAddressDetails, Method: http.MethodGet},
+	}
+	ug.baseGroup.endpoints = baseRoutesHandlers
+
+	return ug, nil
+}
+
+// convertAddress returns the bech32 and the hex encodings of the address parameter
+func (group *utilsGroup) convertAddress(c *gin.Context) {
+	conversion, err := group.facade.ConvertAddress(c.Param("address"), c.Query(common.UrlParameterHrp))
+	if err != nil {
+		respondWithUtilsError(c, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"address": conversion}, "", data.ReturnCodeSuccess)
+}
+
+// computeShardIDs returns the shards of the addresses of the request body
+func (group *utilsGroup) computeShardIDs(c *gin.Context) {
+	var addresses []string
+	err := c.ShouldBindJSON(&addresses)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrInvalidAddressesBatch, err)
+		return
+	}
+
+	shards, err := group.facade.ComputeShardIDs(addresses)
+	if err != nil {
+		respondWithUtilsError(c, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, shards, "", data.ReturnCodeSuccess)
+}
+
+// computeContractAddress returns the address of the contract deployed by the deployer parameter at the nonce parameter
+func (group *utilsGroup) computeContractAddress(c *gin.Context) {
+	nonce, err := shared.FetchNonceFromRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, errors.ErrCannotParseNonce)
+		return
+	}
+
+	details, err := group.facade.ComputeContractAddress(c.Param("deployer"), nonce, c.Query(common.UrlParameterVMType))
+	if err != nil {
+		respondWithUtilsError(c, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"contract": details}, "", data.ReturnCodeSuccess)
+}
+
+// getAddressDetails returns the encodings, the shard and the kind of the address parameter
+func (group *utilsGroup) getAddressDetails(c *gin.Context) {
+	details, err := group.facade.GetAddressDetails(c.Param("address"))
+	if err != nil {
+		respondWithUtilsError(c, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"details": details}, "", data.ReturnCodeSuccess)
+}
+
+func respondWithUtilsError(c *gin.Context, err error) {
+	_, isInvalidRequest := err.(*errors.ErrInvalidRequest)
+	if isInvalidRequest {
+		shared.RespondWithBadRequest(c, err.Error())
+		return
+	}
+
+	shared.RespondWithInternalError(c, errors.ErrAddressUtils, err)
+}
@@ -0,0 +1,278 @@
+package groups_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const utilsPath = "/utils"
+
+type addressConversionResponseData struct {
+	Address data.AddressConversion `json:"address"`
+}
+
+type addressConversionResponse struct {
+	Data  addressConversionResponseData `json:"data"`
+	Error string                        `json:"error"`
+	Code  string                        `json:"code"`
+}
+
+type addressesShardsResponse struct {
+	Data  data.AddressesShardsResponseData `json:"data"`
+	Error string                           `json:"error"`
+	Code  string                           `json:"code"`
+}
+
+type contractAddressResponseData struct {
+	Contract data.AddressDetails `json:"contract"`
+}
+
+type contractAddressResponse struct {
+	Data  contractAddressResponseData `json:"data"`
+	Error string                      `json:"error"`
+	Code  string                      `json:"code"`
+}
+
+type addressDetailsResponseData struct {
+	Details data.AddressDetails `json:"details"`
+}
+
+type addressDetailsResponse struct {
+	Data  addressDetailsResponseData `json:"data"`
+	Error string                     `json:"error"`
+	Code  string                     `json:"code"`
+}
+
+func TestNewUtilsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wrong facade, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		wrongFacade := &mock.WrongFacade{}
+		group, err := groups.NewUtilsGroup(wrongFacade)
+		require.Nil(t, group)
+		require.Equal(t, groups.ErrWrongTypeAssertion, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		group, err := groups.NewUtilsGroup(&mock.FacadeStub{})
+		require.Nil(t, err)
+		require.NotNil(t, group)
+	})
+}
+
+func TestUtilsGroup_ConvertAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ConvertAddressCalled: func(address string, hrp string) (*data.AddressConversion, error) {
+				return nil, &apiErrors.ErrInvalidRequest{Message: apiErrors.ErrInvalidAddress.Error(), Reason: "invalid checksum"}
+			},
+		}
+		utilsGroup, err := groups.NewUtilsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(utilsGroup, utilsPath)
+
+		req, _ := http.NewRequest("GET", "/utils/address/convert/erd1invalid", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidAddress.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ConvertAddressCalled: func(address string, hrp string) (*data.AddressConversion, error) {
+				return nil, errors.New("encoding failed")
+			},
+		}
+		utilsGroup, err := groups.NewUtilsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(utilsGroup, utilsPath)
+
+		req, _ := http.NewRequest("GET", "/utils/address/convert/aabb", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrAddressUtils.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedConversion := &data.AddressConversion{Bech32: "test1address", Hex: "aabb", Hrp: "test"}
+		facade := &mock.FacadeStub{
+			ConvertAddressCalled: func(address string, hrp string) (*data.AddressConversion, error) {
+				require.Equal(t, "aabb", address)
+				require.Equal(t, "test", hrp)
+				return expectedConversion, nil
+			},
+		}
+		utilsGroup, err := groups.NewUtilsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(utilsGroup, utilsPath)
+
+		req, _ := http.NewRequest("GET", "/utils/address/convert/aabb?hrp=test", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := addressConversionResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, *expectedConversion, response.Data.Address)
+	})
+}
+
+func TestUtilsGroup_ComputeShardIDs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		utilsGroup, err := groups.NewUtilsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(utilsGroup, utilsPath)
+
+		req, _ := http.NewRequest("POST", "/utils/address/shard", bytes.NewBuffer([]byte(`{"address": "erd1"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidAddressesBatch.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedShards := &data.AddressesShardsResponseData{
+			Shards: map[string]uint32{"erd1first": 0, "erd1second": 2},
+		}
+		facade := &mock.FacadeStub{
+			ComputeShardIDsCalled: func(addresses []string) (*data.AddressesShardsResponseData, error) {
+				require.Equal(t, []string{"erd1first", "erd1second"}, addresses)
+				return expectedShards, nil
+			},
+		}
+		utilsGroup, err := groups.NewUtilsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(utilsGroup, utilsPath)
+
+		req, _ := http.NewRequest("POST", "/utils/address/shard", bytes.NewBuffer([]byte(`["erd1first", "erd1second"]`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := addressesShardsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, *expectedShards, response.Data)
+	})
+}
+
+func TestUtilsGroup_ComputeContractAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		utilsGroup, err := groups.NewUtilsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(utilsGroup, utilsPath)
+
+		req, _ := http.NewRequest("GET", "/utils/address/contract/erd1deployer/nonce/abc", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrCannotParseNonce.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedDetails := &data.AddressDetails{Bech32: "erd1contract", Hex: "aabb", ShardID: 1, IsSmartContract: true, VMType: "0500"}
+		facade := &mock.FacadeStub{
+			ComputeContractAddressCalled: func(deployer string, nonce uint64, vmType string) (*data.AddressDetails, error) {
+				require.Equal(t, "erd1deployer", deployer)
+				require.Equal(t, uint64(7), nonce)
+				require.Equal(t, "0500", vmType)
+				return expectedDetails, nil
+			},
+		}
+		utilsGroup, err := groups.NewUtilsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(utilsGroup, utilsPath)
+
+		req, _ := http.NewRequest("GET", "/utils/address/contract/erd1deployer/nonce/7?vmType=0500", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := contractAddressResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, *expectedDetails, response.Data.Contract)
+	})
+}
+
+func TestUtilsGroup_GetAddressDetails(t *testing.T) {
+	t.Parallel()
+
+	expectedDetails := &data.AddressDetails{
+		Bech32:                "erd1staking",
+		Hex:                   "aabb",
+		ShardID:               4294967295,
+		IsSmartContract:       true,
+		IsSystemSmartContract: true,
+		VMType:                "0001",
+		SystemContractName:    "staking",
+	}
+	facade := &mock.FacadeStub{
+		GetAddressDetailsCalled: func(address string) (*data.AddressDetails, error) {
+			require.Equal(t, "erd1staking", address)
+			return expectedDetails, nil
+		},
+	}
+	utilsGroup, err := groups.NewUtilsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(utilsGroup, utilsPath)
+
+	req, _ := http.NewRequest("GET", "/utils/address/details/erd1staking", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := addressDetailsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, *expectedDetails, response.Data.Details)
+}
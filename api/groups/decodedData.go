@@ -0,0 +1,77 @@
+package groups
+
+import (
+	"encoding/base64"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	decodedDataField  = "decodedData"
+	poolDataField     = "data"
+	poolSenderField   = "sender"
+	poolReceiverField = "receiver"
+)
+
+func decodeTransaction(decoder transactionDataDecoder, tx *transaction.ApiTransactionResult) *data.DecodedTransactionData {
+	return decoder.DecodeTransactionData(tx.Data, tx.Sender, tx.Receiver)
+}
+
+func decodeTransactions(
+	decoder transactionDataDecoder,
+	txs []*transaction.ApiTransactionResult,
+	decodedData map[string]*data.DecodedTransactionData,
+) {
+	for _, tx := range txs {
+		decoded := decodeTransaction(decoder, tx)
+		if decoded == nil {
+			continue
+		}
+
+		decodedData[tx.Hash] = decoded
+	}
+}
+
+// decodeBlockTransactions returns the decoded data of the block's transactions, indexed by transaction hash
+func decodeBlockTransactions(decoder transactionDataDecoder, block *api.Block) map[string]*data.DecodedTransactionData {
+	decodedData := make(map[string]*data.DecodedTransactionData)
+	for _, miniBlock := range block.MiniBlocks {
+		decodeTransactions(decoder, miniBlock.Transactions, decodedData)
+	}
+
+	return decodedData
+}
+
+// decodeHyperblockTransactions returns the decoded data of the hyperblock's transactions, indexed by transaction hash
+func decodeHyperblockTransactions(decoder transactionDataDecoder, hyperblock *api.Hyperblock) map[string]*data.DecodedTransactionData {
+	decodedData := make(map[string]*data.DecodedTransactionData)
+	decodeTransactions(decoder, hyperblock.Transactions, decodedData)
+
+	return decodedData
+}
+
+// decodePoolTransactions adds the decoded data as a new field of the pool transactions which were fetched with their data field
+func decodePoolTransactions(decoder transactionDataDecoder, txs []data.WrappedTransaction) {
+	for _, tx := range txs {
+		dataField, ok := tx.TxFields[poolDataField].(string)
+		if !ok || len(dataField) == 0 {
+			continue
+		}
+
+		dataBytes, err := base64.StdEncoding.DecodeString(dataField)
+		if err != nil {
+			dataBytes = []byte(dataField)
+		}
+
+		sender, _ := tx.TxFields[poolSenderField].(string)
+		receiver, _ := tx.TxFields[poolReceiverField].(string)
+		decoded := decoder.DecodeTransactionData(dataBytes, sender, receiver)
+		if decoded == nil {
+			continue
+		}
+
+		tx.TxFields[decodedDataField] = decoded
+	}
+}
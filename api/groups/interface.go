@@ -1,6 +1,7 @@
 package groups
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -16,12 +17,24 @@ type AccountsFacadeHandler interface {
 	GetShardIDForAddress(address string) (uint32, error)
 	GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error)
 	GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetDecodedAllESDTTokens(address string, options common.AccountQueryOptions) (*data.ESDTTokensMetadataResponseData, error)
 	GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	StreamKeyValuePairs(address string, options common.AccountQueryOptions, filter common.KeyValuePairsQueryOptions, handler func(pair *data.KeyValuePair) error) error
+	GetKeyValuePairsPage(address string, options common.AccountQueryOptions, filter common.KeyValuePairsQueryOptions) (*data.KeyValuePairsPage, error)
 	GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
+	GetAccountsBulk(request *data.AccountsBulkRequest, options common.AccountQueryOptions) (*data.AccountsBulkModel, error)
+	GetAccountStateDiff(address string, fromNonce uint64, toNonce uint64) (*data.AccountStateDiff, error)
+	GetStakingPosition(address string) (*data.StakingPosition, error)
+	SubscribeToAddressActivity(request *data.AddressWatchRequest) (*data.AddressWatchSubscription, error)
+	UnsubscribeFromAddressActivity(subscriptionID string)
+	GetNextNonce(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error)
+	ReleaseNonceReservation(address string, nonce uint64, token string) error
+	GetTransactions(address string, options common.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error)
 	GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetDecodedESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.ESDTTokenMetadataResponseData, error)
 	GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
@@ -33,6 +46,7 @@ type BlockFacadeHandler interface {
 	GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
 	GetAlteredAccountsByNonce(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
 	GetAlteredAccountsByHash(shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
+	DecodeTransactionData(dataField []byte, sender string, receiver string) *data.DecodedTransactionData
 }
 
 // BlocksFacadeHandler interface defines methods that can be used from the facade
@@ -53,6 +67,7 @@ type InternalFacadeHandler interface {
 type HyperBlockFacadeHandler interface {
 	GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 	GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	DecodeTransactionData(dataField []byte, sender string, receiver string) *data.DecodedTransactionData
 }
 
 // NetworkFacadeHandler interface defines methods that can be used from the facade
@@ -88,20 +103,32 @@ type StatusFacadeHandler interface {
 // TransactionFacadeHandler interface defines methods that can be used from the facade
 type TransactionFacadeHandler interface {
 	SendTransaction(tx *data.Transaction) (int, string, error)
-	SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
+	SendTransactionAndWait(ctx context.Context, tx *data.Transaction, options common.TransactionSendOptions) (int, *data.TransactionSendAndWaitResponseData, error)
+	BroadcastTransaction(tx *data.Transaction, numObservers int) (int, *data.TransactionBroadcastResponseData, error)
+	SendMultipleTransactions(txs []*data.Transaction, idempotencyKey string) (data.MultipleTransactionsResponseData, error)
+	SendOrderedTransactions(txs []*data.Transaction, stopOnRejection bool) (*data.OrderedTransactionsResponseData, error)
 	SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
+	SimulateTransactionsBundle(txs []*data.Transaction, checkSignature bool) (*data.BundleSimulationResponseData, error)
 	IsFaucetEnabled() bool
 	SendUserFunds(receiver string, value *big.Int) error
 	TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
 	GetTransactionStatus(txHash string, sender string) (string, error)
 	GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error)
+	GetTransactionExecutionGraph(txHash string) (*data.TransactionExecutionGraph, error)
 	GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error)
 	GetTransactionsPool(fields string) (*data.TransactionsPool, error)
 	GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error)
 	GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
+	DecodeTransactionData(dataField []byte, sender string, receiver string) *data.DecodedTransactionData
 	GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error)
+	GetTrackedTransaction(txHash string) (*data.TrackedTransaction, error)
+	ComputeTransactionFee(request *data.TransactionFeeRequest) (*data.TransactionFeeResponseData, error)
+	BuildTransaction(request *data.TransactionBuildRequest) (*data.TransactionBuildResponseData, error)
+	GetMempoolPage(options common.MempoolQueryOptions) (*data.MempoolPage, error)
+	GetMempoolStatistics() (*data.MempoolStatistics, error)
+	GetGasPriceRecommendation(shardID uint32) (*data.GasPriceRecommendation, error)
 }
 
 // ProofFacadeHandler interface defines methods that can be used from the facade
@@ -134,3 +161,27 @@ type AboutFacadeHandler interface {
 	GetAboutInfo() (*data.GenericAPIResponse, error)
 	GetNodesVersions() (*data.GenericAPIResponse, error)
 }
+
+// UsernamesFacadeHandler defines the methods that can be used from the facade
+type UsernamesFacadeHandler interface {
+	ResolveUsername(username string) (*data.UsernameResolution, error)
+	ResolveUsernames(usernames []string) (*data.UsernamesResolutionResponseData, error)
+}
+
+// TokensFacadeHandler defines the methods that can be used from the facade
+type TokensFacadeHandler interface {
+	GetTokenProfile(identifier string) (*data.ESDTTokenProfile, error)
+}
+
+// UtilsFacadeHandler defines the methods that can be used from the facade
+type UtilsFacadeHandler interface {
+	ConvertAddress(address string, hrp string) (*data.AddressConversion, error)
+	ComputeShardIDs(addresses []string) (*data.AddressesShardsResponseData, error)
+	ComputeContractAddress(deployer string, nonce uint64, vmType string) (*data.AddressDetails, error)
+	GetAddressDetails(address string) (*data.AddressDetails, error)
+}
+
+// transactionDataDecoder defines the facade method used to decode the data field of transactions
+type transactionDataDecoder interface {
+	DecodeTransactionData(dataField []byte, sender string, receiver string) *data.DecodedTransactionData
+}
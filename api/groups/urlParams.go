@@ -3,9 +3,12 @@ package groups
 import (
 	"encoding/hex"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/core"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 )
 
@@ -28,7 +31,17 @@ func parseBlockQueryOptions(c *gin.Context) (common.BlockQueryOptions, error) {
 		return common.BlockQueryOptions{}, err
 	}
 
-	options := common.BlockQueryOptions{WithTransactions: withTxs, WithLogs: withLogs, ForHyperblock: forHyperblock}
+	withDecodedData, err := parseBoolUrlParam(c, common.UrlParameterWithDecodedData)
+	if err != nil {
+		return common.BlockQueryOptions{}, err
+	}
+
+	options := common.BlockQueryOptions{
+		WithTransactions: withTxs,
+		WithLogs:         withLogs,
+		ForHyperblock:    forHyperblock,
+		WithDecodedData:  withDecodedData,
+	}
 	return options, nil
 }
 
@@ -56,11 +69,17 @@ func parseHyperblockQueryOptions(c *gin.Context) (common.HyperblockQueryOptions,
 		}
 	}
 
+	withDecodedData, err := parseBoolUrlParam(c, common.UrlParameterWithDecodedData)
+	if err != nil {
+		return common.HyperblockQueryOptions{}, err
+	}
+
 	return common.HyperblockQueryOptions{
 		WithLogs:               withLogs,
 		NotarizedAtSource:      notarizedAtSource,
 		WithAlteredAccounts:    withAlteredAccounts,
 		AlteredAccountsOptions: alteredAccountsOptions,
+		WithDecodedData:        withDecodedData,
 	}, nil
 }
 
@@ -129,7 +148,12 @@ func parseTransactionQueryOptions(c *gin.Context) (common.TransactionQueryOption
 		return common.TransactionQueryOptions{}, err
 	}
 
-	options := common.TransactionQueryOptions{WithResults: withResults}
+	withDecodedData, err := parseBoolUrlParam(c, common.UrlParameterWithDecodedData)
+	if err != nil {
+		return common.TransactionQueryOptions{}, err
+	}
+
+	options := common.TransactionQueryOptions{WithResults: withResults, WithDecodedData: withDecodedData}
 	return options, nil
 }
 
@@ -143,6 +167,213 @@ func parseTransactionSimulationOptions(c *gin.Context) (common.TransactionSimula
 	return options, nil
 }
 
+func parseTransactionSendOptions(c *gin.Context) (common.TransactionSendOptions, error) {
+	waitFor := parseStringUrlParam(c, common.UrlParameterWaitFor)
+	switch waitFor {
+	case "", common.TransactionWaitForExecuted, common.TransactionWaitForCompleted:
+	default:
+		return common.TransactionSendOptions{}, apiErrors.ErrInvalidWaitForOption
+	}
+
+	timeout := common.DefaultTransactionWaitTimeout
+	timeoutParam := parseStringUrlParam(c, common.UrlParameterTimeout)
+	if len(timeoutParam) > 0 {
+		var err error
+		timeout, err = time.ParseDuration(timeoutParam)
+		if err != nil || timeout <= 0 || timeout > common.MaxTransactionWaitTimeout {
+			return common.TransactionSendOptions{}, apiErrors.ErrInvalidWaitTimeout
+		}
+	}
+
+	broadcastObservers, err := parseUint32UrlParam(c, common.UrlParameterBroadcast)
+	if err != nil || broadcastObservers.Value > common.MaxTransactionBroadcastObservers {
+		return common.TransactionSendOptions{}, apiErrors.ErrInvalidBroadcastObservers
+	}
+	if broadcastObservers.Value > 0 && len(waitFor) > 0 {
+		return common.TransactionSendOptions{}, apiErrors.ErrBroadcastWithWaitFor
+	}
+
+	return common.TransactionSendOptions{
+		WaitFor:            waitFor,
+		Timeout:            timeout,
+		BroadcastObservers: int(broadcastObservers.Value),
+	}, nil
+}
+
+func parseTransactionsBatchOptions(c *gin.Context) (common.TransactionsBatchOptions, error) {
+	ordered, err := parseBoolUrlParam(c, common.UrlParameterOrdered)
+	if err != nil {
+		return common.TransactionsBatchOptions{}, err
+	}
+
+	stopOnRejection, err := parseBoolUrlParam(c, common.UrlParameterStopOnRejection)
+	if err != nil {
+		return common.TransactionsBatchOptions{}, err
+	}
+
+	return common.TransactionsBatchOptions{Ordered: ordered, StopOnRejection: stopOnRejection}, nil
+}
+
+func parseTransactionsHistoryOptions(c *gin.Context) (common.TransactionsHistoryOptions, error) {
+	from, err := parseUint32UrlParam(c, common.UrlParameterFrom)
+	if err != nil {
+		return common.TransactionsHistoryOptions{}, apiErrors.ErrInvalidPagination
+	}
+
+	size, err := parseUint32UrlParam(c, common.UrlParameterSize)
+	if err != nil {
+		return common.TransactionsHistoryOptions{}, apiErrors.ErrInvalidPagination
+	}
+	if !size.HasValue {
+		size.Value = common.DefaultTransactionsHistorySize
+	}
+	if size.Value == 0 || size.Value > common.MaxTransactionsHistorySize ||
+		uint64(from.Value)+uint64(size.Value) > common.MaxTransactionsHistoryWindow {
+		return common.TransactionsHistoryOptions{}, apiErrors.ErrInvalidPagination
+	}
+
+	order := parseStringUrlParam(c, common.UrlParameterOrder)
+	switch order {
+	case "":
+		order = common.OrderDescending
+	case common.OrderAscending, common.OrderDescending:
+	default:
+		return common.TransactionsHistoryOptions{}, apiErrors.ErrInvalidSortOrder
+	}
+
+	after, err := parseUint64UrlParam(c, common.UrlParameterAfter)
+	if err != nil {
+		return common.TransactionsHistoryOptions{}, apiErrors.ErrInvalidTimeFilter
+	}
+
+	before, err := parseUint64UrlParam(c, common.UrlParameterBefore)
+	if err != nil {
+		return common.TransactionsHistoryOptions{}, apiErrors.ErrInvalidTimeFilter
+	}
+	if after.HasValue && before.HasValue && after.Value > before.Value {
+		return common.TransactionsHistoryOptions{}, apiErrors.ErrInvalidTimeFilter
+	}
+
+	return common.TransactionsHistoryOptions{
+		From:     int(from.Value),
+		Size:     int(size.Value),
+		Order:    order,
+		Sender:   parseStringUrlParam(c, common.UrlParameterSenderFilter),
+		Receiver: parseStringUrlParam(c, common.UrlParameterReceiverFilter),
+		After:    after.Value,
+		Before:   before.Value,
+	}, nil
+}
+
+func parseMempoolQueryOptions(c *gin.Context) (common.MempoolQueryOptions, error) {
+	size, err := parseUint32UrlParam(c, common.UrlParameterSize)
+	if err != nil {
+		return common.MempoolQueryOptions{}, apiErrors.ErrInvalidPagination
+	}
+	if !size.HasValue {
+		size.Value = common.DefaultMempoolPageSize
+	}
+	if size.Value == 0 || size.Value > common.MaxMempoolPageSize {
+		return common.MempoolQueryOptions{}, apiErrors.ErrInvalidPagination
+	}
+
+	sortBy := parseStringUrlParam(c, common.UrlParameterSortBy)
+	switch sortBy {
+	case "":
+		sortBy = common.MempoolSortByGasPrice
+	case common.MempoolSortByGasPrice, common.MempoolSortByNonce:
+	default:
+		return common.MempoolQueryOptions{}, apiErrors.ErrInvalidMempoolFilter
+	}
+
+	order := parseStringUrlParam(c, common.UrlParameterOrder)
+	switch order {
+	case "":
+		order = common.OrderDescending
+	case common.OrderAscending, common.OrderDescending:
+	default:
+		return common.MempoolQueryOptions{}, apiErrors.ErrInvalidSortOrder
+	}
+
+	minGasPrice, err := parseUint64UrlParam(c, common.UrlParameterMinGasPrice)
+	if err != nil {
+		return common.MempoolQueryOptions{}, apiErrors.ErrInvalidMempoolFilter
+	}
+
+	shardID, err := parseUint32UrlParam(c, common.UrlParameterShardID)
+	if err != nil {
+		return common.MempoolQueryOptions{}, apiErrors.ErrInvalidMempoolFilter
+	}
+
+	minAge, err := parseDurationUrlParam(c, common.UrlParameterMinAge)
+	if err != nil {
+		return common.MempoolQueryOptions{}, apiErrors.ErrInvalidMempoolFilter
+	}
+
+	maxAge, err := parseDurationUrlParam(c, common.UrlParameterMaxAge)
+	if err != nil {
+		return common.MempoolQueryOptions{}, apiErrors.ErrInvalidMempoolFilter
+	}
+	if maxAge > 0 && minAge > maxAge {
+		return common.MempoolQueryOptions{}, apiErrors.ErrInvalidMempoolFilter
+	}
+
+	return common.MempoolQueryOptions{
+		Cursor:      parseStringUrlParam(c, common.UrlParameterCursor),
+		Size:        int(size.Value),
+		Receiver:    parseStringUrlParam(c, common.UrlParameterReceiverFilter),
+		Function:    parseStringUrlParam(c, common.UrlParameterFunction),
+		MinGasPrice: minGasPrice.Value,
+		ShardID:     shardID,
+		MinAge:      minAge,
+		MaxAge:      maxAge,
+		SortBy:      sortBy,
+		Order:       order,
+	}, nil
+}
+
+// parseKeyValuePairsQueryOptions parses the hex encoded key prefix and cursor. The prefix can hold an odd number of
+// hex characters, as it filters the hex encoded keys
+func parseKeyValuePairsQueryOptions(c *gin.Context) (common.KeyValuePairsQueryOptions, error) {
+	prefix := strings.ToLower(parseStringUrlParam(c, common.UrlParameterPrefix))
+	_, err := hex.DecodeString(prefix + strings.Repeat("0", len(prefix)%2))
+	if err != nil {
+		return common.KeyValuePairsQueryOptions{}, apiErrors.ErrInvalidKeyValuePairsFilter
+	}
+
+	cursor := strings.ToLower(parseStringUrlParam(c, common.UrlParameterCursor))
+	_, err = hex.DecodeString(cursor)
+	if err != nil {
+		return common.KeyValuePairsQueryOptions{}, apiErrors.ErrInvalidKeyValuePairsFilter
+	}
+
+	return common.KeyValuePairsQueryOptions{
+		Prefix: prefix,
+		Cursor: cursor,
+	}, nil
+}
+
+func parseKeyValuePairsPageOptions(c *gin.Context) (common.KeyValuePairsQueryOptions, error) {
+	options, err := parseKeyValuePairsQueryOptions(c)
+	if err != nil {
+		return common.KeyValuePairsQueryOptions{}, err
+	}
+
+	size, err := parseUint32UrlParam(c, common.UrlParameterSize)
+	if err != nil {
+		return common.KeyValuePairsQueryOptions{}, apiErrors.ErrInvalidPagination
+	}
+	if !size.HasValue {
+		size.Value = common.DefaultKeyValuePairsPageSize
+	}
+	if size.Value == 0 || size.Value > common.MaxKeyValuePairsPageSize {
+		return common.KeyValuePairsQueryOptions{}, apiErrors.ErrInvalidPagination
+	}
+	options.Size = int(size.Value)
+
+	return options, nil
+}
+
 func parseBoolUrlParam(c *gin.Context, name string) (bool, error) {
 	return parseBoolUrlParamWithDefault(c, name, false)
 }
@@ -160,6 +391,23 @@ func parseStringUrlParam(c *gin.Context, name string) string {
 	return c.Request.URL.Query().Get(name)
 }
 
+func parseStringListUrlParam(c *gin.Context, name string) []string {
+	param := c.Request.URL.Query().Get(name)
+	if param == "" {
+		return nil
+	}
+
+	values := make([]string, 0)
+	for _, value := range strings.Split(param, ",") {
+		value = strings.TrimSpace(value)
+		if len(value) > 0 {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
 func parseUint32UrlParam(c *gin.Context, name string) (core.OptionalUint32, error) {
 	param := c.Request.URL.Query().Get(name)
 	if param == "" {
@@ -194,6 +442,23 @@ func parseUint64UrlParam(c *gin.Context, name string) (core.OptionalUint64, erro
 	}, nil
 }
 
+func parseDurationUrlParam(c *gin.Context, name string) (time.Duration, error) {
+	param := c.Request.URL.Query().Get(name)
+	if param == "" {
+		return 0, nil
+	}
+
+	duration, err := time.ParseDuration(param)
+	if err != nil {
+		return 0, err
+	}
+	if duration < 0 {
+		return 0, apiErrors.ErrBadUrlParams
+	}
+
+	return duration, nil
+}
+
 func parseHexBytesUrlParam(c *gin.Context, name string) ([]byte, error) {
 	param := c.Request.URL.Query().Get(name)
 	if param == "" {
@@ -219,12 +484,18 @@ func parseTransactionsPoolQueryOptions(c *gin.Context) (common.TransactionsPoolO
 		return common.TransactionsPoolOptions{}, err
 	}
 
+	withDecodedData, err := parseBoolUrlParam(c, common.UrlParameterWithDecodedData)
+	if err != nil {
+		return common.TransactionsPoolOptions{}, err
+	}
+
 	return common.TransactionsPoolOptions{
-		ShardID:   parseStringUrlParam(c, common.UrlParameterShardID),
-		Sender:    parseStringUrlParam(c, common.UrlParameterSender),
-		Fields:    parseStringUrlParam(c, common.UrlParameterFields),
-		LastNonce: lastNonce,
-		NonceGaps: nonceGaps,
+		ShardID:         parseStringUrlParam(c, common.UrlParameterShardID),
+		Sender:          parseStringUrlParam(c, common.UrlParameterSender),
+		Fields:          parseStringUrlParam(c, common.UrlParameterFields),
+		LastNonce:       lastNonce,
+		NonceGaps:       nonceGaps,
+		WithDecodedData: withDecodedData,
 	}, nil
 }
 
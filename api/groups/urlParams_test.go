@@ -145,6 +145,10 @@ func TestParseTransactionQueryOptions(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, common.TransactionQueryOptions{WithResults: true}, options)
 
+	options, err = parseTransactionQueryOptions(createDummyGinContextWithQuery("withResults=true&withDecodedData=true"))
+	require.Nil(t, err)
+	require.Equal(t, common.TransactionQueryOptions{WithResults: true, WithDecodedData: true}, options)
+
 	options, err = parseTransactionQueryOptions(createDummyGinContextWithQuery(""))
 	require.Nil(t, err)
 	require.Empty(t, options)
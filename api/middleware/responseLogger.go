@@ -20,6 +20,8 @@ const (
 	prefixBadRequest           = "[bad request]"
 	prefixInternalError        = "[internal error]"
 	maxLengthRequestOrResponse = 400
+	// only the beginning of the response is kept for logging, so that streamed responses are not held in memory
+	maxLengthCapturedResponse = 64 * 1024
 )
 
 // TODO: remove this file and use the same middleware from mx-chain-go after it is merged
@@ -127,6 +129,18 @@ type bodyWriter struct {
 }
 
 func (w bodyWriter) Write(b []byte) (int, error) {
-	w.body.Write(b)
+	remaining := maxLengthCapturedResponse - w.body.Len()
+	if remaining > 0 {
+		w.body.Write(b[:minInt(remaining, len(b))])
+	}
+
 	return w.ResponseWriter.Write(b)
 }
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
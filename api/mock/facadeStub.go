@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -15,24 +16,45 @@ type FacadeStub struct {
 	IsFaucetEnabledHandler                       func() bool
 	GetAccountHandler                            func(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
 	GetAccountsHandler                           func(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
+	GetAccountStateDiffHandler                   func(address string, fromNonce uint64, toNonce uint64) (*data.AccountStateDiff, error)
+	GetStakingPositionHandler                    func(address string) (*data.StakingPosition, error)
+	StreamKeyValuePairsHandler                   func(address string, options common.AccountQueryOptions, filter common.KeyValuePairsQueryOptions, handler func(pair *data.KeyValuePair) error) error
+	GetKeyValuePairsPageHandler                  func(address string, options common.AccountQueryOptions, filter common.KeyValuePairsQueryOptions) (*data.KeyValuePairsPage, error)
+	SubscribeToAddressActivityHandler            func(request *data.AddressWatchRequest) (*data.AddressWatchSubscription, error)
+	UnsubscribeFromAddressActivityHandler        func(subscriptionID string)
+	GetAccountsBulkHandler                       func(request *data.AccountsBulkRequest, options common.AccountQueryOptions) (*data.AccountsBulkModel, error)
+	GetNextNonceHandler                          func(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error)
+	ReleaseNonceReservationHandler               func(address string, nonce uint64, token string) error
 	GetShardIDForAddressHandler                  func(address string) (uint32, error)
 	GetValueForKeyHandler                        func(address string, key string, options common.AccountQueryOptions) (string, error)
 	GetKeyValuePairsHandler                      func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetESDTTokenDataCalled                       func(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetESDTNftTokenDataCalled                    func(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetDecodedESDTNftTokenDataCalled             func(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.ESDTTokenMetadataResponseData, error)
+	GetDecodedAllESDTTokensCalled                func(address string, options common.AccountQueryOptions) (*data.ESDTTokensMetadataResponseData, error)
 	GetESDTsWithRoleCalled                       func(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetNFTTokenIDsRegisteredByAddressCalled      func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetAllESDTTokensCalled                       func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetTransactionsHandler                       func(address string) ([]data.DatabaseTransaction, error)
+	GetTransactionsHandler                       func(address string, options common.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error)
 	GetTransactionHandler                        func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetTransactionsPoolHandler                   func(fields string) (*data.TransactionsPool, error)
 	GetTransactionsPoolForShardHandler           func(shardID uint32, fields string) (*data.TransactionsPool, error)
 	GetTransactionsPoolForSenderHandler          func(sender, fields string) (*data.TransactionsPoolForSender, error)
 	GetLastPoolNonceForSenderHandler             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderHandler func(sender string) (*data.TransactionsPoolNonceGaps, error)
+	GetTrackedTransactionHandler                 func(txHash string) (*data.TrackedTransaction, error)
+	ComputeTransactionFeeHandler                 func(request *data.TransactionFeeRequest) (*data.TransactionFeeResponseData, error)
+	GetMempoolPageHandler                        func(options common.MempoolQueryOptions) (*data.MempoolPage, error)
+	GetMempoolStatisticsHandler                  func() (*data.MempoolStatistics, error)
+	GetGasPriceRecommendationHandler             func(shardID uint32) (*data.GasPriceRecommendation, error)
+	BuildTransactionHandler                      func(request *data.TransactionBuildRequest) (*data.TransactionBuildResponseData, error)
 	SendTransactionHandler                       func(tx *data.Transaction) (int, string, error)
-	SendMultipleTransactionsHandler              func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
+	BroadcastTransactionHandler                  func(tx *data.Transaction, numObservers int) (int, *data.TransactionBroadcastResponseData, error)
+	SendTransactionAndWaitHandler                func(ctx context.Context, tx *data.Transaction, options common.TransactionSendOptions) (int, *data.TransactionSendAndWaitResponseData, error)
+	SendMultipleTransactionsHandler              func(txs []*data.Transaction, idempotencyKey string) (data.MultipleTransactionsResponseData, error)
+	SendOrderedTransactionsHandler               func(txs []*data.Transaction, stopOnRejection bool) (*data.OrderedTransactionsResponseData, error)
 	SimulateTransactionHandler                   func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
+	SimulateTransactionsBundleHandler            func(txs []*data.Transaction, checkSignature bool) (*data.BundleSimulationResponseData, error)
 	SendUserFundsCalled                          func(receiver string, value *big.Int) error
 	ExecuteSCQueryHandler                        func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
 	GetHeartbeatDataHandler                      func() (*data.HeartbeatResponse, error)
@@ -41,6 +63,7 @@ type FacadeStub struct {
 	TransactionCostRequestHandler                func(tx *data.Transaction) (*data.TxCostResponseData, error)
 	GetTransactionStatusHandler                  func(txHash string, sender string) (string, error)
 	GetProcessedTransactionStatusHandler         func(txHash string) (*data.ProcessStatusResponse, error)
+	GetTransactionExecutionGraphHandler          func(txHash string) (*data.TransactionExecutionGraph, error)
 	GetConfigMetricsHandler                      func() (*data.GenericAPIResponse, error)
 	GetNetworkMetricsHandler                     func(shardID uint32) (*data.GenericAPIResponse, error)
 	GetAllIssuedESDTsHandler                     func(tokenType string) (*data.GenericAPIResponse, error)
@@ -60,6 +83,7 @@ type FacadeStub struct {
 	GetInternalStartOfEpochValidatorsInfoCalled  func(epoch uint32) (*data.ValidatorsInfoApiResponse, error)
 	GetHyperBlockByHashCalled                    func(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 	GetHyperBlockByNonceCalled                   func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	DecodeTransactionDataCalled                  func(dataField []byte, sender string, receiver string) *data.DecodedTransactionData
 	ReloadObserversCalled                        func() data.NodesReloadResponse
 	ReloadFullHistoryObserversCalled             func() data.NodesReloadResponse
 	GetProofCalled                               func(string, string) (*data.GenericAPIResponse, error)
@@ -75,6 +99,13 @@ type FacadeStub struct {
 	IsOldStorageForTokenCalled                   func(tokenID string, nonce uint64) (bool, error)
 	GetAboutInfoCalled                           func() (*data.GenericAPIResponse, error)
 	GetNodesVersionsCalled                       func() (*data.GenericAPIResponse, error)
+	ResolveUsernameCalled                        func(username string) (*data.UsernameResolution, error)
+	ResolveUsernamesCalled                       func(usernames []string) (*data.UsernamesResolutionResponseData, error)
+	GetTokenProfileCalled                        func(identifier string) (*data.ESDTTokenProfile, error)
+	ConvertAddressCalled                         func(address string, hrp string) (*data.AddressConversion, error)
+	ComputeShardIDsCalled                        func(addresses []string) (*data.AddressesShardsResponseData, error)
+	ComputeContractAddressCalled                 func(deployer string, nonce uint64, vmType string) (*data.AddressDetails, error)
+	GetAddressDetailsCalled                      func(address string) (*data.AddressDetails, error)
 	GetAlteredAccountsByNonceCalled              func(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
 	GetAlteredAccountsByHashCalled               func(shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
 	GetTriesStatisticsCalled                     func(shardID uint32) (*data.TrieStatisticsAPIResponse, error)
@@ -276,6 +307,57 @@ func (f *FacadeStub) GetAccounts(addresses []string, options common.AccountQuery
 	return f.GetAccountsHandler(addresses, options)
 }
 
+// GetAccountStateDiff -
+func (f *FacadeStub) GetAccountStateDiff(address string, fromNonce uint64, toNonce uint64) (*data.AccountStateDiff, error) {
+	return f.GetAccountStateDiffHandler(address, fromNonce, toNonce)
+}
+
+// GetStakingPosition -
+func (f *FacadeStub) GetStakingPosition(address string) (*data.StakingPosition, error) {
+	return f.GetStakingPositionHandler(address)
+}
+
+// StreamKeyValuePairs -
+func (f *FacadeStub) StreamKeyValuePairs(address string, options common.AccountQueryOptions, filter common.KeyValuePairsQueryOptions, handler func(pair *data.KeyValuePair) error) error {
+	return f.StreamKeyValuePairsHandler(address, options, filter, handler)
+}
+
+// GetKeyValuePairsPage -
+func (f *FacadeStub) GetKeyValuePairsPage(address string, options common.AccountQueryOptions, filter common.KeyValuePairsQueryOptions) (*data.KeyValuePairsPage, error) {
+	return f.GetKeyValuePairsPageHandler(address, options, filter)
+}
+
+// SubscribeToAddressActivity -
+func (f *FacadeStub) SubscribeToAddressActivity(request *data.AddressWatchRequest) (*data.AddressWatchSubscription, error) {
+	return f.SubscribeToAddressActivityHandler(request)
+}
+
+// UnsubscribeFromAddressActivity -
+func (f *FacadeStub) UnsubscribeFromAddressActivity(subscriptionID string) {
+	if f.UnsubscribeFromAddressActivityHandler != nil {
+		f.UnsubscribeFromAddressActivityHandler(subscriptionID)
+	}
+}
+
+// GetAccountsBulk -
+func (f *FacadeStub) GetAccountsBulk(request *data.AccountsBulkRequest, options common.AccountQueryOptions) (*data.AccountsBulkModel, error) {
+	return f.GetAccountsBulkHandler(request, options)
+}
+
+// GetNextNonce -
+func (f *FacadeStub) GetNextNonce(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error) {
+	return f.GetNextNonceHandler(address, options)
+}
+
+// ReleaseNonceReservation -
+func (f *FacadeStub) ReleaseNonceReservation(address string, nonce uint64, token string) error {
+	if f.ReleaseNonceReservationHandler != nil {
+		return f.ReleaseNonceReservationHandler(address, nonce, token)
+	}
+
+	return nil
+}
+
 // GetKeyValuePairs -
 func (f *FacadeStub) GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return f.GetKeyValuePairsHandler(address, options)
@@ -323,6 +405,24 @@ func (f *FacadeStub) GetESDTNftTokenData(address string, key string, nonce uint6
 	return nil, nil
 }
 
+// GetDecodedESDTNftTokenData -
+func (f *FacadeStub) GetDecodedESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.ESDTTokenMetadataResponseData, error) {
+	if f.GetDecodedESDTNftTokenDataCalled != nil {
+		return f.GetDecodedESDTNftTokenDataCalled(address, key, nonce, options)
+	}
+
+	return nil, nil
+}
+
+// GetDecodedAllESDTTokens -
+func (f *FacadeStub) GetDecodedAllESDTTokens(address string, options common.AccountQueryOptions) (*data.ESDTTokensMetadataResponseData, error) {
+	if f.GetDecodedAllESDTTokensCalled != nil {
+		return f.GetDecodedAllESDTTokensCalled(address, options)
+	}
+
+	return nil, nil
+}
+
 // IsOldStorageForToken -
 func (f *FacadeStub) IsOldStorageForToken(tokenID string, nonce uint64) (bool, error) {
 	if f.IsOldStorageForTokenCalled != nil {
@@ -333,8 +433,8 @@ func (f *FacadeStub) IsOldStorageForToken(tokenID string, nonce uint64) (bool, e
 }
 
 // GetTransactions -
-func (f *FacadeStub) GetTransactions(address string) ([]data.DatabaseTransaction, error) {
-	return f.GetTransactionsHandler(address)
+func (f *FacadeStub) GetTransactions(address string, options common.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	return f.GetTransactionsHandler(address, options)
 }
 
 // GetTransactionByHashAndSenderAddress -
@@ -392,11 +492,79 @@ func (f *FacadeStub) GetTransactionsPoolNonceGapsForSender(sender string) (*data
 	return nil, nil
 }
 
+// ComputeTransactionFee -
+func (f *FacadeStub) ComputeTransactionFee(request *data.TransactionFeeRequest) (*data.TransactionFeeResponseData, error) {
+	if f.ComputeTransactionFeeHandler != nil {
+		return f.ComputeTransactionFeeHandler(request)
+	}
+
+	return nil, nil
+}
+
+// GetMempoolPage -
+func (f *FacadeStub) GetMempoolPage(options common.MempoolQueryOptions) (*data.MempoolPage, error) {
+	if f.GetMempoolPageHandler != nil {
+		return f.GetMempoolPageHandler(options)
+	}
+
+	return nil, nil
+}
+
+// GetMempoolStatistics -
+func (f *FacadeStub) GetMempoolStatistics() (*data.MempoolStatistics, error) {
+	if f.GetMempoolStatisticsHandler != nil {
+		return f.GetMempoolStatisticsHandler()
+	}
+
+	return nil, nil
+}
+
+// GetGasPriceRecommendation -
+func (f *FacadeStub) GetGasPriceRecommendation(shardID uint32) (*data.GasPriceRecommendation, error) {
+	if f.GetGasPriceRecommendationHandler != nil {
+		return f.GetGasPriceRecommendationHandler(shardID)
+	}
+
+	return nil, nil
+}
+
+// BuildTransaction -
+func (f *FacadeStub) BuildTransaction(request *data.TransactionBuildRequest) (*data.TransactionBuildResponseData, error) {
+	if f.BuildTransactionHandler != nil {
+		return f.BuildTransactionHandler(request)
+	}
+
+	return nil, nil
+}
+
+// GetTrackedTransaction -
+func (f *FacadeStub) GetTrackedTransaction(txHash string) (*data.TrackedTransaction, error) {
+	if f.GetTrackedTransactionHandler != nil {
+		return f.GetTrackedTransactionHandler(txHash)
+	}
+
+	return nil, nil
+}
+
 // SendTransaction -
 func (f *FacadeStub) SendTransaction(tx *data.Transaction) (int, string, error) {
 	return f.SendTransactionHandler(tx)
 }
 
+// SendTransactionAndWait -
+func (f *FacadeStub) SendTransactionAndWait(ctx context.Context, tx *data.Transaction, options common.TransactionSendOptions) (int, *data.TransactionSendAndWaitResponseData, error) {
+	return f.SendTransactionAndWaitHandler(ctx, tx, options)
+}
+
+// BroadcastTransaction -
+func (f *FacadeStub) BroadcastTransaction(tx *data.Transaction, numObservers int) (int, *data.TransactionBroadcastResponseData, error) {
+	if f.BroadcastTransactionHandler != nil {
+		return f.BroadcastTransactionHandler(tx, numObservers)
+	}
+
+	return 0, nil, nil
+}
+
 // SimulateTransaction -
 func (f *FacadeStub) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
 	return f.SimulateTransactionHandler(tx, checkSignature)
@@ -408,8 +576,22 @@ func (f *FacadeStub) GetAddressConverter() (core.PubkeyConverter, error) {
 }
 
 // SendMultipleTransactions -
-func (f *FacadeStub) SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
-	return f.SendMultipleTransactionsHandler(txs)
+func (f *FacadeStub) SendMultipleTransactions(txs []*data.Transaction, idempotencyKey string) (data.MultipleTransactionsResponseData, error) {
+	return f.SendMultipleTransactionsHandler(txs, idempotencyKey)
+}
+
+// SendOrderedTransactions -
+func (f *FacadeStub) SendOrderedTransactions(txs []*data.Transaction, stopOnRejection bool) (*data.OrderedTransactionsResponseData, error) {
+	return f.SendOrderedTransactionsHandler(txs, stopOnRejection)
+}
+
+// SimulateTransactionsBundle -
+func (f *FacadeStub) SimulateTransactionsBundle(txs []*data.Transaction, checkSignature bool) (*data.BundleSimulationResponseData, error) {
+	if f.SimulateTransactionsBundleHandler != nil {
+		return f.SimulateTransactionsBundleHandler(txs, checkSignature)
+	}
+
+	return nil, nil
 }
 
 // TransactionCostRequest -
@@ -427,6 +609,15 @@ func (f *FacadeStub) GetProcessedTransactionStatus(txHash string) (*data.Process
 	return f.GetProcessedTransactionStatusHandler(txHash)
 }
 
+// GetTransactionExecutionGraph -
+func (f *FacadeStub) GetTransactionExecutionGraph(txHash string) (*data.TransactionExecutionGraph, error) {
+	if f.GetTransactionExecutionGraphHandler != nil {
+		return f.GetTransactionExecutionGraphHandler(txHash)
+	}
+
+	return nil, nil
+}
+
 // SendUserFunds -
 func (f *FacadeStub) SendUserFunds(receiver string, value *big.Int) error {
 	return f.SendUserFundsCalled(receiver, value)
@@ -520,6 +711,41 @@ func (f *FacadeStub) GetNodesVersions() (*data.GenericAPIResponse, error) {
 	return f.GetNodesVersionsCalled()
 }
 
+// ResolveUsername -
+func (f *FacadeStub) ResolveUsername(username string) (*data.UsernameResolution, error) {
+	return f.ResolveUsernameCalled(username)
+}
+
+// ResolveUsernames -
+func (f *FacadeStub) ResolveUsernames(usernames []string) (*data.UsernamesResolutionResponseData, error) {
+	return f.ResolveUsernamesCalled(usernames)
+}
+
+// GetTokenProfile -
+func (f *FacadeStub) GetTokenProfile(identifier string) (*data.ESDTTokenProfile, error) {
+	return f.GetTokenProfileCalled(identifier)
+}
+
+// ConvertAddress -
+func (f *FacadeStub) ConvertAddress(address string, hrp string) (*data.AddressConversion, error) {
+	return f.ConvertAddressCalled(address, hrp)
+}
+
+// ComputeShardIDs -
+func (f *FacadeStub) ComputeShardIDs(addresses []string) (*data.AddressesShardsResponseData, error) {
+	return f.ComputeShardIDsCalled(addresses)
+}
+
+// ComputeContractAddress -
+func (f *FacadeStub) ComputeContractAddress(deployer string, nonce uint64, vmType string) (*data.AddressDetails, error) {
+	return f.ComputeContractAddressCalled(deployer, nonce, vmType)
+}
+
+// GetAddressDetails -
+func (f *FacadeStub) GetAddressDetails(address string) (*data.AddressDetails, error) {
+	return f.GetAddressDetailsCalled(address)
+}
+
 // GetAlteredAccountsByNonce -
 func (f *FacadeStub) GetAlteredAccountsByNonce(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
 	if f.GetAlteredAccountsByNonceCalled != nil {
@@ -577,6 +803,14 @@ func (f *FacadeStub) GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.W
 	return &data.WaitingEpochsLeftApiResponse{}, nil
 }
 
+// DecodeTransactionData -
+func (f *FacadeStub) DecodeTransactionData(dataField []byte, sender string, receiver string) *data.DecodedTransactionData {
+	if f.DecodeTransactionDataCalled != nil {
+		return f.DecodeTransactionDataCalled(dataField, sender, receiver)
+	}
+	return nil
+}
+
 // WrongFacade is a struct that can be used as a wrong implementation of the node router handler
 type WrongFacade struct {
 }
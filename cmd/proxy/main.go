@@ -16,6 +16,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 	"github.com/multiversx/mx-chain-core-go/core/sharding"
 	hasherFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
 	marshalFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-logger-go/file"
@@ -26,8 +27,16 @@ import (
 	"github.com/multiversx/mx-chain-proxy-go/metrics"
 	"github.com/multiversx/mx-chain-proxy-go/observer"
 	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/addressutils"
 	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/datafield"
 	processFactory "github.com/multiversx/mx-chain-proxy-go/process/factory"
+	"github.com/multiversx/mx-chain-proxy-go/process/gasprice"
+	"github.com/multiversx/mx-chain-proxy-go/process/mempool"
+	"github.com/multiversx/mx-chain-proxy-go/process/staking"
+	"github.com/multiversx/mx-chain-proxy-go/process/tokens"
+	"github.com/multiversx/mx-chain-proxy-go/process/txfee"
+	"github.com/multiversx/mx-chain-proxy-go/process/usernames"
 	"github.com/multiversx/mx-chain-proxy-go/testing"
 	versionsFactory "github.com/multiversx/mx-chain-proxy-go/versions/factory"
 	"github.com/urfave/cli"
@@ -337,11 +346,57 @@ func createVersionsRegistryTestOrProduction(
 				ValStatsCacheValidityDurationSec:         60,
 				EconomicsMetricsCacheValidityDurationSec: 6,
 				FaucetValue:                              "10000000000",
+				NonceReservationDurationInSec:            30,
+				MaxNonceReservationsPerAddress:           100,
+				NetworkConfigCacheValidityDurationSec:    60,
 			},
 			ApiLogging: config.ApiLoggingConfig{
 				LoggingEnabled:          true,
 				ThresholdInMicroSeconds: 10000,
 			},
+			TransactionValidation: config.TransactionValidationConfig{
+				SignMarshalizerType: "json",
+				SignHasherType:      "keccak",
+			},
+			Deduplication: config.DeduplicationConfig{
+				Enabled:     false,
+				WindowInSec: 120,
+			},
+			MempoolExplorer: config.MempoolExplorerConfig{
+				SnapshotValidityInSec: 6,
+				CursorValidityInSec:   60,
+				MaxCachedTransactions: 200000,
+			},
+			GasPriceRecommendation: config.GasPriceRecommendationConfig{
+				NumRecentBlocks:     5,
+				MaxGasLimitPerBlock: 1500000000,
+				CacheValidityInSec:  6,
+			},
+			AddressWatch: config.AddressWatchConfig{
+				CheckIntervalInMillis:       2000,
+				MaxSubscriptions:            100,
+				MaxAddressesPerSubscription: 10000,
+				EventsBufferSize:            1000,
+			},
+			UsernameResolution: config.UsernameResolutionConfig{
+				CacheValidityInSec:         600,
+				NegativeCacheValidityInSec: 30,
+				MaxUsernamesInBatch:        100,
+			},
+			StakingPosition: config.StakingPositionConfig{
+				ProvidersCacheValidityInSec:   600,
+				DelegationsCacheValidityInSec: 18,
+				MaxConcurrentQueries:          20,
+			},
+			AddressUtils: config.AddressUtilsConfig{
+				MaxAddressesInBatch: 1000,
+			},
+			AccountsBulk: config.AccountsBulkConfig{
+				MaxAccounts:                         1000,
+				MaxTokens:                           20,
+				MaxConcurrentGroups:                 5,
+				MaxConcurrentTokensRequestsPerGroup: 10,
+			},
 			Observers: []*data.NodeData{
 				{
 					ShardId: 0,
@@ -460,7 +515,12 @@ func createVersionsRegistry(
 	}
 	bp.StartNodesSyncStateChecks()
 
-	accntProc, err := process.NewAccountProcessor(bp, pubKeyConverter)
+	accntProc, err := process.NewAccountProcessor(bp, pubKeyConverter, process.AccountsBulkLimits{
+		MaxAccounts:                         cfg.AccountsBulk.MaxAccounts,
+		MaxTokens:                           cfg.AccountsBulk.MaxTokens,
+		MaxConcurrentGroups:                 cfg.AccountsBulk.MaxConcurrentGroups,
+		MaxConcurrentTokensRequestsPerGroup: cfg.AccountsBulk.MaxConcurrentTokensRequestsPerGroup,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -548,6 +608,145 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
+	nonceReservationDuration := time.Duration(cfg.GeneralSettings.NonceReservationDurationInSec) * time.Second
+	nonceProc, err := process.NewNonceProcessor(
+		accntProc,
+		txProc,
+		nonceReservationDuration,
+		cfg.GeneralSettings.MaxNonceReservationsPerAddress,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	networkConfigCacheValidity := time.Duration(cfg.GeneralSettings.NetworkConfigCacheValidityDurationSec) * time.Second
+	networkConfigProvider, err := process.NewNetworkConfigProvider(nodeStatusProc, networkConfigCacheValidity)
+	if err != nil {
+		return nil, err
+	}
+
+	txWaiter, err := process.NewTransactionWaiter(txProc, networkConfigProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	txsBatchProc, err := process.NewTransactionsBatchProcessor(nonceProc, txProc, txProc)
+	if err != nil {
+		return nil, err
+	}
+
+	txValidator, err := processFactory.CreateTransactionValidator(cfg.TransactionValidation, pubKeyConverter, networkConfigProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	dataDecoder, err := datafield.NewDataFieldDecoder(pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	dbConnector, err := processFactory.CreateDatabaseConnector(cfg.ElasticSearchConnector)
+	if err != nil {
+		return nil, err
+	}
+
+	txTracker, err := processFactory.CreateTransactionsTracker(cfg.TransactionsTracker, txProc, accntProc)
+	if err != nil {
+		return nil, err
+	}
+	closableComponents.Add(txTracker)
+
+	feeComputer, err := txfee.NewFeeComputer(networkConfigProvider, txProc)
+	if err != nil {
+		return nil, err
+	}
+
+	txDeduplicator, err := processFactory.CreateTransactionsDeduplicator(cfg.Deduplication, txProc, txTracker)
+	if err != nil {
+		return nil, err
+	}
+
+	mempoolExplorer, err := mempool.NewMempoolExplorer(mempool.ArgsMempoolExplorer{
+		PoolProvider:          txProc,
+		ShardIDsProvider:      bp,
+		DataFieldDecoder:      dataDecoder,
+		SnapshotValidity:      time.Duration(cfg.MempoolExplorer.SnapshotValidityInSec) * time.Second,
+		CursorValidity:        time.Duration(cfg.MempoolExplorer.CursorValidityInSec) * time.Second,
+		MaxCachedTransactions: cfg.MempoolExplorer.MaxCachedTransactions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gasPriceRecommender, err := gasprice.NewGasPriceRecommender(gasprice.ArgsGasPriceRecommender{
+		PoolProvider:          txProc,
+		NodeStatusProvider:    nodeStatusProc,
+		NetworkConfigProvider: networkConfigProvider,
+		BlockProvider:         blockProc,
+		NumRecentBlocks:       cfg.GasPriceRecommendation.NumRecentBlocks,
+		MaxGasLimitPerBlock:   cfg.GasPriceRecommendation.MaxGasLimitPerBlock,
+		CacheValidity:         time.Duration(cfg.GasPriceRecommendation.CacheValidityInSec) * time.Second,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	txBuilder, err := processFactory.CreateTransactionBuilder(cfg.TransactionValidation, pubKeyConverter, networkConfigProvider, nonceProc, txProc)
+	if err != nil {
+		return nil, err
+	}
+
+	addressWatcher, err := processFactory.CreateAddressWatcher(cfg.AddressWatch, blockProc, nodeStatusProc, accntProc, pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+	closableComponents.Add(addressWatcher)
+
+	usernameResolver, err := usernames.NewUsernameResolver(usernames.ArgsUsernameResolver{
+		SCQueryService:        scQueryProc,
+		ShardIDComputer:       bp,
+		PubKeyConverter:       pubKeyConverter,
+		Hasher:                keccak.NewKeccak(),
+		CacheValidity:         time.Duration(cfg.UsernameResolution.CacheValidityInSec) * time.Second,
+		NegativeCacheValidity: time.Duration(cfg.UsernameResolution.NegativeCacheValidityInSec) * time.Second,
+		MaxUsernamesInBatch:   cfg.UsernameResolution.MaxUsernamesInBatch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tokenProfileProc, err := tokens.NewTokenProfileProcessor(tokens.ArgsTokenProfileProcessor{
+		ESDTSupplyProvider: esdtSuppliesProc,
+		SCQueryService:     scQueryProc,
+		NodeStatusProvider: nodeStatusProc,
+		PubKeyConverter:    pubKeyConverter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stakingPositionProc, err := staking.NewStakingPositionProcessor(staking.ArgsStakingPositionProcessor{
+		SCQueryService:           scQueryProc,
+		PubKeyConverter:          pubKeyConverter,
+		ProvidersCacheValidity:   time.Duration(cfg.StakingPosition.ProvidersCacheValidityInSec) * time.Second,
+		DelegationsCacheValidity: time.Duration(cfg.StakingPosition.DelegationsCacheValidityInSec) * time.Second,
+		MaxConcurrentQueries:     cfg.StakingPosition.MaxConcurrentQueries,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	addressUtilsProc, err := addressutils.NewAddressUtilsProcessor(addressutils.ArgsAddressUtilsProcessor{
+		ShardIDComputer:     bp,
+		PubKeyConverter:     pubKeyConverter,
+		Hasher:              keccak.NewKeccak(),
+		Hrp:                 addressHRP,
+		MaxAddressesInBatch: cfg.AddressUtils.MaxAddressesInBatch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	facadeArgs := versionsFactory.FacadeArgs{
 		ActionsProcessor:             bp,
 		AccountProcessor:             accntProc,
@@ -564,6 +763,24 @@ func createVersionsRegistry(
 		ESDTSuppliesProcessor:        esdtSuppliesProc,
 		StatusProcessor:              statusProc,
 		AboutInfoProcessor:           aboutInfoProc,
+		NonceProcessor:               nonceProc,
+		TransactionValidator:         txValidator,
+		DataFieldDecoder:             dataDecoder,
+		DatabaseConnector:            dbConnector,
+		TransactionsTracker:          txTracker,
+		FeeComputer:                  feeComputer,
+		TransactionsDeduplicator:     txDeduplicator,
+		MempoolExplorer:              mempoolExplorer,
+		GasPriceRecommender:          gasPriceRecommender,
+		TransactionBuilder:           txBuilder,
+		AddressWatcher:               addressWatcher,
+		UsernameResolver:             usernameResolver,
+		TokenProfileProcessor:        tokenProfileProc,
+		StakingPositionProcessor:     stakingPositionProc,
+		AddressUtilsProcessor:        addressUtilsProc,
+		NetworkConfigProvider:        networkConfigProvider,
+		TransactionWaiter:            txWaiter,
+		TransactionsBatchProcessor:   txsBatchProc,
 	}
 
 	apiConfigParser, err := versionsFactory.NewApiConfigParser(apiConfigDirectoryPath)
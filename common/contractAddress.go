@@ -0,0 +1,29 @@
+package common
+
+import (
+	"encoding/binary"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+)
+
+const numInitZeroBytesForScAddress = core.NumInitCharactersForScAddress - core.VMTypeLen
+
+// WasmVMType is the type of the VM the user deployed contracts and the DNS contracts run on, placed after the leading
+// zero bytes of their address
+var WasmVMType = []byte{5, 0}
+
+// ComputeContractAddress computes the address of a contract the same way the protocol does: the hash of the deployer
+// and of its nonce, prefixed by zero bytes and the VM type and suffixed by the shard identifier of the deployer
+func ComputeContractAddress(hasher hashing.Hasher, deployer []byte, deployerNonce uint64, vmType []byte) []byte {
+	nonceBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(nonceBytes, deployerNonce)
+
+	bytesToHash := append(append([]byte{}, deployer...), nonceBytes...)
+	address := hasher.Compute(string(bytesToHash))
+	copy(address[:numInitZeroBytesForScAddress], make([]byte, numInitZeroBytesForScAddress))
+	copy(address[numInitZeroBytesForScAddress:], vmType)
+	copy(address[len(address)-core.ShardIdentiferLen:], deployer[len(deployer)-core.ShardIdentiferLen:])
+
+	return address
+}
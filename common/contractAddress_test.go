@@ -0,0 +1,26 @@
+package common
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeContractAddress(t *testing.T) {
+	t.Parallel()
+
+	hasher := keccak.NewKeccak()
+	deployer := append(bytes.Repeat([]byte{1}, 30), 0, 7)
+	vmType := []byte{5, 0}
+
+	address := ComputeContractAddress(hasher, deployer, 3, vmType)
+	require.Len(t, address, len(deployer))
+	require.Equal(t, make([]byte, numInitZeroBytesForScAddress), address[:numInitZeroBytesForScAddress])
+	require.Equal(t, vmType, address[numInitZeroBytesForScAddress:core.NumInitCharactersForScAddress])
+	require.Equal(t, []byte{0, 7}, address[len(address)-core.ShardIdentiferLen:])
+	require.Equal(t, address, ComputeContractAddress(hasher, deployer, 3, vmType))
+	require.NotEqual(t, address, ComputeContractAddress(hasher, deployer, 4, vmType))
+}
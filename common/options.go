@@ -4,6 +4,8 @@ import (
 	"encoding/hex"
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 )
@@ -51,6 +53,107 @@ const (
 	UrlParameterWithAlteredAccounts = "withAlteredAccounts"
 	// UrlParameterWithKeys represents the name of an URL parameter
 	UrlParameterWithKeys = "withKeys"
+	// UrlParameterReservationToken represents the name of an URL parameter
+	UrlParameterReservationToken = "reservationToken"
+	// UrlParameterWithDecodedData represents the name of an URL parameter
+	UrlParameterWithDecodedData = "withDecodedData"
+	// UrlParameterOrdered represents the name of an URL parameter
+	UrlParameterOrdered = "ordered"
+	// UrlParameterStopOnRejection represents the name of an URL parameter
+	UrlParameterStopOnRejection = "stopOnRejection"
+	// UrlParameterWaitFor represents the name of an URL parameter
+	UrlParameterWaitFor = "waitFor"
+	// UrlParameterTimeout represents the name of an URL parameter
+	UrlParameterTimeout = "timeout"
+	// UrlParameterBroadcast represents the name of an URL parameter
+	UrlParameterBroadcast = "broadcast"
+	// UrlParameterFromNonce represents the name of an URL parameter
+	UrlParameterFromNonce = "fromNonce"
+	// UrlParameterToNonce represents the name of an URL parameter
+	UrlParameterToNonce = "toNonce"
+	// UrlParameterFrom represents the name of an URL parameter
+	UrlParameterFrom = "from"
+	// UrlParameterSize represents the name of an URL parameter
+	UrlParameterSize = "size"
+	// UrlParameterOrder represents the name of an URL parameter
+	UrlParameterOrder = "order"
+	// UrlParameterSenderFilter represents the name of an URL parameter
+	UrlParameterSenderFilter = "sender"
+	// UrlParameterReceiverFilter represents the name of an URL parameter
+	UrlParameterReceiverFilter = "receiver"
+	// UrlParameterAfter represents the name of an URL parameter
+	UrlParameterAfter = "after"
+	// UrlParameterBefore represents the name of an URL parameter
+	UrlParameterBefore = "before"
+	// UrlParameterCursor represents the name of an URL parameter
+	UrlParameterCursor = "cursor"
+	// UrlParameterPrefix represents the name of an URL parameter
+	UrlParameterPrefix = "prefix"
+	// UrlParameterFunction represents the name of an URL parameter
+	UrlParameterFunction = "function"
+	// UrlParameterMinGasPrice represents the name of an URL parameter
+	UrlParameterMinGasPrice = "minGasPrice"
+	// UrlParameterMinAge represents the name of an URL parameter
+	UrlParameterMinAge = "minAge"
+	// UrlParameterMaxAge represents the name of an URL parameter
+	UrlParameterMaxAge = "maxAge"
+	// UrlParameterSortBy represents the name of an URL parameter
+	UrlParameterSortBy = "sortBy"
+	// UrlParameterAddresses represents the name of an URL parameter
+	UrlParameterAddresses = "addresses"
+	// UrlParameterHrp represents the name of an URL parameter
+	UrlParameterHrp = "hrp"
+	// UrlParameterVMType represents the name of an URL parameter
+	UrlParameterVMType = "vmType"
+)
+
+const (
+	// TransactionWaitForExecuted defines the wait target reached once the transaction's outcome (success or fail) is known
+	TransactionWaitForExecuted = "executed"
+	// TransactionWaitForCompleted defines the wait target reached once the transaction is also notarized at destination by the metachain
+	TransactionWaitForCompleted = "completed"
+	// DefaultTransactionWaitTimeout is the timeout used when waiting for a transaction without an explicit timeout
+	DefaultTransactionWaitTimeout = 30 * time.Second
+	// MaxTransactionWaitTimeout is the maximum timeout accepted when waiting for a transaction
+	MaxTransactionWaitTimeout = 5 * time.Minute
+	// MaxTransactionBroadcastObservers is the maximum number of observers of a shard a transaction can be broadcast to
+	MaxTransactionBroadcastObservers = 10
+)
+
+const (
+	// HeaderIdempotencyKey represents the name of the HTTP header holding the idempotency key of a send request
+	HeaderIdempotencyKey = "Idempotency-Key"
+)
+
+const (
+	// OrderAscending defines the ascending sort order
+	OrderAscending = "asc"
+	// OrderDescending defines the descending sort order
+	OrderDescending = "desc"
+	// DefaultTransactionsHistorySize is the number of transactions returned by a history request without an explicit size
+	DefaultTransactionsHistorySize = 25
+	// MaxTransactionsHistorySize is the maximum number of transactions returned by a single history request
+	MaxTransactionsHistorySize = 100
+	// MaxTransactionsHistoryWindow is the maximum value of from + size, as Elasticsearch does not page past this window
+	MaxTransactionsHistoryWindow = 10000
+)
+
+const (
+	// MempoolSortByGasPrice defines the sorting of the mempool transactions by gas price
+	MempoolSortByGasPrice = "gasPrice"
+	// MempoolSortByNonce defines the sorting of the mempool transactions by nonce
+	MempoolSortByNonce = "nonce"
+	// DefaultMempoolPageSize is the number of mempool transactions returned by a request without an explicit size
+	DefaultMempoolPageSize = 50
+	// MaxMempoolPageSize is the maximum number of mempool transactions returned by a single request
+	MaxMempoolPageSize = 500
+)
+
+const (
+	// DefaultKeyValuePairsPageSize is the number of key-value pairs returned by a request without an explicit size
+	DefaultKeyValuePairsPageSize = 100
+	// MaxKeyValuePairsPageSize is the maximum number of key-value pairs returned by a single request
+	MaxKeyValuePairsPageSize = 1000
 )
 
 // BlockQueryOptions holds options for block queries
@@ -58,6 +161,7 @@ type BlockQueryOptions struct {
 	WithTransactions bool
 	WithLogs         bool
 	ForHyperblock    bool
+	WithDecodedData  bool
 }
 
 // HyperblockQueryOptions holds options for hyperblock queries
@@ -66,11 +170,42 @@ type HyperblockQueryOptions struct {
 	NotarizedAtSource      bool
 	WithAlteredAccounts    bool
 	AlteredAccountsOptions GetAlteredAccountsForBlockOptions
+	WithDecodedData        bool
 }
 
 // TransactionQueryOptions holds options for transaction queries
 type TransactionQueryOptions struct {
-	WithResults bool
+	WithResults     bool
+	WithDecodedData bool
+}
+
+// TransactionSendOptions holds options for transaction send requests
+type TransactionSendOptions struct {
+	WaitFor            string
+	Timeout            time.Duration
+	BroadcastObservers int
+}
+
+// ShouldWait returns true if the sender requested to wait for the transaction's outcome
+func (options TransactionSendOptions) ShouldWait() bool {
+	return len(options.WaitFor) > 0
+}
+
+// ShouldBroadcast returns true if the sender requested the transaction to be sent to multiple observers at once
+func (options TransactionSendOptions) ShouldBroadcast() bool {
+	return options.BroadcastObservers > 0
+}
+
+// NextNonceOptions holds options for the next nonce requests
+type NextNonceOptions struct {
+	Reserve          bool
+	ReservationToken string
+}
+
+// TransactionsBatchOptions holds options for sending multiple transactions at once
+type TransactionsBatchOptions struct {
+	Ordered         bool
+	StopOnRejection bool
 }
 
 // TransactionSimulationOptions holds options for transaction simulation requests
@@ -78,13 +213,52 @@ type TransactionSimulationOptions struct {
 	CheckSignature bool
 }
 
+// TransactionsHistoryOptions holds options for fetching the transactions history of an address
+type TransactionsHistoryOptions struct {
+	From     int
+	Size     int
+	Order    string
+	Sender   string
+	Receiver string
+	After    uint64
+	Before   uint64
+}
+
+// MempoolQueryOptions holds the pagination, filtering and sorting options of a mempool query
+type MempoolQueryOptions struct {
+	Cursor      string
+	Size        int
+	Receiver    string
+	Function    string
+	MinGasPrice uint64
+	ShardID     core.OptionalUint32
+	MinAge      time.Duration
+	MaxAge      time.Duration
+	SortBy      string
+	Order       string
+}
+
+// KeyValuePairsQueryOptions holds the filtering and pagination options of a key-value pairs query. The keys are hex
+// encoded, the cursor being the last key of the previous page
+type KeyValuePairsQueryOptions struct {
+	Prefix string
+	Cursor string
+	Size   int
+}
+
+// Matches returns true if the hex encoded key has the requested prefix and comes after the cursor
+func (options KeyValuePairsQueryOptions) Matches(key string) bool {
+	return strings.HasPrefix(key, options.Prefix) && key > options.Cursor
+}
+
 // TransactionsPoolOptions holds options for transactions pool requests
 type TransactionsPoolOptions struct {
-	ShardID   string
-	Sender    string
-	Fields    string
-	LastNonce bool
-	NonceGaps bool
+	ShardID         string
+	Sender          string
+	Fields          string
+	LastNonce       bool
+	NonceGaps       bool
+	WithDecodedData bool
 }
 
 // GetAlteredAccountsForBlockOptions specifies the options for returning altered accounts for a given block
@@ -0,0 +1,32 @@
+package common
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// MinVersionForOptions is the first transaction version which accepts options
+const MinVersionForOptions = core.InitialVersionOfTransaction + 1
+
+// ComputeMoveBalanceGas returns the gas a transaction consumes before any processing: the minimum gas limit, the gas
+// of the data field and the extra gas of guarded and of relayed v3 transactions
+func ComputeMoveBalanceGas(dataLength int, isGuarded bool, isRelayedV3 bool, networkConfig *data.NetworkConfig) uint64 {
+	moveBalanceGas := networkConfig.Config.MinGasLimit + uint64(dataLength)*networkConfig.Config.GasPerDataByte
+	if isGuarded {
+		moveBalanceGas += networkConfig.Config.ExtraGasLimitGuardedTx
+	}
+	if isRelayedV3 {
+		moveBalanceGas += networkConfig.Config.MinGasLimit
+	}
+
+	return moveBalanceGas
+}
+
+// ComputeTransactionMoveBalanceGas returns the gas the transaction consumes before any processing
+func ComputeTransactionMoveBalanceGas(tx *data.Transaction, networkConfig *data.NetworkConfig) uint64 {
+	isGuarded := tx.Options&transaction.MaskGuardedTransaction > 0
+	isRelayedV3 := len(tx.RelayerAddr) > 0
+
+	return ComputeMoveBalanceGas(len(tx.Data), isGuarded, isRelayedV3, networkConfig)
+}
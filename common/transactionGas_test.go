@@ -0,0 +1,31 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeTransactionMoveBalanceGas(t *testing.T) {
+	t.Parallel()
+
+	networkConfig := &data.NetworkConfig{}
+	networkConfig.Config.MinGasLimit = 50000
+	networkConfig.Config.GasPerDataByte = 1500
+	networkConfig.Config.ExtraGasLimitGuardedTx = 50000
+
+	tx := &data.Transaction{Data: []byte("test")}
+	require.Equal(t, uint64(50000+4*1500), ComputeTransactionMoveBalanceGas(tx, networkConfig))
+
+	tx.Options = transaction.MaskGuardedTransaction
+	require.Equal(t, uint64(50000+4*1500+50000), ComputeTransactionMoveBalanceGas(tx, networkConfig))
+
+	tx.Options = 0
+	tx.RelayerAddr = "relayer"
+	require.Equal(t, uint64(50000+4*1500+50000), ComputeTransactionMoveBalanceGas(tx, networkConfig))
+
+	tx.Options = transaction.MaskGuardedTransaction
+	require.Equal(t, uint64(50000+4*1500+50000+50000), ComputeTransactionMoveBalanceGas(tx, networkConfig))
+}
@@ -0,0 +1,29 @@
+package common
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// MarshalTransactionForSigning serializes the transaction the same way the nodes do before checking its signatures
+func MarshalTransactionForSigning(tx *data.Transaction, marshalizer marshal.Marshalizer) ([]byte, error) {
+	frontendTx := &transaction.FrontendTransaction{
+		Nonce:            tx.Nonce,
+		Value:            tx.Value,
+		Receiver:         tx.Receiver,
+		Sender:           tx.Sender,
+		SenderUsername:   tx.SenderUsername,
+		ReceiverUsername: tx.ReceiverUsername,
+		GasPrice:         tx.GasPrice,
+		GasLimit:         tx.GasLimit,
+		Data:             tx.Data,
+		ChainID:          tx.ChainID,
+		Version:          tx.Version,
+		Options:          tx.Options,
+		GuardianAddr:     tx.GuardianAddr,
+		RelayerAddr:      tx.RelayerAddr,
+	}
+
+	return marshalizer.Marshal(frontendTx)
+}
@@ -0,0 +1,34 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalTransactionForSigning(t *testing.T) {
+	t.Parallel()
+
+	tx := &data.Transaction{
+		Nonce:     7,
+		Value:     "10",
+		Receiver:  "erd1receiver",
+		Sender:    "erd1sender",
+		GasPrice:  1000000000,
+		GasLimit:  50000,
+		Data:      []byte("hello"),
+		Signature: "signature",
+		ChainID:   "T",
+		Version:   2,
+		Options:   1,
+	}
+
+	signingPayload, err := MarshalTransactionForSigning(tx, &marshal.JsonMarshalizer{})
+	require.Nil(t, err)
+	require.Equal(t,
+		`{"nonce":7,"value":"10","receiver":"erd1receiver","sender":"erd1sender","gasPrice":1000000000,"gasLimit":50000,"data":"aGVsbG8=","chainID":"T","version":2,"options":1}`,
+		string(signingPayload),
+	)
+}
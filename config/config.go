@@ -18,6 +18,9 @@ type GeneralSettingsConfig struct {
 	AllowEntireTxPoolFetch                   bool
 	NumShardsTimeoutInSec                    int
 	TimeBetweenNodesRequestsInSec            int
+	NonceReservationDurationInSec            int
+	MaxNonceReservationsPerAddress           int
+	NetworkConfigCacheValidityDurationSec    int
 }
 
 // Config will hold the whole config file's data
@@ -27,6 +30,17 @@ type Config struct {
 	Marshalizer            TypeConfig
 	Hasher                 TypeConfig
 	ApiLogging             ApiLoggingConfig
+	TransactionValidation  TransactionValidationConfig
+	ElasticSearchConnector ElasticSearchConnectorConfig
+	TransactionsTracker    TransactionsTrackerConfig
+	Deduplication          DeduplicationConfig
+	MempoolExplorer        MempoolExplorerConfig
+	GasPriceRecommendation GasPriceRecommendationConfig
+	AddressWatch           AddressWatchConfig
+	UsernameResolution     UsernameResolutionConfig
+	StakingPosition        StakingPositionConfig
+	AddressUtils           AddressUtilsConfig
+	AccountsBulk           AccountsBulkConfig
 	Observers              []*data.NodeData
 	FullHistoryNodes       []*data.NodeData
 }
@@ -49,6 +63,87 @@ type ApiLoggingConfig struct {
 	ThresholdInMicroSeconds int
 }
 
+// TransactionValidationConfig holds the configuration related to the proxy-side validation of transactions
+type TransactionValidationConfig struct {
+	Enabled             bool
+	SignMarshalizerType string
+	SignHasherType      string
+}
+
+// ElasticSearchConnectorConfig holds the configuration needed for connecting to an Elasticsearch cluster
+type ElasticSearchConnectorConfig struct {
+	URL                 string
+	Username            string
+	Password            string
+	RequestTimeoutInSec int
+}
+
+// TransactionsTrackerConfig holds the configuration related to the tracking and rebroadcast of the sent transactions
+type TransactionsTrackerConfig struct {
+	Enabled             bool
+	TrackingWindowInSec int
+	CheckIntervalInSec  int
+	MaxRebroadcasts     int
+	MaxTrackedTxs       int
+}
+
+// DeduplicationConfig holds the configuration related to the deduplication of the sent transactions and of the
+// requests carrying an idempotency key
+type DeduplicationConfig struct {
+	Enabled     bool
+	WindowInSec int
+}
+
+// MempoolExplorerConfig holds the configuration related to the snapshots of the transactions pool used for exploring it
+type MempoolExplorerConfig struct {
+	SnapshotValidityInSec int
+	CursorValidityInSec   int
+	MaxCachedTransactions int
+}
+
+// GasPriceRecommendationConfig holds the configuration related to the gas price recommendations
+type GasPriceRecommendationConfig struct {
+	NumRecentBlocks     int
+	MaxGasLimitPerBlock uint64
+	CacheValidityInSec  int
+}
+
+// AddressWatchConfig holds the configuration related to the notifications about the activity of the watched addresses
+type AddressWatchConfig struct {
+	Enabled                     bool
+	CheckIntervalInMillis       int
+	MaxSubscriptions            int
+	MaxAddressesPerSubscription int
+	EventsBufferSize            int
+}
+
+// UsernameResolutionConfig holds the configuration related to the resolution of usernames into addresses
+type UsernameResolutionConfig struct {
+	CacheValidityInSec         int
+	NegativeCacheValidityInSec int
+	MaxUsernamesInBatch        int
+}
+
+// StakingPositionConfig holds the configuration related to the staking positions of the addresses
+type StakingPositionConfig struct {
+	ProvidersCacheValidityInSec   int
+	DelegationsCacheValidityInSec int
+	MaxConcurrentQueries          int
+}
+
+// AddressUtilsConfig holds the configuration related to the address utility endpoints
+type AddressUtilsConfig struct {
+	MaxAddressesInBatch int
+}
+
+// AccountsBulkConfig holds the configuration related to the requests for a bulk of accounts
+type AccountsBulkConfig struct {
+	MaxAccounts                         int
+	MaxTokens                           int
+	MaxConcurrentGroups                 int
+	MaxConcurrentTokensRequestsPerGroup int
+}
+
 // CredentialsConfig holds the credential pairs
 type CredentialsConfig struct {
 	Credentials []data.Credential
@@ -28,6 +28,66 @@ type Account struct {
 	Pairs           map[string]string `json:"pairs,omitempty"`
 }
 
+// AccountsBulkRequest defines the request for fetching multiple accounts, each one optionally at its own block, together
+// with the balances of the selected tokens
+type AccountsBulkRequest struct {
+	Accounts []*AccountsBulkEntry `json:"accounts"`
+	Tokens   []string             `json:"tokens,omitempty"`
+}
+
+// AccountsBulkEntry defines an address of a bulk accounts request and the block it should be fetched at. Only one of
+// the block nonce, the block hash and the block root hash can be provided
+type AccountsBulkEntry struct {
+	Address       string  `json:"address"`
+	BlockNonce    *uint64 `json:"blockNonce,omitempty"`
+	BlockHash     string  `json:"blockHash,omitempty"`
+	BlockRootHash string  `json:"blockRootHash,omitempty"`
+}
+
+// AccountsBulkModel defines the response of a bulk accounts request, indexed by address
+type AccountsBulkModel struct {
+	Accounts map[string]*AccountBulkModel `json:"accounts"`
+}
+
+// AccountBulkModel holds an account together with the balances of the requested tokens, indexed by token identifier
+type AccountBulkModel struct {
+	Account *Account          `json:"account"`
+	Tokens  map[string]string `json:"tokens,omitempty"`
+}
+
+// AccountStateDiff defines how an account changed between two blocks
+type AccountStateDiff struct {
+	Address        string              `json:"address"`
+	FromBlock      BlockInfo           `json:"fromBlock"`
+	ToBlock        BlockInfo           `json:"toBlock"`
+	FromBalance    string              `json:"fromBalance"`
+	ToBalance      string              `json:"toBalance"`
+	BalanceDelta   string              `json:"balanceDelta"`
+	FromNonce      uint64              `json:"fromNonce"`
+	ToNonce        uint64              `json:"toNonce"`
+	NonceDelta     uint64              `json:"nonceDelta"`
+	TokensAdded    []*TokenBalanceDiff `json:"tokensAdded"`
+	TokensRemoved  []*TokenBalanceDiff `json:"tokensRemoved"`
+	TokensChanged  []*TokenBalanceDiff `json:"tokensChanged"`
+	StorageChanged []*StorageKeyDiff   `json:"storageChanged"`
+}
+
+// TokenBalanceDiff defines how the balance of a token changed between two blocks
+type TokenBalanceDiff struct {
+	Identifier  string `json:"identifier"`
+	FromBalance string `json:"fromBalance"`
+	ToBalance   string `json:"toBalance"`
+	Delta       string `json:"delta"`
+}
+
+// StorageKeyDiff defines how the value of a storage key changed between two blocks. An empty value means the key was
+// not set at that block
+type StorageKeyDiff struct {
+	Key       string `json:"key"`
+	FromValue string `json:"fromValue"`
+	ToValue   string `json:"toValue"`
+}
+
 // ValidatorApiResponse represents the data which is fetched from each validator for returning it in API call
 type ValidatorApiResponse = validator.ValidatorStatistics
 
@@ -68,3 +128,13 @@ type AccountKeyValueResponse struct {
 	Error string                      `json:"error"`
 	Code  string                      `json:"code"`
 }
+
+// NextNonceResponseData holds the recommended nonce for the next transaction of an address
+type NextNonceResponseData struct {
+	Nonce                uint64     `json:"nonce"`
+	AccountNonce         uint64     `json:"accountNonce"`
+	NonceGaps            []NonceGap `json:"nonceGaps"`
+	Reserved             bool       `json:"reserved"`
+	ReservationExpiresAt int64      `json:"reservationExpiresAt,omitempty"`
+	ReservationToken     string     `json:"reservationToken,omitempty"`
+}
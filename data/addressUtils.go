@@ -0,0 +1,25 @@
+package data
+
+// AddressConversion holds the bech32 and the hex encodings of an address, together with the human readable part used
+type AddressConversion struct {
+	Bech32 string `json:"bech32"`
+	Hex    string `json:"hex"`
+	Hrp    string `json:"hrp"`
+}
+
+// AddressDetails holds the encodings and the shard of an address, together with what kind of account it is
+type AddressDetails struct {
+	Bech32                string `json:"bech32"`
+	Hex                   string `json:"hex"`
+	ShardID               uint32 `json:"shardID"`
+	IsSmartContract       bool   `json:"isSmartContract"`
+	IsSystemSmartContract bool   `json:"isSystemSmartContract"`
+	IsSystemAccount       bool   `json:"isSystemAccount"`
+	VMType                string `json:"vmType,omitempty"`
+	SystemContractName    string `json:"systemContractName,omitempty"`
+}
+
+// AddressesShardsResponseData holds the shards of a batch of addresses, indexed by the requested addresses
+type AddressesShardsResponseData struct {
+	Shards map[string]uint32 `json:"shards"`
+}
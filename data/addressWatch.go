@@ -0,0 +1,33 @@
+package data
+
+// AddressWatchRequest defines the addresses, and optionally the tokens, a client wants to be notified about
+type AddressWatchRequest struct {
+	Addresses []string `json:"addresses"`
+	Tokens    []string `json:"tokens,omitempty"`
+}
+
+// AddressWatchSubscription holds the identifier of a subscription and the channel its events are delivered on. The
+// channel is closed when the subscription is ended by the proxy
+type AddressWatchSubscription struct {
+	ID     string
+	Events <-chan *AddressActivityEvent
+}
+
+// AddressActivityEvent defines the activity of a watched address in a hyperblock, together with its state after it
+type AddressActivityEvent struct {
+	Address         string                  `json:"address"`
+	HyperblockNonce uint64                  `json:"hyperblockNonce"`
+	HyperblockHash  string                  `json:"hyperblockHash"`
+	Altered         bool                    `json:"altered"`
+	Balance         string                  `json:"balance,omitempty"`
+	Nonce           uint64                  `json:"nonce,omitempty"`
+	Tokens          []*AddressActivityToken `json:"tokens,omitempty"`
+	Transactions    []string                `json:"transactions,omitempty"`
+}
+
+// AddressActivityToken defines the balance of a token of a watched address after a hyperblock
+type AddressActivityToken struct {
+	Identifier string `json:"identifier"`
+	Nonce      uint64 `json:"nonce,omitempty"`
+	Balance    string `json:"balance"`
+}
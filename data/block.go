@@ -14,7 +14,8 @@ type BlockApiResponse struct {
 
 // BlockApiResponsePayload wraps a block
 type BlockApiResponsePayload struct {
-	Block api.Block `json:"block"`
+	Block       api.Block                          `json:"block"`
+	DecodedData map[string]*DecodedTransactionData `json:"decodedData,omitempty"`
 }
 
 // HyperblockApiResponse is a response holding a hyperblock
@@ -36,7 +37,8 @@ func NewHyperblockApiResponse(hyperblock api.Hyperblock) *HyperblockApiResponse
 
 // HyperblockApiResponsePayload wraps a hyperblock
 type HyperblockApiResponsePayload struct {
-	Hyperblock api.Hyperblock `json:"hyperblock"`
+	Hyperblock  api.Hyperblock                     `json:"hyperblock"`
+	DecodedData map[string]*DecodedTransactionData `json:"decodedData,omitempty"`
 }
 
 // InternalBlockApiResponse is a response holding an internal block
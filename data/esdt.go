@@ -25,6 +25,84 @@ type ESDTSupply struct {
 	RecomputedSupply bool   `json:"recomputedSupply"`
 }
 
+// ESDTTokenProfile aggregates the supply, the properties and the special roles of a token, as known at the provided
+// epoch. The properties and the roles of a collection's token are the ones of its collection
+type ESDTTokenProfile struct {
+	Identifier string              `json:"identifier"`
+	Collection string              `json:"collection"`
+	Nonce      uint64              `json:"nonce,omitempty"`
+	Ticker     string              `json:"ticker"`
+	Name       string              `json:"name"`
+	Type       string              `json:"type"`
+	Owner      string              `json:"owner"`
+	Decimals   uint32              `json:"decimals"`
+	Supply     ESDTSupply          `json:"supply"`
+	Properties ESDTTokenProperties `json:"properties"`
+	Roles      []*ESDTAddressRoles `json:"roles"`
+	Epoch      uint32              `json:"epoch"`
+}
+
+// ESDTTokenProperties holds the properties of a token, as registered in the ESDT system smart contract
+type ESDTTokenProperties struct {
+	IsPaused                 bool   `json:"isPaused"`
+	CanUpgrade               bool   `json:"canUpgrade"`
+	CanMint                  bool   `json:"canMint"`
+	CanBurn                  bool   `json:"canBurn"`
+	CanChangeOwner           bool   `json:"canChangeOwner"`
+	CanPause                 bool   `json:"canPause"`
+	CanFreeze                bool   `json:"canFreeze"`
+	CanWipe                  bool   `json:"canWipe"`
+	CanAddSpecialRoles       bool   `json:"canAddSpecialRoles"`
+	CanTransferNFTCreateRole bool   `json:"canTransferNFTCreateRole"`
+	NFTCreateStopped         bool   `json:"nftCreateStopped"`
+	NumWiped                 uint64 `json:"numWiped"`
+}
+
+// ESDTAddressRoles holds the special roles of a token an address has
+type ESDTAddressRoles struct {
+	Address string   `json:"address"`
+	Roles   []string `json:"roles"`
+}
+
+// ESDTTokenMetadata is the normalized on-chain metadata of a token held by an address, having its encoded fields decoded
+type ESDTTokenMetadata struct {
+	Identifier          string               `json:"identifier"`
+	Collection          string               `json:"collection"`
+	Nonce               uint64               `json:"nonce,omitempty"`
+	Balance             string               `json:"balance"`
+	Properties          string               `json:"properties,omitempty"`
+	Name                string               `json:"name,omitempty"`
+	Creator             string               `json:"creator,omitempty"`
+	Royalties           uint32               `json:"royalties"`
+	RoyaltiesPercentage float64              `json:"royaltiesPercentage"`
+	Hash                string               `json:"hash,omitempty"`
+	IsHashValid         bool                 `json:"isHashValid"`
+	URIs                []string             `json:"uris,omitempty"`
+	Attributes          *ESDTTokenAttributes `json:"attributes,omitempty"`
+	Error               string               `json:"error,omitempty"`
+}
+
+// ESDTTokenAttributes holds the attributes of a token, together with the fields of the tags:...;metadata:... convention
+type ESDTTokenAttributes struct {
+	Raw      []byte            `json:"raw"`
+	Text     string            `json:"text,omitempty"`
+	Tags     []string          `json:"tags,omitempty"`
+	Metadata string            `json:"metadata,omitempty"`
+	Fields   map[string]string `json:"fields,omitempty"`
+}
+
+// ESDTTokenMetadataResponseData holds the decoded metadata of a token held by an address
+type ESDTTokenMetadataResponseData struct {
+	TokenData *ESDTTokenMetadata `json:"tokenData"`
+	BlockInfo BlockInfo          `json:"blockInfo"`
+}
+
+// ESDTTokensMetadataResponseData holds the decoded metadata of all the tokens held by an address, indexed by identifier
+type ESDTTokensMetadataResponseData struct {
+	Tokens    map[string]*ESDTTokenMetadata `json:"esdts"`
+	BlockInfo BlockInfo                     `json:"blockInfo"`
+}
+
 // IsValidEsdtPath returns true if the provided path is a valid esdt token type
 func IsValidEsdtPath(path string) bool {
 	for _, tokenType := range ValidTokenTypes {
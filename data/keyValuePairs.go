@@ -0,0 +1,15 @@
+package data
+
+// KeyValuePair defines a hex encoded storage key of an account together with its hex encoded value
+type KeyValuePair struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// KeyValuePairsPage holds a page of the key-value pairs of an account, sorted by key, together with the block they
+// were read at. The next pages should be requested at the same block, so that they are consistent with this one
+type KeyValuePairsPage struct {
+	Pairs      []*KeyValuePair `json:"pairs"`
+	BlockInfo  BlockInfo       `json:"blockInfo"`
+	NextCursor string          `json:"nextCursor,omitempty"`
+}
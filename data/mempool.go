@@ -0,0 +1,80 @@
+package data
+
+const (
+	// MempoolTxTypeRegular marks a regular transaction from the transactions pool
+	MempoolTxTypeRegular = "regular"
+	// MempoolTxTypeSmartContractResult marks a smart contract result from the transactions pool
+	MempoolTxTypeSmartContractResult = "smartContractResult"
+	// MempoolTxTypeReward marks a reward transaction from the transactions pool
+	MempoolTxTypeReward = "reward"
+)
+
+// MempoolTransaction holds a transaction found in the transactions pool of a shard
+type MempoolTransaction struct {
+	Hash      string `json:"hash"`
+	Type      string `json:"type"`
+	ShardID   uint32 `json:"shardID"`
+	Nonce     uint64 `json:"nonce"`
+	Sender    string `json:"sender"`
+	Receiver  string `json:"receiver"`
+	Value     string `json:"value,omitempty"`
+	GasPrice  uint64 `json:"gasPrice"`
+	GasLimit  uint64 `json:"gasLimit"`
+	Data      []byte `json:"data,omitempty"`
+	Operation string `json:"operation,omitempty"`
+	Function  string `json:"function,omitempty"`
+	FirstSeen int64  `json:"firstSeen"`
+}
+
+// MempoolPage holds a page of the transactions pool snapshot, matching a mempool query
+type MempoolPage struct {
+	Transactions      []*MempoolTransaction `json:"transactions"`
+	NumTransactions   int                   `json:"numTransactions"`
+	NextCursor        string                `json:"nextCursor,omitempty"`
+	SnapshotTimestamp int64                 `json:"snapshotTimestamp"`
+}
+
+// MempoolGasPricePercentiles holds the gas price distribution of the transactions from the pool
+type MempoolGasPricePercentiles struct {
+	Min uint64 `json:"min"`
+	P25 uint64 `json:"p25"`
+	P50 uint64 `json:"p50"`
+	P75 uint64 `json:"p75"`
+	P90 uint64 `json:"p90"`
+	Max uint64 `json:"max"`
+}
+
+// MempoolSenderStatistics holds the number of transactions a sender has in the pool
+type MempoolSenderStatistics struct {
+	Address         string `json:"address"`
+	NumTransactions int    `json:"numTransactions"`
+}
+
+// MempoolStatistics holds aggregate statistics of a transactions pool snapshot
+type MempoolStatistics struct {
+	NumTransactions      int                        `json:"numTransactions"`
+	TransactionsPerShard map[uint32]int             `json:"transactionsPerShard"`
+	TransactionsPerType  map[string]int             `json:"transactionsPerType"`
+	GasPricePercentiles  MempoolGasPricePercentiles `json:"gasPricePercentiles"`
+	TopSenders           []*MempoolSenderStatistics `json:"topSenders"`
+	SnapshotTimestamp    int64                      `json:"snapshotTimestamp"`
+}
+
+// GasPriceSuggestion holds a gas price together with the estimated number of blocks until a transaction paying it is included
+type GasPriceSuggestion struct {
+	GasPrice        uint64 `json:"gasPrice"`
+	EstimatedBlocks uint64 `json:"estimatedBlocks"`
+}
+
+// GasPriceRecommendation holds the gas price suggestions for a shard, computed from its transactions pool and recent blocks
+type GasPriceRecommendation struct {
+	ShardID                uint32             `json:"shardID"`
+	MinGasPrice            uint64             `json:"minGasPrice"`
+	RecentBlocksFullness   float64            `json:"recentBlocksFullness"`
+	NumPendingTransactions int                `json:"numPendingTransactions"`
+	PendingGas             uint64             `json:"pendingGas"`
+	Low                    GasPriceSuggestion `json:"low"`
+	Medium                 GasPriceSuggestion `json:"medium"`
+	High                   GasPriceSuggestion `json:"high"`
+	Timestamp              int64              `json:"timestamp"`
+}
@@ -0,0 +1,41 @@
+package data
+
+// StakingPosition holds the direct stake of an address, together with its delegations to the staking providers
+type StakingPosition struct {
+	Address     string                `json:"address"`
+	DirectStake *DirectStakePosition  `json:"directStake,omitempty"`
+	Delegations []*DelegationPosition `json:"delegations"`
+}
+
+// DirectStakePosition holds the stake of an address registered in the validator system smart contract
+type DirectStakePosition struct {
+	TotalStaked string            `json:"totalStaked"`
+	TopUp       string            `json:"topUp"`
+	Unstaked    []*UnstakedAmount `json:"unstaked"`
+	Nodes       []*StakedNode     `json:"nodes"`
+}
+
+// StakedNode holds a validator key of an address and its status in the staking system smart contract
+type StakedNode struct {
+	BLSKey string `json:"blsKey"`
+	Status string `json:"status,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// DelegationPosition holds the delegation of an address to a staking provider. When the position of the address
+// could not be fetched, only the provider and the error are set
+type DelegationPosition struct {
+	Provider         string            `json:"provider"`
+	ActiveStake      string            `json:"activeStake,omitempty"`
+	UnstakedAmount   string            `json:"unstakedAmount,omitempty"`
+	UnbondableAmount string            `json:"unbondableAmount,omitempty"`
+	ClaimableRewards string            `json:"claimableRewards,omitempty"`
+	Undelegations    []*UnstakedAmount `json:"undelegations,omitempty"`
+	Error            string            `json:"error,omitempty"`
+}
+
+// UnstakedAmount holds an amount which was unstaked and the number of epochs left until it can be withdrawn
+type UnstakedAmount struct {
+	Amount          string `json:"amount"`
+	RemainingEpochs uint64 `json:"remainingEpochs"`
+}
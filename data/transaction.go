@@ -155,8 +155,15 @@ type ResponseTransactionSimulationCrossShard struct {
 
 // MultipleTransactionsResponseData holds the data which is returned when sending a bulk of transactions
 type MultipleTransactionsResponseData struct {
-	NumOfTxs  uint64         `json:"txsSent"`
-	TxsHashes map[int]string `json:"txsHashes"`
+	NumOfTxs   uint64                          `json:"txsSent"`
+	TxsHashes  map[int]string                  `json:"txsHashes"`
+	InvalidTxs map[int]*InvalidTransactionData `json:"invalidTxs,omitempty"`
+}
+
+// InvalidTransactionData holds the reason why a transaction from a bulk was rejected and not sent
+type InvalidTransactionData struct {
+	Message string `json:"message"`
+	Reason  string `json:"reason"`
 }
 
 // ResponseMultipleTransactions defines a response from the node holding the number of transactions sent to the chain
@@ -168,10 +175,27 @@ type ResponseMultipleTransactions struct {
 
 // TxCostResponseData follows the format of the data field of a transaction cost request
 type TxCostResponseData struct {
-	TxCost     uint64                                     `json:"txGasUnits"`
-	RetMessage string                                     `json:"returnMessage"`
-	ScResults  map[string]*ExtendedApiSmartContractResult `json:"smartContractResults"`
-	Logs       *transaction.ApiLogs                       `json:"logs,omitempty"`
+	TxCost            uint64                                     `json:"txGasUnits"`
+	RetMessage        string                                     `json:"returnMessage"`
+	ScResults         map[string]*ExtendedApiSmartContractResult `json:"smartContractResults"`
+	Logs              *transaction.ApiLogs                       `json:"logs,omitempty"`
+	GasBreakdown      *TxCostHop                                 `json:"gasBreakdown,omitempty"`
+	DepthLimitReached bool                                       `json:"depthLimitReached,omitempty"`
+}
+
+// TxCostHop holds the gas estimated for one execution step of a transaction, together with the steps it triggered
+// in other shards. The gas limit forwarded to a child step is not included in the gas used by its parent
+type TxCostHop struct {
+	ShardID           uint32       `json:"shardID"`
+	Sender            string       `json:"sender"`
+	Receiver          string       `json:"receiver"`
+	Function          string       `json:"function,omitempty"`
+	GasLimit          uint64       `json:"gasLimit,omitempty"`
+	GasUsed           uint64       `json:"gasUsed"`
+	Refund            string       `json:"refund,omitempty"`
+	ReturnMessage     string       `json:"returnMessage,omitempty"`
+	DepthLimitReached bool         `json:"depthLimitReached,omitempty"`
+	Hops              []*TxCostHop `json:"hops,omitempty"`
 }
 
 // ExtendedApiSmartContractResult extends the structure transaction.ApiSmartContractResult with an extra field
@@ -209,6 +233,21 @@ type WrappedTransaction struct {
 	TxFields map[string]interface{} `json:"txFields"`
 }
 
+// GetUint64Field returns the value of a numeric field of the wrapped transaction, or 0 if it is missing. The field can
+// be decoded from JSON as a float64 or set directly as an integer
+func (wt WrappedTransaction) GetUint64Field(field string) uint64 {
+	switch value := wt.TxFields[field].(type) {
+	case float64:
+		return uint64(value)
+	case uint64:
+		return value
+	case int:
+		return uint64(value)
+	default:
+		return 0
+	}
+}
+
 // TransactionsPool represents a structure that holds all wrapped transactions from pool
 type TransactionsPool struct {
 	RegularTransactions  []WrappedTransaction `json:"regularTransactions"`
@@ -287,3 +326,217 @@ type ProcessStatusResponse struct {
 	Status string `json:"status"`
 	Reason string `json:"reason"`
 }
+
+// TransactionSendAndWaitResponseData holds the outcome of a transaction which was sent with a wait target
+type TransactionSendAndWaitResponseData struct {
+	TxHash      string                            `json:"txHash"`
+	Status      string                            `json:"status"`
+	Reason      string                            `json:"reason,omitempty"`
+	TimedOut    bool                              `json:"timedOut"`
+	Transaction *transaction.ApiTransactionResult `json:"transaction,omitempty"`
+}
+
+// TransactionBroadcastResponseData holds the outcome of a transaction which was sent to multiple observers at once.
+// AcceptedBy lists the observers which accepted the transaction in the order of their responses
+type TransactionBroadcastResponseData struct {
+	TxHash          string   `json:"txHash"`
+	SentTo          []string `json:"sentTo"`
+	FirstAcceptedBy string   `json:"firstAcceptedBy,omitempty"`
+	AcceptedBy      []string `json:"acceptedBy"`
+}
+
+const (
+	// OrderedTxStatusAccepted marks a transaction of an ordered batch which was accepted by an observer
+	OrderedTxStatusAccepted = "accepted"
+	// OrderedTxStatusRejected marks a transaction of an ordered batch which was rejected
+	OrderedTxStatusRejected = "rejected"
+	// OrderedTxStatusSkipped marks a transaction of an ordered batch which was not sent because of a previous rejection
+	OrderedTxStatusSkipped = "skipped"
+)
+
+// OrderedTransactionResult holds the outcome of a transaction sent as part of an ordered batch
+type OrderedTransactionResult struct {
+	Index  int    `json:"index"`
+	Sender string `json:"sender"`
+	Nonce  uint64 `json:"nonce"`
+	TxHash string `json:"txHash,omitempty"`
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// OrderedTransactionsResponseData holds the data which is returned when sending an ordered batch of transactions
+type OrderedTransactionsResponseData struct {
+	NumOfSentTxs uint64                      `json:"numOfSentTxs"`
+	Transactions []*OrderedTransactionResult `json:"transactions"`
+}
+
+const (
+	// BundleTxStatusSuccess marks a transaction of a bundle which was simulated successfully
+	BundleTxStatusSuccess = "success"
+	// BundleTxStatusFail marks a transaction of a bundle whose simulation failed
+	BundleTxStatusFail = "fail"
+	// BundleTxStatusSkipped marks a transaction of a bundle which was not simulated because of a previous failure
+	BundleTxStatusSkipped = "skipped"
+)
+
+// BundleSimulationTransactionResult holds the outcome of simulating a transaction as part of a bundle
+type BundleSimulationTransactionResult struct {
+	Index      int         `json:"index"`
+	Sender     string      `json:"sender"`
+	Nonce      uint64      `json:"nonce"`
+	Status     string      `json:"status"`
+	FailReason string      `json:"failReason,omitempty"`
+	Result     interface{} `json:"result,omitempty"`
+	Limitation string      `json:"limitation,omitempty"`
+}
+
+// BundleSimulationResponseData holds the data which is returned when simulating a bundle of transactions
+type BundleSimulationResponseData struct {
+	NumSimulated int                                  `json:"numSimulated"`
+	Transactions []*BundleSimulationTransactionResult `json:"transactions"`
+	StateChained bool                                 `json:"stateChained"`
+	Limitation   string                               `json:"limitation,omitempty"`
+}
+
+// DecodedTokenTransfer holds a token transfer decoded from the data field of a transaction
+type DecodedTokenTransfer struct {
+	Identifier string `json:"identifier"`
+	Nonce      uint64 `json:"nonce,omitempty"`
+	Amount     string `json:"amount"`
+}
+
+// DecodedTransactionData holds the normalized operation decoded from the data field of a transaction
+type DecodedTransactionData struct {
+	Operation         string                  `json:"operation"`
+	Function          string                  `json:"function,omitempty"`
+	Arguments         []string                `json:"arguments,omitempty"`
+	Transfers         []DecodedTokenTransfer  `json:"transfers,omitempty"`
+	EffectiveReceiver string                  `json:"effectiveReceiver,omitempty"`
+	InnerTransaction  *DecodedTransactionData `json:"innerTransaction,omitempty"`
+}
+
+const (
+	// TrackedTxStatusSent marks a tracked transaction which was sent, but not yet checked
+	TrackedTxStatusSent = "sent"
+	// TrackedTxStatusInPool marks a tracked transaction which was found in the transactions pool
+	TrackedTxStatusInPool = "inPool"
+	// TrackedTxStatusRebroadcast marks a tracked transaction which was sent again after disappearing from the pool
+	TrackedTxStatusRebroadcast = "rebroadcast"
+	// TrackedTxStatusExecuted marks a tracked transaction which was found on-chain
+	TrackedTxStatusExecuted = "executed"
+	// TrackedTxStatusInvalidated marks a tracked transaction whose nonce was consumed by another transaction
+	TrackedTxStatusInvalidated = "invalidated"
+	// TrackedTxStatusAbandoned marks a tracked transaction which was not rebroadcast anymore after too many attempts
+	TrackedTxStatusAbandoned = "abandoned"
+	// TrackedTxStatusExpired marks a tracked transaction which reached the end of the tracking window
+	TrackedTxStatusExpired = "expired"
+)
+
+// TrackedTransactionEvent holds a change in the lifecycle of a tracked transaction
+type TrackedTransactionEvent struct {
+	Timestamp int64  `json:"timestamp"`
+	Status    string `json:"status"`
+	Details   string `json:"details,omitempty"`
+}
+
+// TrackedTransaction holds the lifecycle of a transaction tracked by the proxy for rebroadcast
+type TrackedTransaction struct {
+	TxHash          string                    `json:"txHash"`
+	Sender          string                    `json:"sender"`
+	Nonce           uint64                    `json:"nonce"`
+	Status          string                    `json:"status"`
+	NumRebroadcasts int                       `json:"numRebroadcasts"`
+	TrackedSince    int64                     `json:"trackedSince"`
+	TrackedUntil    int64                     `json:"trackedUntil"`
+	LastCheckedAt   int64                     `json:"lastCheckedAt,omitempty"`
+	Events          []TrackedTransactionEvent `json:"events"`
+}
+
+// TransactionFeeRequest holds the input of a fee computation: either a transaction, or a gas limit together with the
+// data field. The gas price defaults to the minimum one. If the gas used is not provided, it is estimated
+type TransactionFeeRequest struct {
+	Transaction *Transaction `json:"transaction,omitempty"`
+	GasLimit    uint64       `json:"gasLimit,omitempty"`
+	GasPrice    uint64       `json:"gasPrice,omitempty"`
+	Data        []byte       `json:"data,omitempty"`
+	GasUsed     uint64       `json:"gasUsed,omitempty"`
+}
+
+// TransactionFeeResponseData holds the fee paid for a transaction, following the economics rules of the network
+type TransactionFeeResponseData struct {
+	GasLimit         uint64 `json:"gasLimit"`
+	GasPrice         uint64 `json:"gasPrice"`
+	GasUsed          uint64 `json:"gasUsed"`
+	InitiallyPaidFee string `json:"initiallyPaidFee"`
+	Refund           string `json:"refund"`
+	Fee              string `json:"fee"`
+}
+
+// TransactionBuildRequest holds the fields of a transaction to be built. The nonce, the gas price, the gas limit and the
+// version are filled in when not provided
+type TransactionBuildRequest struct {
+	Sender           string  `json:"sender"`
+	Receiver         string  `json:"receiver"`
+	Value            string  `json:"value,omitempty"`
+	Data             []byte  `json:"data,omitempty"`
+	Nonce            *uint64 `json:"nonce,omitempty"`
+	GasPrice         uint64  `json:"gasPrice,omitempty"`
+	GasLimit         uint64  `json:"gasLimit,omitempty"`
+	SenderUsername   []byte  `json:"senderUsername,omitempty"`
+	ReceiverUsername []byte  `json:"receiverUsername,omitempty"`
+	Version          uint32  `json:"version,omitempty"`
+	Options          uint32  `json:"options,omitempty"`
+	GuardianAddr     string  `json:"guardian,omitempty"`
+	RelayerAddr      string  `json:"relayer,omitempty"`
+}
+
+// TransactionBuildResponseData holds an unsigned transaction together with the payload its signers should sign. When
+// the options require signing on the hash, the hash of the payload is the one to be signed
+type TransactionBuildResponseData struct {
+	Transaction        *Transaction `json:"transaction"`
+	SigningPayload     string       `json:"signingPayload"`
+	SignOnHash         bool         `json:"signOnHash"`
+	SigningPayloadHash string       `json:"signingPayloadHash,omitempty"`
+	GasLimitEstimated  bool         `json:"gasLimitEstimated"`
+}
+
+const (
+	// ExecutionNodeTypeTransaction marks the transaction at the root of an execution graph
+	ExecutionNodeTypeTransaction = "transaction"
+	// ExecutionNodeTypeSmartContractResult marks a smart contract result of an execution graph
+	ExecutionNodeTypeSmartContractResult = "smartContractResult"
+)
+
+// ExecutionTokenTransfer holds a token transferred by a step of a transaction's execution
+type ExecutionTokenTransfer struct {
+	Token string `json:"token"`
+	Value string `json:"value"`
+}
+
+// TransactionExecutionNode holds a step of a transaction's execution, together with the steps it has generated
+type TransactionExecutionNode struct {
+	Hash           string                      `json:"hash"`
+	Type           string                      `json:"type"`
+	CallType       string                      `json:"callType,omitempty"`
+	Sender         string                      `json:"sender"`
+	Receiver       string                      `json:"receiver"`
+	SourceShard    uint32                      `json:"sourceShard"`
+	ExecutionShard uint32                      `json:"executionShard"`
+	Value          string                      `json:"value"`
+	TokenTransfers []*ExecutionTokenTransfer   `json:"tokenTransfers,omitempty"`
+	Operation      string                      `json:"operation,omitempty"`
+	Function       string                      `json:"function,omitempty"`
+	ReturnMessage  string                      `json:"returnMessage,omitempty"`
+	Events         []*transaction.Events       `json:"events,omitempty"`
+	Failed         bool                        `json:"failed,omitempty"`
+	FailureReason  string                      `json:"failureReason,omitempty"`
+	Children       []*TransactionExecutionNode `json:"children,omitempty"`
+}
+
+// TransactionExecutionGraph holds the execution of a transaction as a tree of the transaction and its smart contract results
+type TransactionExecutionGraph struct {
+	Status        string                    `json:"status"`
+	Root          *TransactionExecutionNode `json:"root"`
+	FailurePoint  string                    `json:"failurePoint,omitempty"`
+	FailureReason string                    `json:"failureReason,omitempty"`
+}
@@ -60,3 +60,22 @@ func TestTransactionWrapper_Getters(t *testing.T) {
 	require.Equal(t, gasPrice, tw.GetGasPrice())
 	require.Equal(t, rcvr, tw.GetRcvAddr())
 }
+
+func TestWrappedTransaction_GetUint64Field(t *testing.T) {
+	t.Parallel()
+
+	wrappedTx := WrappedTransaction{
+		TxFields: map[string]interface{}{
+			"gasPrice": float64(1000000000),
+			"gasLimit": uint64(50000),
+			"nonce":    7,
+			"sender":   "erd1",
+		},
+	}
+
+	require.Equal(t, uint64(1000000000), wrappedTx.GetUint64Field("gasPrice"))
+	require.Equal(t, uint64(50000), wrappedTx.GetUint64Field("gasLimit"))
+	require.Equal(t, uint64(7), wrappedTx.GetUint64Field("nonce"))
+	require.Equal(t, uint64(0), wrappedTx.GetUint64Field("sender"))
+	require.Equal(t, uint64(0), wrappedTx.GetUint64Field("missing"))
+}
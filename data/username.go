@@ -0,0 +1,15 @@
+package data
+
+// UsernameResolution holds the address a username resolves to, as registered in the DNS contract handling the username
+type UsernameResolution struct {
+	Username   string `json:"username"`
+	DnsAddress string `json:"dnsAddress"`
+	Found      bool   `json:"found"`
+	Address    string `json:"address,omitempty"`
+	ShardID    uint32 `json:"shardID"`
+}
+
+// UsernamesResolutionResponseData holds the resolutions of a batch of usernames, indexed by username
+type UsernamesResolutionResponseData struct {
+	Usernames map[string]*UsernameResolution `json:"usernames"`
+}
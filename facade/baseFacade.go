@@ -1,17 +1,23 @@
 package facade
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"math/big"
+	"net/http"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
+var log = logger.GetOrCreate("facade")
+
 // interfaces assertions. verifies that all API endpoint have their corresponding methods in the facade
 var _ groups.ActionsFacadeHandler = (*ProxyFacade)(nil)
 var _ groups.AccountsFacadeHandler = (*ProxyFacade)(nil)
@@ -43,6 +49,24 @@ type ProxyFacade struct {
 
 	pubKeyConverter core.PubkeyConverter
 	aboutInfoProc   AboutInfoProcessor
+	nonceProc       NonceProcessor
+	txValidator     TransactionValidator
+	dataDecoder     DataFieldDecoder
+	dbConnector     DatabaseConnector
+	txTracker       TransactionsTracker
+	feeComputer     FeeComputer
+	txDeduplicator  TransactionsDeduplicator
+	mempoolExplorer MempoolExplorer
+	gasPriceRecom   GasPriceRecommender
+	txBuilder       TransactionBuilder
+	addressWatcher  AddressWatcher
+	usernameRes     UsernameResolver
+	tokensProc      TokenProfileProcessor
+	stakingProc     StakingPositionProcessor
+	addrUtilsProc   AddressUtilsProcessor
+	networkCfgProv  NetworkConfigProvider
+	txWaiter        TransactionWaiter
+	txsBatchProc    TransactionsBatchProcessor
 }
 
 // NewProxyFacade creates a new ProxyFacade instance
@@ -62,6 +86,24 @@ func NewProxyFacade(
 	esdtSuppliesProc ESDTSupplyProcessor,
 	statusProc StatusProcessor,
 	aboutInfoProc AboutInfoProcessor,
+	nonceProc NonceProcessor,
+	txValidator TransactionValidator,
+	dataDecoder DataFieldDecoder,
+	dbConnector DatabaseConnector,
+	txTracker TransactionsTracker,
+	feeComputer FeeComputer,
+	txDeduplicator TransactionsDeduplicator,
+	mempoolExplorer MempoolExplorer,
+	gasPriceRecom GasPriceRecommender,
+	txBuilder TransactionBuilder,
+	addressWatcher AddressWatcher,
+	usernameRes UsernameResolver,
+	tokensProc TokenProfileProcessor,
+	stakingProc StakingPositionProcessor,
+	addrUtilsProc AddressUtilsProcessor,
+	networkCfgProv NetworkConfigProvider,
+	txWaiter TransactionWaiter,
+	txsBatchProc TransactionsBatchProcessor,
 ) (*ProxyFacade, error) {
 	if actionsProc == nil {
 		return nil, ErrNilActionsProcessor
@@ -105,6 +147,60 @@ func NewProxyFacade(
 	if aboutInfoProc == nil {
 		return nil, ErrNilAboutInfoProcessor
 	}
+	if nonceProc == nil {
+		return nil, ErrNilNonceProcessor
+	}
+	if txValidator == nil {
+		return nil, ErrNilTransactionValidator
+	}
+	if dataDecoder == nil {
+		return nil, ErrNilDataFieldDecoder
+	}
+	if dbConnector == nil {
+		return nil, ErrNilDatabaseConnector
+	}
+	if txTracker == nil {
+		return nil, ErrNilTransactionsTracker
+	}
+	if feeComputer == nil {
+		return nil, ErrNilFeeComputer
+	}
+	if txDeduplicator == nil {
+		return nil, ErrNilTransactionsDeduplicator
+	}
+	if mempoolExplorer == nil {
+		return nil, ErrNilMempoolExplorer
+	}
+	if gasPriceRecom == nil {
+		return nil, ErrNilGasPriceRecommender
+	}
+	if txBuilder == nil {
+		return nil, ErrNilTransactionBuilder
+	}
+	if addressWatcher == nil {
+		return nil, ErrNilAddressWatcher
+	}
+	if usernameRes == nil {
+		return nil, ErrNilUsernameResolver
+	}
+	if tokensProc == nil {
+		return nil, ErrNilTokenProfileProcessor
+	}
+	if stakingProc == nil {
+		return nil, ErrNilStakingPositionProcessor
+	}
+	if addrUtilsProc == nil {
+		return nil, ErrNilAddressUtilsProcessor
+	}
+	if networkCfgProv == nil {
+		return nil, ErrNilNetworkConfigProvider
+	}
+	if txWaiter == nil {
+		return nil, ErrNilTransactionWaiter
+	}
+	if txsBatchProc == nil {
+		return nil, ErrNilTransactionsBatchProcessor
+	}
 
 	return &ProxyFacade{
 		actionsProc:      actionsProc,
@@ -122,6 +218,24 @@ func NewProxyFacade(
 		esdtSuppliesProc: esdtSuppliesProc,
 		statusProc:       statusProc,
 		aboutInfoProc:    aboutInfoProc,
+		nonceProc:        nonceProc,
+		txValidator:      txValidator,
+		dataDecoder:      dataDecoder,
+		dbConnector:      dbConnector,
+		txTracker:        txTracker,
+		feeComputer:      feeComputer,
+		txDeduplicator:   txDeduplicator,
+		mempoolExplorer:  mempoolExplorer,
+		gasPriceRecom:    gasPriceRecom,
+		txBuilder:        txBuilder,
+		addressWatcher:   addressWatcher,
+		usernameRes:      usernameRes,
+		tokensProc:       tokensProc,
+		stakingProc:      stakingProc,
+		addrUtilsProc:    addrUtilsProc,
+		networkCfgProv:   networkCfgProv,
+		txWaiter:         txWaiter,
+		txsBatchProc:     txsBatchProc,
 	}, nil
 }
 
@@ -140,11 +254,42 @@ func (pf *ProxyFacade) GetKeyValuePairs(address string, options common.AccountQu
 	return pf.accountProc.GetKeyValuePairs(address, options)
 }
 
+// StreamKeyValuePairs hands the key-value pairs of the given address that match the filter to the handler, while they
+// are read from an observer
+func (pf *ProxyFacade) StreamKeyValuePairs(address string, options common.AccountQueryOptions, filter common.KeyValuePairsQueryOptions, handler func(pair *data.KeyValuePair) error) error {
+	return pf.accountProc.StreamKeyValuePairs(address, options, filter, handler)
+}
+
+// GetKeyValuePairsPage returns a page of the key-value pairs of the given address that match the filter, sorted by key
+func (pf *ProxyFacade) GetKeyValuePairsPage(address string, options common.AccountQueryOptions, filter common.KeyValuePairsQueryOptions) (*data.KeyValuePairsPage, error) {
+	return pf.accountProc.GetKeyValuePairsPage(address, options, filter)
+}
+
+// GetNextNonce returns the recommended nonce for the next transaction of the given address
+func (pf *ProxyFacade) GetNextNonce(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error) {
+	return pf.nonceProc.GetNextNonce(address, options)
+}
+
+// ReleaseNonceReservation removes the reservation of the given nonce, if it was made with the provided token
+func (pf *ProxyFacade) ReleaseNonceReservation(address string, nonce uint64, token string) error {
+	return pf.nonceProc.ReleaseNonceReservation(address, nonce, token)
+}
+
 // GetAccounts returns data about the provided addresses
 func (pf *ProxyFacade) GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
 	return pf.accountProc.GetAccounts(addresses, options)
 }
 
+// GetAccountsBulk returns the provided accounts, each one at its requested block, together with the balances of the requested tokens
+func (pf *ProxyFacade) GetAccountsBulk(request *data.AccountsBulkRequest, options common.AccountQueryOptions) (*data.AccountsBulkModel, error) {
+	return pf.accountProc.GetAccountsBulk(request, options)
+}
+
+// GetAccountStateDiff returns how the given account changed between the two block nonces
+func (pf *ProxyFacade) GetAccountStateDiff(address string, fromNonce uint64, toNonce uint64) (*data.AccountStateDiff, error) {
+	return pf.accountProc.GetAccountStateDiff(address, fromNonce, toNonce)
+}
+
 // GetValueForKey returns the value for the given address and key
 func (pf *ProxyFacade) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
 	return pf.accountProc.GetValueForKey(address, key, options)
@@ -155,6 +300,11 @@ func (pf *ProxyFacade) GetGuardianData(address string, options common.AccountQue
 	return pf.accountProc.GetGuardianData(address, options)
 }
 
+// GetTransactions returns the transactions history of an address, as indexed in the database
+func (pf *ProxyFacade) GetTransactions(address string, options common.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	return pf.dbConnector.GetTransactionsByAddress(address, options)
+}
+
 // GetShardIDForAddress returns the computed shard ID for the given address based on the current proxy's configuration
 func (pf *ProxyFacade) GetShardIDForAddress(address string) (uint32, error) {
 	return pf.accountProc.GetShardIDForAddress(address)
@@ -170,6 +320,11 @@ func (pf *ProxyFacade) GetESDTNftTokenData(address string, key string, nonce uin
 	return pf.accountProc.GetESDTNftTokenData(address, key, nonce, options)
 }
 
+// GetDecodedESDTNftTokenData returns the token data for a given token name, having its on-chain metadata decoded
+func (pf *ProxyFacade) GetDecodedESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.ESDTTokenMetadataResponseData, error) {
+	return pf.accountProc.GetDecodedESDTNftTokenData(address, key, nonce, options)
+}
+
 // GetESDTsWithRole returns the tokens where the given address has the assigned role
 func (pf *ProxyFacade) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return pf.accountProc.GetESDTsWithRole(address, role, options)
@@ -190,14 +345,187 @@ func (pf *ProxyFacade) GetAllESDTTokens(address string, options common.AccountQu
 	return pf.accountProc.GetAllESDTTokens(address, options)
 }
 
-// SendTransaction should send the transaction to the correct observer
+// GetDecodedAllESDTTokens returns all the ESDT tokens for a given address, having their on-chain metadata decoded
+func (pf *ProxyFacade) GetDecodedAllESDTTokens(address string, options common.AccountQueryOptions) (*data.ESDTTokensMetadataResponseData, error) {
+	return pf.accountProc.GetDecodedAllESDTTokens(address, options)
+}
+
+// SendTransaction should send the transaction to the correct observer. A transaction recently sent is not sent
+// again, the original hash being returned instead
 func (pf *ProxyFacade) SendTransaction(tx *data.Transaction) (int, string, error) {
-	return pf.txProc.SendTransaction(tx)
+	return pf.sendTransaction(tx, pf.txProc.SendTransaction)
+}
+
+// BroadcastTransaction sends the transaction to multiple observers of the involved shards at once. A transaction
+// recently sent is not sent again, the original hash being returned instead
+func (pf *ProxyFacade) BroadcastTransaction(tx *data.Transaction, numObservers int) (int, *data.TransactionBroadcastResponseData, error) {
+	var response *data.TransactionBroadcastResponseData
+	statusCode, txHash, err := pf.sendTransaction(tx, func(tx *data.Transaction) (int, string, error) {
+		broadcastStatusCode, broadcastResponse, errBroadcast := pf.txProc.BroadcastTransaction(tx, numObservers)
+		if errBroadcast != nil {
+			return broadcastStatusCode, "", errBroadcast
+		}
+
+		response = broadcastResponse
+		return broadcastStatusCode, broadcastResponse.TxHash, nil
+	})
+	if err != nil {
+		return statusCode, nil, err
+	}
+
+	if response == nil {
+		// already sent, so no observer was contacted this time
+		response = &data.TransactionBroadcastResponseData{
+			TxHash:     txHash,
+			SentTo:     make([]string, 0),
+			AcceptedBy: make([]string, 0),
+		}
+	}
+
+	return statusCode, response, nil
+}
+
+func (pf *ProxyFacade) sendTransaction(tx *data.Transaction, sendHandler func(tx *data.Transaction) (int, string, error)) (int, string, error) {
+	return pf.txDeduplicator.SendTransaction(tx, func(tx *data.Transaction) (int, string, error) {
+		return pf.validateSendAndTrackTransaction(tx, sendHandler)
+	})
+}
+
+func (pf *ProxyFacade) validateSendAndTrackTransaction(tx *data.Transaction, sendHandler func(tx *data.Transaction) (int, string, error)) (int, string, error) {
+	err := pf.txValidator.ValidateTransaction(tx)
+	if err != nil {
+		return getValidationErrorStatusCode(err), "", err
+	}
+
+	statusCode, txHash, err := sendHandler(tx)
+	if err != nil {
+		return statusCode, txHash, err
+	}
+
+	pf.txTracker.Track(tx, txHash)
+
+	return statusCode, txHash, nil
 }
 
-// SendMultipleTransactions should send the transactions to the correct observers
-func (pf *ProxyFacade) SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
-	return pf.txProc.SendMultipleTransactions(txs)
+func getValidationErrorStatusCode(err error) int {
+	var errInvalidTxFields *apiErrors.ErrInvalidTxFields
+	if errors.As(err, &errInvalidTxFields) {
+		return http.StatusBadRequest
+	}
+
+	return http.StatusInternalServerError
+}
+
+// SendTransactionAndWait sends the transaction and waits until it reaches the requested state, the timeout expires or
+// the request context is done. If the context is done after the transaction was sent, the response holding its hash and
+// its last known status is returned together with the error
+func (pf *ProxyFacade) SendTransactionAndWait(
+	ctx context.Context,
+	tx *data.Transaction,
+	options common.TransactionSendOptions,
+) (int, *data.TransactionSendAndWaitResponseData, error) {
+	statusCode, txHash, err := pf.SendTransaction(tx)
+	if err != nil {
+		return statusCode, nil, err
+	}
+
+	response := &data.TransactionSendAndWaitResponseData{
+		TxHash: txHash,
+		Status: string(transaction.TxStatusPending),
+	}
+	err = pf.txWaiter.WaitForTransaction(ctx, response, options)
+	if err != nil {
+		response.TimedOut = true
+		return http.StatusRequestTimeout, response, err
+	}
+
+	return http.StatusOK, response, nil
+}
+
+// SendMultipleTransactions should send the transactions to the correct observers. If an idempotency key is provided,
+// a request repeated with the same key gets the original response, without sending the transactions again
+func (pf *ProxyFacade) SendMultipleTransactions(txs []*data.Transaction, idempotencyKey string) (data.MultipleTransactionsResponseData, error) {
+	return pf.txDeduplicator.SendMultipleTransactions(txs, idempotencyKey, pf.sendMultipleTransactions)
+}
+
+func (pf *ProxyFacade) sendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+	validTxs := make([]*data.Transaction, 0, len(txs))
+	validTxsIndices := make([]int, 0, len(txs))
+	invalidTxs := make(map[int]*data.InvalidTransactionData)
+	for idx, tx := range txs {
+		err := pf.txValidator.ValidateTransaction(tx)
+		errInvalidTxFields, isInvalidTx := err.(*apiErrors.ErrInvalidTxFields)
+		if isInvalidTx {
+			log.Debug("invalid tx received",
+				"index", idx,
+				"sender", tx.Sender,
+				"receiver", tx.Receiver,
+				"error", err)
+			invalidTxs[idx] = &data.InvalidTransactionData{
+				Message: errInvalidTxFields.Message,
+				Reason:  errInvalidTxFields.Reason,
+			}
+			continue
+		}
+		if err != nil {
+			return data.MultipleTransactionsResponseData{}, err
+		}
+
+		validTxs = append(validTxs, tx)
+		validTxsIndices = append(validTxsIndices, idx)
+	}
+
+	if len(validTxs) == 0 && len(invalidTxs) > 0 {
+		return data.MultipleTransactionsResponseData{
+			TxsHashes:  make(map[int]string),
+			InvalidTxs: invalidTxs,
+		}, nil
+	}
+
+	response, err := pf.txProc.SendMultipleTransactions(validTxs)
+	if err != nil {
+		return response, err
+	}
+
+	// the hashes are indexed by the position in the list of valid transactions, so they are moved back
+	// to the position of each transaction in the received list
+	txsHashes := make(map[int]string, len(response.TxsHashes))
+	for idx, txHash := range response.TxsHashes {
+		if idx < 0 || idx >= len(validTxs) {
+			continue
+		}
+
+		pf.txTracker.Track(validTxs[idx], txHash)
+		txsHashes[validTxsIndices[idx]] = txHash
+	}
+
+	response.TxsHashes = txsHashes
+	if len(invalidTxs) > 0 {
+		response.InvalidTxs = invalidTxs
+	}
+
+	return response, nil
+}
+
+// ComputeTransactionFee computes the initially paid fee, the refund and the final fee of a transaction
+func (pf *ProxyFacade) ComputeTransactionFee(request *data.TransactionFeeRequest) (*data.TransactionFeeResponseData, error) {
+	return pf.feeComputer.ComputeTransactionFee(request)
+}
+
+// GetTrackedTransaction returns the lifecycle of a transaction tracked by the proxy
+func (pf *ProxyFacade) GetTrackedTransaction(txHash string) (*data.TrackedTransaction, error) {
+	return pf.txTracker.GetTrackedTransaction(txHash)
+}
+
+// SendOrderedTransactions sends the transactions of each sender one by one, in nonce order, after checking the nonces
+// against the account and the transactions pool. The outcome of each transaction is reported
+func (pf *ProxyFacade) SendOrderedTransactions(txs []*data.Transaction, stopOnRejection bool) (*data.OrderedTransactionsResponseData, error) {
+	return pf.txsBatchProc.SendOrderedTransactions(txs, stopOnRejection, pf.SendTransaction)
+}
+
+// SimulateTransactionsBundle simulates the transactions one by one, in the provided order, and stops at the first failure
+func (pf *ProxyFacade) SimulateTransactionsBundle(txs []*data.Transaction, checkSignature bool) (*data.BundleSimulationResponseData, error) {
+	return pf.txsBatchProc.SimulateTransactionsBundle(txs, checkSignature)
 }
 
 // SimulateTransaction should send the transaction to the correct observer for simulation
@@ -220,6 +548,11 @@ func (pf *ProxyFacade) GetProcessedTransactionStatus(txHash string) (*data.Proce
 	return pf.txProc.GetProcessedTransactionStatus(txHash)
 }
 
+// GetTransactionExecutionGraph returns the execution of a transaction as a tree of the transaction and its smart contract results
+func (pf *ProxyFacade) GetTransactionExecutionGraph(txHash string) (*data.TransactionExecutionGraph, error) {
+	return pf.txProc.GetTransactionExecutionGraph(txHash)
+}
+
 // GetTransaction should return a transaction by hash
 func (pf *ProxyFacade) GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return pf.txProc.GetTransaction(txHash, withResults)
@@ -257,7 +590,7 @@ func (pf *ProxyFacade) SendUserFunds(receiver string, value *big.Int) error {
 		return err
 	}
 
-	networkCfg, err := pf.getNetworkConfig()
+	networkCfg, err := pf.networkCfgProv.GetNetworkConfig()
 	if err != nil {
 		return err
 	}
@@ -278,23 +611,6 @@ func (pf *ProxyFacade) SendUserFunds(receiver string, value *big.Int) error {
 	return err
 }
 
-func (pf *ProxyFacade) getNetworkConfig() (*data.NetworkConfig, error) {
-	genericResponse, err := pf.nodeStatusProc.GetNetworkConfigMetrics()
-	if err != nil {
-		return nil, err
-	}
-
-	networkConfigBytes, err := json.Marshal(&genericResponse.Data)
-	if err != nil {
-		return nil, err
-	}
-
-	networkCfg := &data.NetworkConfig{}
-	err = json.Unmarshal(networkConfigBytes, networkCfg)
-
-	return networkCfg, err
-}
-
 // ExecuteSCQuery retrieves data from existing SC trie through the use of a VM
 func (pf *ProxyFacade) ExecuteSCQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 	return pf.scQueryService.ExecuteQuery(query)
@@ -440,6 +756,76 @@ func (pf *ProxyFacade) GetTransactionsPoolForShard(shardID uint32, fields string
 	return pf.txProc.GetTransactionsPoolForShard(shardID, fields)
 }
 
+// GetMempoolPage returns a page of the transactions pool snapshot, matching the provided options
+func (pf *ProxyFacade) GetMempoolPage(options common.MempoolQueryOptions) (*data.MempoolPage, error) {
+	return pf.mempoolExplorer.GetMempoolPage(options)
+}
+
+// GetMempoolStatistics returns aggregate statistics of the transactions pool
+func (pf *ProxyFacade) GetMempoolStatistics() (*data.MempoolStatistics, error) {
+	return pf.mempoolExplorer.GetMempoolStatistics()
+}
+
+// GetGasPriceRecommendation returns the gas price suggestions for the provided shard
+func (pf *ProxyFacade) GetGasPriceRecommendation(shardID uint32) (*data.GasPriceRecommendation, error) {
+	return pf.gasPriceRecom.GetGasPriceRecommendation(shardID)
+}
+
+// BuildTransaction returns an unsigned transaction with the missing fields filled in, together with its signing payload
+func (pf *ProxyFacade) BuildTransaction(request *data.TransactionBuildRequest) (*data.TransactionBuildResponseData, error) {
+	return pf.txBuilder.BuildTransaction(request)
+}
+
+// SubscribeToAddressActivity registers a subscription for the activity of the requested addresses
+func (pf *ProxyFacade) SubscribeToAddressActivity(request *data.AddressWatchRequest) (*data.AddressWatchSubscription, error) {
+	return pf.addressWatcher.Subscribe(request)
+}
+
+// UnsubscribeFromAddressActivity ends the provided address activity subscription
+func (pf *ProxyFacade) UnsubscribeFromAddressActivity(subscriptionID string) {
+	pf.addressWatcher.Unsubscribe(subscriptionID)
+}
+
+// ResolveUsername returns the address the provided username resolves to
+func (pf *ProxyFacade) ResolveUsername(username string) (*data.UsernameResolution, error) {
+	return pf.usernameRes.ResolveUsername(username)
+}
+
+// ResolveUsernames returns the addresses the provided usernames resolve to
+func (pf *ProxyFacade) ResolveUsernames(usernames []string) (*data.UsernamesResolutionResponseData, error) {
+	return pf.usernameRes.ResolveUsernames(usernames)
+}
+
+// GetTokenProfile returns the supply, the properties and the special roles of the provided token
+func (pf *ProxyFacade) GetTokenProfile(identifier string) (*data.ESDTTokenProfile, error) {
+	return pf.tokensProc.GetTokenProfile(identifier)
+}
+
+// GetStakingPosition returns the direct stake and the delegations of the provided address
+func (pf *ProxyFacade) GetStakingPosition(address string) (*data.StakingPosition, error) {
+	return pf.stakingProc.GetStakingPosition(address)
+}
+
+// ConvertAddress returns the bech32 and the hex encodings of the provided address
+func (pf *ProxyFacade) ConvertAddress(address string, hrp string) (*data.AddressConversion, error) {
+	return pf.addrUtilsProc.ConvertAddress(address, hrp)
+}
+
+// ComputeShardIDs returns the shards of the provided addresses
+func (pf *ProxyFacade) ComputeShardIDs(addresses []string) (*data.AddressesShardsResponseData, error) {
+	return pf.addrUtilsProc.ComputeShardIDs(addresses)
+}
+
+// ComputeContractAddress returns the address of the contract deployed by the provided deployer at the provided nonce
+func (pf *ProxyFacade) ComputeContractAddress(deployer string, nonce uint64, vmType string) (*data.AddressDetails, error) {
+	return pf.addrUtilsProc.ComputeContractAddress(deployer, nonce, vmType)
+}
+
+// GetAddressDetails returns the encodings, the shard and the kind of the provided address
+func (pf *ProxyFacade) GetAddressDetails(address string) (*data.AddressDetails, error) {
+	return pf.addrUtilsProc.GetAddressDetails(address)
+}
+
 // GetTransactionsPoolForSender returns tx pool for sender
 func (pf *ProxyFacade) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
 	return pf.txProc.GetTransactionsPoolForSender(sender, fields)
@@ -544,3 +930,8 @@ func (epf *ProxyFacade) GetWaitingEpochsLeftForPublicKey(publicKey string) (*dat
 func (pf *ProxyFacade) IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return pf.accountProc.IsDataTrieMigrated(address, options)
 }
+
+// DecodeTransactionData decodes the data field of a transaction into a normalized operation
+func (pf *ProxyFacade) DecodeTransactionData(dataField []byte, sender string, receiver string) *data.DecodedTransactionData {
+	return pf.dataDecoder.Decode(dataField, sender, receiver)
+}
@@ -1,16 +1,22 @@
 package facade_test
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"math/big"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/facade"
@@ -45,6 +51,24 @@ func TestNewProxyFacade_NilActionsProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -70,6 +94,24 @@ func TestNewProxyFacade_NilAccountProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -95,6 +137,24 @@ func TestNewProxyFacade_NilTransactionProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -120,6 +180,24 @@ func TestNewProxyFacade_NilGetValuesProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -145,6 +223,24 @@ func TestNewProxyFacade_NilNodeGroupProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -170,6 +266,24 @@ func TestNewProxyFacade_NilValStatsProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -195,6 +309,24 @@ func TestNewProxyFacade_NilFaucetProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -220,6 +352,24 @@ func TestNewProxyFacade_NilNodeProcessor(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -245,6 +395,24 @@ func TestNewProxyFacade_NilBlocksProcessor(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -270,6 +438,24 @@ func TestNewProxyFacade_NilProofProcessor(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -295,6 +481,24 @@ func TestNewProxyFacade_NilStatusProcessorShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		nil,
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -320,12 +524,804 @@ func TestNewProxyFacade_NilAboutInfoProcessorShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		nil,
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
 	assert.Equal(t, facade.ErrNilAboutInfoProcessor, err)
 }
 
+func TestNewProxyFacade_NilNonceProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		nil,
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilNonceProcessor, err)
+}
+
+func TestNewProxyFacade_NilTransactionValidatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		nil,
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilTransactionValidator, err)
+}
+
+func TestNewProxyFacade_NilDataFieldDecoderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		nil,
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilDataFieldDecoder, err)
+}
+
+func TestNewProxyFacade_NilDatabaseConnectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		nil,
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilDatabaseConnector, err)
+}
+
+func TestNewProxyFacade_NilTransactionsTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		nil,
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilTransactionsTracker, err)
+}
+
+func TestNewProxyFacade_NilFeeComputerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		nil,
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilFeeComputer, err)
+}
+
+func TestNewProxyFacade_NilTransactionsDeduplicatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		nil,
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilTransactionsDeduplicator, err)
+}
+
+func TestNewProxyFacade_NilMempoolExplorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		nil,
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilMempoolExplorer, err)
+}
+
+func TestNewProxyFacade_NilGasPriceRecommenderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		nil,
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilGasPriceRecommender, err)
+}
+
+func TestNewProxyFacade_NilTransactionBuilderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		nil,
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilTransactionBuilder, err)
+}
+
+func TestNewProxyFacade_NilAddressWatcherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		nil,
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilAddressWatcher, err)
+}
+
+func TestNewProxyFacade_NilUsernameResolverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		nil,
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilUsernameResolver, err)
+}
+
+func TestNewProxyFacade_NilTokenProfileProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		nil,
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilTokenProfileProcessor, err)
+}
+
+func TestNewProxyFacade_NilStakingPositionProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		nil,
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilStakingPositionProcessor, err)
+}
+
+func TestNewProxyFacade_NilAddressUtilsProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		nil,
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilAddressUtilsProcessor, err)
+}
+
+func TestNewProxyFacade_NilNetworkConfigProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		nil,
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilNetworkConfigProvider, err)
+}
+
+func TestNewProxyFacade_NilTransactionWaiterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		nil,
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilTransactionWaiter, err)
+}
+
+func TestNewProxyFacade_NilTransactionsBatchProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		nil,
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilTransactionsBatchProcessor, err)
+}
+
 func TestNewProxyFacade_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -345,6 +1341,24 @@ func TestNewProxyFacade_ShouldWork(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	assert.NotNil(t, epf)
@@ -393,6 +1407,24 @@ func TestNewProxyFacade_GetBlocksByRound(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 	require.NoError(t, err)
 
@@ -430,6 +1462,24 @@ func TestProxyFacade_GetAccount(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	_, _ = epf.GetAccount("", common.AccountQueryOptions{})
@@ -463,6 +1513,24 @@ func TestProxyFacade_SendTransaction(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	_, _, _ = epf.SendTransaction(&data.Transaction{})
@@ -470,6 +1538,390 @@ func TestProxyFacade_SendTransaction(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestProxyFacade_SendTransactionInvalidShouldNotSend(t *testing.T) {
+	t.Parallel()
+
+	invalidTxErr := &apiErrors.ErrInvalidTxFields{Message: "invalid", Reason: "reason"}
+	epf, _ := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				require.Fail(t, "should have not been called")
+				return 0, "", nil
+			},
+			SendMultipleTransactionsCalled: func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+				require.Equal(t, 1, len(txs))
+				require.Equal(t, uint64(2), txs[0].Nonce)
+				return data.MultipleTransactionsResponseData{NumOfTxs: 1, TxsHashes: map[int]string{0: "hash2"}}, nil
+			},
+		},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{
+			ValidateTransactionCalled: func(tx *data.Transaction) error {
+				if tx.Nonce == 1 {
+					return invalidTxErr
+				}
+
+				return nil
+			},
+		},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	statusCode, _, err := epf.SendTransaction(&data.Transaction{Nonce: 1})
+	assert.Equal(t, invalidTxErr, err)
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+
+	expectedInvalidTxs := map[int]*data.InvalidTransactionData{
+		0: {Message: "invalid", Reason: "reason"},
+	}
+	response, err := epf.SendMultipleTransactions([]*data.Transaction{{Nonce: 1}, {Nonce: 2}}, "")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), response.NumOfTxs)
+	assert.Equal(t, map[int]string{1: "hash2"}, response.TxsHashes)
+	assert.Equal(t, expectedInvalidTxs, response.InvalidTxs)
+
+	response, err = epf.SendMultipleTransactions([]*data.Transaction{{Nonce: 1}}, "")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), response.NumOfTxs)
+	assert.Empty(t, response.TxsHashes)
+	assert.Equal(t, expectedInvalidTxs, response.InvalidTxs)
+}
+
+func TestProxyFacade_SentTransactionsShouldBeTracked(t *testing.T) {
+	t.Parallel()
+
+	trackedTxs := make(map[string]uint64)
+	epf, _ := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				if tx.Nonce == 0 {
+					return http.StatusInternalServerError, "", errors.New("send error")
+				}
+
+				return http.StatusOK, "hash1", nil
+			},
+			SendMultipleTransactionsCalled: func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+				return data.MultipleTransactionsResponseData{
+					NumOfTxs:  2,
+					TxsHashes: map[int]string{0: "hash2", 1: "hash3"},
+				}, nil
+			},
+		},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{
+			TrackCalled: func(tx *data.Transaction, txHash string) {
+				trackedTxs[txHash] = tx.Nonce
+			},
+		},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	_, _, err := epf.SendTransaction(&data.Transaction{Nonce: 0})
+	require.NotNil(t, err)
+	require.Empty(t, trackedTxs)
+
+	_, _, err = epf.SendTransaction(&data.Transaction{Nonce: 1})
+	require.Nil(t, err)
+
+	_, err = epf.SendMultipleTransactions([]*data.Transaction{{Nonce: 2}, {Nonce: 3}}, "")
+	require.Nil(t, err)
+
+	expectedTrackedTxs := map[string]uint64{
+		"hash1": 1,
+		"hash2": 2,
+		"hash3": 3,
+	}
+	require.Equal(t, expectedTrackedTxs, trackedTxs)
+}
+
+func createFacadeWithTransactionsDeduplicator(txProc facade.TransactionProcessor, txDeduplicator facade.TransactionsDeduplicator) *facade.ProxyFacade {
+	epf, _ := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		txProc,
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		txDeduplicator,
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	return epf
+}
+
+func TestProxyFacade_SendTransactionShouldGoThroughDeduplicator(t *testing.T) {
+	t.Parallel()
+
+	numSent := 0
+	sentTxs := make(map[uint64]string)
+	epf := createFacadeWithTransactionsDeduplicator(
+		&mock.TransactionProcessorStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				numSent++
+				return http.StatusOK, fmt.Sprintf("hash%d", tx.Nonce), nil
+			},
+		},
+		&mock.TransactionsDeduplicatorStub{
+			SendTransactionCalled: func(tx *data.Transaction, sendHandler func(tx *data.Transaction) (int, string, error)) (int, string, error) {
+				txHash, found := sentTxs[tx.Nonce]
+				if found {
+					return http.StatusOK, txHash, nil
+				}
+
+				statusCode, txHash, err := sendHandler(tx)
+				sentTxs[tx.Nonce] = txHash
+				return statusCode, txHash, err
+			},
+		},
+	)
+
+	_, txHash, err := epf.SendTransaction(&data.Transaction{Nonce: 1})
+	require.Nil(t, err)
+	require.Equal(t, "hash1", txHash)
+
+	_, txHash, err = epf.SendTransaction(&data.Transaction{Nonce: 1})
+	require.Nil(t, err)
+	require.Equal(t, "hash1", txHash)
+	require.Equal(t, 1, numSent)
+}
+
+func TestProxyFacade_BroadcastTransaction(t *testing.T) {
+	t.Parallel()
+
+	numSent := 0
+	isAlreadySent := false
+	epf := createFacadeWithTransactionsDeduplicator(
+		&mock.TransactionProcessorStub{
+			BroadcastTransactionCalled: func(tx *data.Transaction, numObservers int) (int, *data.TransactionBroadcastResponseData, error) {
+				numSent++
+				require.Equal(t, 3, numObservers)
+				return http.StatusOK, &data.TransactionBroadcastResponseData{
+					TxHash:     "hash",
+					SentTo:     []string{"observer0", "observer1", "observer2"},
+					AcceptedBy: []string{"observer1"},
+				}, nil
+			},
+		},
+		&mock.TransactionsDeduplicatorStub{
+			SendTransactionCalled: func(tx *data.Transaction, sendHandler func(tx *data.Transaction) (int, string, error)) (int, string, error) {
+				if isAlreadySent {
+					return http.StatusOK, "hash", nil
+				}
+
+				isAlreadySent = true
+				return sendHandler(tx)
+			},
+		},
+	)
+
+	statusCode, response, err := epf.BroadcastTransaction(&data.Transaction{Nonce: 1}, 3)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "hash", response.TxHash)
+	require.Equal(t, []string{"observer1"}, response.AcceptedBy)
+
+	// already sent, so it should not be broadcast again
+	statusCode, response, err = epf.BroadcastTransaction(&data.Transaction{Nonce: 1}, 3)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "hash", response.TxHash)
+	require.Empty(t, response.SentTo)
+	require.Equal(t, 1, numSent)
+}
+
+func TestProxyFacade_SendMultipleTransactionsShouldPassTheIdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	epf := createFacadeWithTransactionsDeduplicator(
+		&mock.TransactionProcessorStub{
+			SendMultipleTransactionsCalled: func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+				return data.MultipleTransactionsResponseData{NumOfTxs: 1, TxsHashes: map[int]string{0: "hash1"}}, nil
+			},
+		},
+		&mock.TransactionsDeduplicatorStub{
+			SendMultipleTransactionsCalled: func(txs []*data.Transaction, idempotencyKey string, sendHandler func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)) (data.MultipleTransactionsResponseData, error) {
+				require.Equal(t, "key", idempotencyKey)
+				return sendHandler(txs)
+			},
+		},
+	)
+
+	response, err := epf.SendMultipleTransactions([]*data.Transaction{{Nonce: 1}}, "key")
+	require.Nil(t, err)
+	require.Equal(t, map[int]string{0: "hash1"}, response.TxsHashes)
+}
+
+func createFacadeWithTransactionsBatchProcessor(txProc facade.TransactionProcessor, txsBatchProc facade.TransactionsBatchProcessor) *facade.ProxyFacade {
+	epf, _ := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		txProc,
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		txsBatchProc,
+	)
+
+	return epf
+}
+
+func TestProxyFacade_SendOrderedTransactions(t *testing.T) {
+	t.Parallel()
+
+	sentTx := &data.Transaction{Sender: "alice", Nonce: 6}
+	txProc := &mock.TransactionProcessorStub{
+		SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+			return http.StatusOK, "hash", nil
+		},
+	}
+	expectedResponse := &data.OrderedTransactionsResponseData{NumOfSentTxs: 1}
+	txsBatchProc := &mock.TransactionsBatchProcessorStub{
+		SendOrderedTransactionsCalled: func(txs []*data.Transaction, stopOnRejection bool, sendHandler func(tx *data.Transaction) (int, string, error)) (*data.OrderedTransactionsResponseData, error) {
+			require.Equal(t, []*data.Transaction{sentTx}, txs)
+			require.True(t, stopOnRejection)
+
+			_, txHash, err := sendHandler(txs[0])
+			require.Nil(t, err)
+			require.Equal(t, "hash", txHash)
+
+			return expectedResponse, nil
+		},
+	}
+	epf := createFacadeWithTransactionsBatchProcessor(txProc, txsBatchProc)
+
+	response, err := epf.SendOrderedTransactions([]*data.Transaction{sentTx}, true)
+	require.Nil(t, err)
+	require.Equal(t, expectedResponse, response)
+}
+
+func TestProxyFacade_SimulateTransactionsBundle(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &data.BundleSimulationResponseData{NumSimulated: 2}
+	txsBatchProc := &mock.TransactionsBatchProcessorStub{
+		SimulateTransactionsBundleCalled: func(txs []*data.Transaction, checkSignature bool) (*data.BundleSimulationResponseData, error) {
+			require.Len(t, txs, 2)
+			require.True(t, checkSignature)
+
+			return expectedResponse, nil
+		},
+	}
+	epf := createFacadeWithTransactionsBatchProcessor(&mock.TransactionProcessorStub{}, txsBatchProc)
+
+	response, err := epf.SimulateTransactionsBundle([]*data.Transaction{{Nonce: 1}, {Nonce: 2}}, true)
+	require.Nil(t, err)
+	require.Equal(t, expectedResponse, response)
+}
+
 func TestProxyFacade_SimulateTransaction(t *testing.T) {
 	t.Parallel()
 
@@ -495,6 +1947,24 @@ func TestProxyFacade_SimulateTransaction(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	_, _ = epf.SimulateTransaction(&data.Transaction{}, false)
@@ -553,6 +2023,24 @@ func TestProxyFacade_SendUserFunds(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	_ = epf.SendUserFunds("", big.NewInt(0))
@@ -560,6 +2048,137 @@ func TestProxyFacade_SendUserFunds(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func createFacadeForSendAndWait(txProc facade.TransactionProcessor, txWaiter facade.TransactionWaiter) *facade.ProxyFacade {
+	epf, _ := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		txProc,
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		txWaiter,
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	return epf
+}
+
+func TestProxyFacade_SendTransactionAndWait(t *testing.T) {
+	t.Parallel()
+
+	t.Run("send error should return it", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		epf := createFacadeForSendAndWait(
+			&mock.TransactionProcessorStub{
+				SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+					return http.StatusBadRequest, "", expectedErr
+				},
+			},
+			&mock.TransactionWaiterStub{
+				WaitForTransactionCalled: func(ctx context.Context, response *data.TransactionSendAndWaitResponseData, options common.TransactionSendOptions) error {
+					require.Fail(t, "should have not been called")
+					return nil
+				},
+			},
+		)
+
+		statusCode, response, err := epf.SendTransactionAndWait(context.Background(), &data.Transaction{}, common.TransactionSendOptions{
+			WaitFor: common.TransactionWaitForExecuted,
+			Timeout: time.Second,
+		})
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, http.StatusBadRequest, statusCode)
+		assert.Nil(t, response)
+	})
+	t.Run("wait error should return it together with the last known status", func(t *testing.T) {
+		t.Parallel()
+
+		epf := createFacadeForSendAndWait(
+			&mock.TransactionProcessorStub{
+				SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+					return http.StatusOK, "hash", nil
+				},
+			},
+			&mock.TransactionWaiterStub{
+				WaitForTransactionCalled: func(ctx context.Context, response *data.TransactionSendAndWaitResponseData, options common.TransactionSendOptions) error {
+					return context.Canceled
+				},
+			},
+		)
+
+		statusCode, response, err := epf.SendTransactionAndWait(context.Background(), &data.Transaction{}, common.TransactionSendOptions{
+			WaitFor: common.TransactionWaitForExecuted,
+			Timeout: time.Second,
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, http.StatusRequestTimeout, statusCode)
+		assert.Equal(t, "hash", response.TxHash)
+		assert.Equal(t, string(transaction.TxStatusPending), response.Status)
+		assert.True(t, response.TimedOut)
+	})
+	t.Run("should wait for the sent transaction", func(t *testing.T) {
+		t.Parallel()
+
+		type ctxKey struct{}
+		ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+		options := common.TransactionSendOptions{
+			WaitFor: common.TransactionWaitForCompleted,
+			Timeout: time.Minute,
+		}
+		epf := createFacadeForSendAndWait(
+			&mock.TransactionProcessorStub{
+				SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+					return http.StatusOK, "hash", nil
+				},
+			},
+			&mock.TransactionWaiterStub{
+				WaitForTransactionCalled: func(providedCtx context.Context, response *data.TransactionSendAndWaitResponseData, providedOptions common.TransactionSendOptions) error {
+					assert.Equal(t, "value", providedCtx.Value(ctxKey{}))
+					assert.Equal(t, options, providedOptions)
+					assert.Equal(t, "hash", response.TxHash)
+					assert.Equal(t, string(transaction.TxStatusPending), response.Status)
+
+					response.Status = string(transaction.TxStatusSuccess)
+					return nil
+				},
+			},
+		)
+
+		statusCode, response, err := epf.SendTransactionAndWait(ctx, &data.Transaction{}, options)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, statusCode)
+		assert.Equal(t, "hash", response.TxHash)
+		assert.Equal(t, string(transaction.TxStatusSuccess), response.Status)
+	})
+}
+
 func TestProxyFacade_GetDataValue(t *testing.T) {
 	t.Parallel()
 
@@ -585,6 +2204,24 @@ func TestProxyFacade_GetDataValue(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	_, _, _ = epf.ExecuteSCQuery(nil)
@@ -623,6 +2260,24 @@ func TestProxyFacade_GetHeartbeatData(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	actualResult, _ := epf.GetHeartbeatData()
@@ -658,6 +2313,24 @@ func TestProxyFacade_ReloadObservers(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	actualResult := epf.ReloadObservers()
@@ -693,6 +2366,24 @@ func TestProxyFacade_ReloadFullHistoryObservers(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	actualResult := epf.ReloadFullHistoryObservers()
@@ -732,6 +2423,24 @@ func TestProxyFacade_GetBlockByHash(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	actualResult, err := epf.GetBlockByHash(0, "aaaa", common.BlockQueryOptions{})
@@ -772,6 +2481,24 @@ func TestProxyFacade_GetBlockByNonce(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	actualResult, err := epf.GetBlockByNonce(0, 10, common.BlockQueryOptions{})
@@ -814,6 +2541,24 @@ func TestProxyFacade_GetInternalBlockByHash(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	actualResult, err := epf.GetInternalBlockByHash(0, "aaaa", common.Internal)
@@ -854,6 +2599,24 @@ func TestProxyFacade_GetInternalBlockByNonce(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	actualResult, err := epf.GetInternalBlockByNonce(0, 10, common.Internal)
@@ -894,6 +2657,24 @@ func TestProxyFacade_GetInternalMiniBlockByHash(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	actualResult, err := epf.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
@@ -932,6 +2713,24 @@ func TestProxyFacade_GetRatingsConfig(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	actualResult, err := epf.GetRatingsConfig()
@@ -997,6 +2796,24 @@ func TestProxyFacade_GetTransactionsPool(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	actualTxPool, err := epf.GetTransactionsPool("")
@@ -1051,6 +2868,24 @@ func TestProxyFacade_GetGasConfigs(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	actualResult, err := epf.GetGasConfigs()
@@ -1088,6 +2923,24 @@ func TestProxyFacade_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
 	)
 
 	actualResult, _ := epf.GetWaitingEpochsLeftForPublicKey("key")
@@ -1101,3 +2954,55 @@ func getPrivKey() crypto.PrivateKey {
 
 	return sk
 }
+
+func TestProxyFacade_GetAccountStateDiff(t *testing.T) {
+	t.Parallel()
+
+	expectedDiff := &data.AccountStateDiff{Address: "erd1address", BalanceDelta: "-10"}
+	epf, _ := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{
+			GetAccountStateDiffCalled: func(address string, fromNonce uint64, toNonce uint64) (*data.AccountStateDiff, error) {
+				require.Equal(t, "erd1address", address)
+				require.Equal(t, uint64(10), fromNonce)
+				require.Equal(t, uint64(11), toNonce)
+				return expectedDiff, nil
+			},
+		},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.NonceProcessorStub{},
+		&mock.TransactionValidatorStub{},
+		&mock.DataFieldDecoderStub{},
+		&mock.DatabaseConnectorStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.FeeComputerStub{},
+		&mock.TransactionsDeduplicatorStub{},
+		&mock.MempoolExplorerStub{},
+		&mock.GasPriceRecommenderStub{},
+		&mock.TransactionBuilderStub{},
+		&mock.AddressWatcherStub{},
+		&mock.UsernameResolverStub{},
+		&mock.TokenProfileProcessorStub{},
+		&mock.StakingPositionProcessorStub{},
+		&mock.AddressUtilsProcessorStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionWaiterStub{},
+		&mock.TransactionsBatchProcessorStub{},
+	)
+
+	diff, err := epf.GetAccountStateDiff("erd1address", 10, 11)
+	require.Nil(t, err)
+	require.Equal(t, expectedDiff, diff)
+}
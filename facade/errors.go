@@ -43,3 +43,57 @@ var ErrNilStatusProcessor = errors.New("nil status processor")
 
 // ErrNilAboutInfoProcessor signals that a nil about info processor has been provided
 var ErrNilAboutInfoProcessor = errors.New("nil about info processor")
+
+// ErrNilNonceProcessor signals that a nil nonce processor has been provided
+var ErrNilNonceProcessor = errors.New("nil nonce processor")
+
+// ErrNilTransactionValidator signals that a nil transaction validator has been provided
+var ErrNilTransactionValidator = errors.New("nil transaction validator")
+
+// ErrNilDataFieldDecoder signals that a nil data field decoder has been provided
+var ErrNilDataFieldDecoder = errors.New("nil data field decoder")
+
+// ErrNilDatabaseConnector signals that a nil database connector has been provided
+var ErrNilDatabaseConnector = errors.New("nil database connector")
+
+// ErrNilTransactionsTracker signals that a nil transactions tracker has been provided
+var ErrNilTransactionsTracker = errors.New("nil transactions tracker")
+
+// ErrNilFeeComputer signals that a nil fee computer has been provided
+var ErrNilFeeComputer = errors.New("nil fee computer")
+
+// ErrNilMempoolExplorer signals that a nil mempool explorer has been provided
+var ErrNilMempoolExplorer = errors.New("nil mempool explorer")
+
+// ErrNilGasPriceRecommender signals that a nil gas price recommender has been provided
+var ErrNilGasPriceRecommender = errors.New("nil gas price recommender")
+
+// ErrNilTransactionBuilder signals that a nil transaction builder has been provided
+var ErrNilTransactionBuilder = errors.New("nil transaction builder")
+
+// ErrNilAddressWatcher signals that a nil address watcher has been provided
+var ErrNilAddressWatcher = errors.New("nil address watcher")
+
+// ErrNilUsernameResolver signals that a nil username resolver has been provided
+var ErrNilUsernameResolver = errors.New("nil username resolver")
+
+// ErrNilTokenProfileProcessor signals that a nil token profile processor has been provided
+var ErrNilTokenProfileProcessor = errors.New("nil token profile processor")
+
+// ErrNilStakingPositionProcessor signals that a nil staking position processor has been provided
+var ErrNilStakingPositionProcessor = errors.New("nil staking position processor")
+
+// ErrNilAddressUtilsProcessor signals that a nil address utils processor has been provided
+var ErrNilAddressUtilsProcessor = errors.New("nil address utils processor")
+
+// ErrNilTransactionsDeduplicator signals that a nil transactions deduplicator has been provided
+var ErrNilTransactionsDeduplicator = errors.New("nil transactions deduplicator")
+
+// ErrNilTransactionWaiter signals that a nil transaction waiter has been provided
+var ErrNilTransactionWaiter = errors.New("nil transaction waiter")
+
+// ErrNilTransactionsBatchProcessor signals that a nil transactions batch processor has been provided
+var ErrNilTransactionsBatchProcessor = errors.New("nil transactions batch processor")
+
+// ErrNilNetworkConfigProvider signals that a nil network config provider has been provided
+var ErrNilNetworkConfigProvider = errors.New("nil network config provider")
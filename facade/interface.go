@@ -1,6 +1,7 @@
 package facade
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -20,14 +21,20 @@ type ActionsProcessor interface {
 type AccountProcessor interface {
 	GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
 	GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
+	GetAccountsBulk(request *data.AccountsBulkRequest, options common.AccountQueryOptions) (*data.AccountsBulkModel, error)
+	GetAccountStateDiff(address string, fromNonce uint64, toNonce uint64) (*data.AccountStateDiff, error)
 	GetShardIDForAddress(address string) (uint32, error)
 	GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error)
 	GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetDecodedAllESDTTokens(address string, options common.AccountQueryOptions) (*data.ESDTTokensMetadataResponseData, error)
 	GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	StreamKeyValuePairs(address string, options common.AccountQueryOptions, filter common.KeyValuePairsQueryOptions, handler func(pair *data.KeyValuePair) error) error
+	GetKeyValuePairsPage(address string, options common.AccountQueryOptions, filter common.KeyValuePairsQueryOptions) (*data.KeyValuePairsPage, error)
 	GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetDecodedESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.ESDTTokenMetadataResponseData, error)
 	GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
@@ -37,16 +44,19 @@ type AccountProcessor interface {
 // TransactionProcessor defines what a transaction request processor should do
 type TransactionProcessor interface {
 	SendTransaction(tx *data.Transaction) (int, string, error)
+	BroadcastTransaction(tx *data.Transaction, numObservers int) (int, *data.TransactionBroadcastResponseData, error)
 	SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
 	SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
 	TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
 	GetTransactionStatus(txHash string, sender string) (string, error)
 	GetTransaction(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error)
 	GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error)
+	GetTransactionExecutionGraph(txHash string) (*data.TransactionExecutionGraph, error)
 	GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error)
 	ComputeTransactionHash(tx *data.Transaction) (string, error)
 	GetTransactionsPool(fields string) (*data.TransactionsPool, error)
 	GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error)
+	GetTransactionsPoolGasForShard(shardID uint32) (*data.TransactionsPool, error)
 	GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error)
@@ -147,3 +157,111 @@ type AboutInfoProcessor interface {
 	GetAboutInfo() *data.GenericAPIResponse
 	GetNodesVersions() (*data.GenericAPIResponse, error)
 }
+
+// NonceProcessor defines what a component which recommends the next nonce of an address should do
+type NonceProcessor interface {
+	GetNextNonce(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error)
+	ReleaseNonceReservation(address string, nonce uint64, token string) error
+}
+
+// TransactionValidator defines what a component which validates transactions before being sent should do
+type TransactionValidator interface {
+	ValidateTransaction(tx *data.Transaction) error
+}
+
+// DataFieldDecoder defines what a component which decodes the data field of transactions should do
+type DataFieldDecoder interface {
+	Decode(dataField []byte, sender string, receiver string) *data.DecodedTransactionData
+}
+
+// DatabaseConnector defines what a component which fetches data from an external database should do
+type DatabaseConnector interface {
+	GetTransactionsByAddress(address string, options common.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error)
+}
+
+// TransactionsTracker defines what a component which tracks the sent transactions should do
+type TransactionsTracker interface {
+	Track(tx *data.Transaction, txHash string)
+	GetTrackedTransaction(txHash string) (*data.TrackedTransaction, error)
+}
+
+// FeeComputer defines what a component which computes the fees of transactions should do
+type FeeComputer interface {
+	ComputeTransactionFee(request *data.TransactionFeeRequest) (*data.TransactionFeeResponseData, error)
+}
+
+// MempoolExplorer defines what a component which explores the snapshots of the transactions pool should do
+type MempoolExplorer interface {
+	GetMempoolPage(options common.MempoolQueryOptions) (*data.MempoolPage, error)
+	GetMempoolStatistics() (*data.MempoolStatistics, error)
+}
+
+// UsernameResolver defines what a component which resolves usernames into addresses should do
+type UsernameResolver interface {
+	ResolveUsername(username string) (*data.UsernameResolution, error)
+	ResolveUsernames(usernames []string) (*data.UsernamesResolutionResponseData, error)
+}
+
+// TokenProfileProcessor defines what a component which aggregates the details of a token should do
+type TokenProfileProcessor interface {
+	GetTokenProfile(identifier string) (*data.ESDTTokenProfile, error)
+}
+
+// StakingPositionProcessor defines what a component which aggregates the staking position of an address should do
+type StakingPositionProcessor interface {
+	GetStakingPosition(address string) (*data.StakingPosition, error)
+}
+
+// AddressUtilsProcessor defines what a component which converts addresses and computes their details should do
+type AddressUtilsProcessor interface {
+	ConvertAddress(address string, hrp string) (*data.AddressConversion, error)
+	ComputeShardIDs(addresses []string) (*data.AddressesShardsResponseData, error)
+	ComputeContractAddress(deployer string, nonce uint64, vmType string) (*data.AddressDetails, error)
+	GetAddressDetails(address string) (*data.AddressDetails, error)
+}
+
+// NetworkConfigProvider defines what a component which provides the cached network config should do
+type NetworkConfigProvider interface {
+	GetNetworkConfig() (*data.NetworkConfig, error)
+}
+
+// TransactionWaiter defines what a component which waits for the outcome of a sent transaction should do
+type TransactionWaiter interface {
+	WaitForTransaction(ctx context.Context, response *data.TransactionSendAndWaitResponseData, options common.TransactionSendOptions) error
+}
+
+// TransactionsBatchProcessor defines what a component which handles the batches of transactions processed in order should do
+type TransactionsBatchProcessor interface {
+	SendOrderedTransactions(
+		txs []*data.Transaction,
+		stopOnRejection bool,
+		sendHandler func(tx *data.Transaction) (int, string, error),
+	) (*data.OrderedTransactionsResponseData, error)
+	SimulateTransactionsBundle(txs []*data.Transaction, checkSignature bool) (*data.BundleSimulationResponseData, error)
+}
+
+// GasPriceRecommender defines what a component which recommends gas prices based on the shards load should do
+type GasPriceRecommender interface {
+	GetGasPriceRecommendation(shardID uint32) (*data.GasPriceRecommendation, error)
+}
+
+// AddressWatcher defines what a component which notifies about the activity of the watched addresses should do
+type AddressWatcher interface {
+	Subscribe(request *data.AddressWatchRequest) (*data.AddressWatchSubscription, error)
+	Unsubscribe(subscriptionID string)
+}
+
+// TransactionBuilder defines what a component which builds unsigned transactions should do
+type TransactionBuilder interface {
+	BuildTransaction(request *data.TransactionBuildRequest) (*data.TransactionBuildResponseData, error)
+}
+
+// TransactionsDeduplicator defines what a component which prevents sending the same transactions again should do
+type TransactionsDeduplicator interface {
+	SendTransaction(tx *data.Transaction, sendHandler func(tx *data.Transaction) (int, string, error)) (int, string, error)
+	SendMultipleTransactions(
+		txs []*data.Transaction,
+		idempotencyKey string,
+		sendHandler func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error),
+	) (data.MultipleTransactionsResponseData, error)
+}
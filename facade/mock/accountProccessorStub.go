@@ -9,6 +9,8 @@ import (
 type AccountProcessorStub struct {
 	GetAccountCalled                        func(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
 	GetAccountsCalled                       func(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
+	GetAccountsBulkCalled                   func(request *data.AccountsBulkRequest, options common.AccountQueryOptions) (*data.AccountsBulkModel, error)
+	GetAccountStateDiffCalled               func(address string, fromNonce uint64, toNonce uint64) (*data.AccountStateDiff, error)
 	GetValueForKeyCalled                    func(address string, key string, options common.AccountQueryOptions) (string, error)
 	GetShardIDForAddressCalled              func(address string) (uint32, error)
 	GetTransactionsCalled                   func(address string) ([]data.DatabaseTransaction, error)
@@ -16,8 +18,12 @@ type AccountProcessorStub struct {
 	GetAllESDTTokensCalled                  func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetESDTTokenDataCalled                  func(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetESDTNftTokenDataCalled               func(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetDecodedESDTNftTokenDataCalled        func(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.ESDTTokenMetadataResponseData, error)
+	GetDecodedAllESDTTokensCalled           func(address string, options common.AccountQueryOptions) (*data.ESDTTokensMetadataResponseData, error)
 	GetESDTsWithRoleCalled                  func(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetNFTTokenIDsRegisteredByAddressCalled func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	StreamKeyValuePairsCalled               func(address string, options common.AccountQueryOptions, filter common.KeyValuePairsQueryOptions, handler func(pair *data.KeyValuePair) error) error
+	GetKeyValuePairsPageCalled              func(address string, options common.AccountQueryOptions, filter common.KeyValuePairsQueryOptions) (*data.KeyValuePairsPage, error)
 	GetKeyValuePairsCalled                  func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetESDTsRolesCalled                     func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetCodeHashCalled                       func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
@@ -45,6 +51,16 @@ func (aps *AccountProcessorStub) GetESDTNftTokenData(address string, key string,
 	return aps.GetESDTNftTokenDataCalled(address, key, nonce, options)
 }
 
+// GetDecodedESDTNftTokenData -
+func (aps *AccountProcessorStub) GetDecodedESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.ESDTTokenMetadataResponseData, error) {
+	return aps.GetDecodedESDTNftTokenDataCalled(address, key, nonce, options)
+}
+
+// GetDecodedAllESDTTokens -
+func (aps *AccountProcessorStub) GetDecodedAllESDTTokens(address string, options common.AccountQueryOptions) (*data.ESDTTokensMetadataResponseData, error) {
+	return aps.GetDecodedAllESDTTokensCalled(address, options)
+}
+
 // GetESDTsWithRole -
 func (aps *AccountProcessorStub) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetESDTsWithRoleCalled(address, role, options)
@@ -69,11 +85,31 @@ func (aps *AccountProcessorStub) GetAccount(address string, options common.Accou
 	return aps.GetAccountCalled(address, options)
 }
 
+// StreamKeyValuePairs -
+func (aps *AccountProcessorStub) StreamKeyValuePairs(address string, options common.AccountQueryOptions, filter common.KeyValuePairsQueryOptions, handler func(pair *data.KeyValuePair) error) error {
+	return aps.StreamKeyValuePairsCalled(address, options, filter, handler)
+}
+
+// GetKeyValuePairsPage -
+func (aps *AccountProcessorStub) GetKeyValuePairsPage(address string, options common.AccountQueryOptions, filter common.KeyValuePairsQueryOptions) (*data.KeyValuePairsPage, error) {
+	return aps.GetKeyValuePairsPageCalled(address, options, filter)
+}
+
 // GetAccounts -
 func (aps *AccountProcessorStub) GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
 	return aps.GetAccountsCalled(addresses, options)
 }
 
+// GetAccountsBulk -
+func (aps *AccountProcessorStub) GetAccountsBulk(request *data.AccountsBulkRequest, options common.AccountQueryOptions) (*data.AccountsBulkModel, error) {
+	return aps.GetAccountsBulkCalled(request, options)
+}
+
+// GetAccountStateDiff -
+func (aps *AccountProcessorStub) GetAccountStateDiff(address string, fromNonce uint64, toNonce uint64) (*data.AccountStateDiff, error) {
+	return aps.GetAccountStateDiffCalled(address, fromNonce, toNonce)
+}
+
 // GetValueForKey -
 func (aps *AccountProcessorStub) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
 	return aps.GetValueForKeyCalled(address, key, options)
@@ -0,0 +1,47 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// AddressUtilsProcessorStub -
+type AddressUtilsProcessorStub struct {
+	ConvertAddressCalled         func(address string, hrp string) (*data.AddressConversion, error)
+	ComputeShardIDsCalled        func(addresses []string) (*data.AddressesShardsResponseData, error)
+	ComputeContractAddressCalled func(deployer string, nonce uint64, vmType string) (*data.AddressDetails, error)
+	GetAddressDetailsCalled      func(address string) (*data.AddressDetails, error)
+}
+
+// ConvertAddress -
+func (stub *AddressUtilsProcessorStub) ConvertAddress(address string, hrp string) (*data.AddressConversion, error) {
+	if stub.ConvertAddressCalled != nil {
+		return stub.ConvertAddressCalled(address, hrp)
+	}
+
+	return &data.AddressConversion{}, nil
+}
+
+// ComputeShardIDs -
+func (stub *AddressUtilsProcessorStub) ComputeShardIDs(addresses []string) (*data.AddressesShardsResponseData, error) {
+	if stub.ComputeShardIDsCalled != nil {
+		return stub.ComputeShardIDsCalled(addresses)
+	}
+
+	return &data.AddressesShardsResponseData{}, nil
+}
+
+// ComputeContractAddress -
+func (stub *AddressUtilsProcessorStub) ComputeContractAddress(deployer string, nonce uint64, vmType string) (*data.AddressDetails, error) {
+	if stub.ComputeContractAddressCalled != nil {
+		return stub.ComputeContractAddressCalled(deployer, nonce, vmType)
+	}
+
+	return &data.AddressDetails{}, nil
+}
+
+// GetAddressDetails -
+func (stub *AddressUtilsProcessorStub) GetAddressDetails(address string) (*data.AddressDetails, error) {
+	if stub.GetAddressDetailsCalled != nil {
+		return stub.GetAddressDetailsCalled(address)
+	}
+
+	return &data.AddressDetails{}, nil
+}
@@ -0,0 +1,25 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// AddressWatcherStub -
+type AddressWatcherStub struct {
+	SubscribeCalled   func(request *data.AddressWatchRequest) (*data.AddressWatchSubscription, error)
+	UnsubscribeCalled func(subscriptionID string)
+}
+
+// Subscribe -
+func (stub *AddressWatcherStub) Subscribe(request *data.AddressWatchRequest) (*data.AddressWatchSubscription, error) {
+	if stub.SubscribeCalled != nil {
+		return stub.SubscribeCalled(request)
+	}
+
+	return &data.AddressWatchSubscription{}, nil
+}
+
+// Unsubscribe -
+func (stub *AddressWatcherStub) Unsubscribe(subscriptionID string) {
+	if stub.UnsubscribeCalled != nil {
+		stub.UnsubscribeCalled(subscriptionID)
+	}
+}
@@ -16,6 +16,7 @@ type BlockProcessorStub struct {
 	GetInternalMiniBlockByHashCalled            func(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
 	GetInternalStartOfEpochMetaBlockCalled      func(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalStartOfEpochValidatorsInfoCalled func(epoch uint32) (*data.ValidatorsInfoApiResponse, error)
+	GetAlteredAccountsByNonceCalled             func(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
 }
 
 func (bps *BlockProcessorStub) GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
@@ -66,6 +67,10 @@ func (bps *BlockProcessorStub) GetInternalStartOfEpochMetaBlock(epoch uint32, fo
 
 // GetAlteredAccountsByNonce -
 func (bps *BlockProcessorStub) GetAlteredAccountsByNonce(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
+	if bps.GetAlteredAccountsByNonceCalled != nil {
+		return bps.GetAlteredAccountsByNonceCalled(shardID, nonce, options)
+	}
+
 	return nil, nil
 }
 
@@ -0,0 +1,17 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// DataFieldDecoderStub -
+type DataFieldDecoderStub struct {
+	DecodeCalled func(dataField []byte, sender string, receiver string) *data.DecodedTransactionData
+}
+
+// Decode -
+func (stub *DataFieldDecoderStub) Decode(dataField []byte, sender string, receiver string) *data.DecodedTransactionData {
+	if stub.DecodeCalled != nil {
+		return stub.DecodeCalled(dataField, sender, receiver)
+	}
+
+	return nil
+}
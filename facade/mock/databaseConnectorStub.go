@@ -0,0 +1,20 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// DatabaseConnectorStub -
+type DatabaseConnectorStub struct {
+	GetTransactionsByAddressCalled func(address string, options common.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error)
+}
+
+// GetTransactionsByAddress -
+func (stub *DatabaseConnectorStub) GetTransactionsByAddress(address string, options common.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	if stub.GetTransactionsByAddressCalled != nil {
+		return stub.GetTransactionsByAddressCalled(address, options)
+	}
+
+	return make([]data.DatabaseTransaction, 0), nil
+}
@@ -0,0 +1,17 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// FeeComputerStub -
+type FeeComputerStub struct {
+	ComputeTransactionFeeCalled func(request *data.TransactionFeeRequest) (*data.TransactionFeeResponseData, error)
+}
+
+// ComputeTransactionFee -
+func (stub *FeeComputerStub) ComputeTransactionFee(request *data.TransactionFeeRequest) (*data.TransactionFeeResponseData, error) {
+	if stub.ComputeTransactionFeeCalled != nil {
+		return stub.ComputeTransactionFeeCalled(request)
+	}
+
+	return &data.TransactionFeeResponseData{}, nil
+}
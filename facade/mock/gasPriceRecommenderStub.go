@@ -0,0 +1,17 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// GasPriceRecommenderStub -
+type GasPriceRecommenderStub struct {
+	GetGasPriceRecommendationCalled func(shardID uint32) (*data.GasPriceRecommendation, error)
+}
+
+// GetGasPriceRecommendation -
+func (stub *GasPriceRecommenderStub) GetGasPriceRecommendation(shardID uint32) (*data.GasPriceRecommendation, error) {
+	if stub.GetGasPriceRecommendationCalled != nil {
+		return stub.GetGasPriceRecommendationCalled(shardID)
+	}
+
+	return &data.GasPriceRecommendation{}, nil
+}
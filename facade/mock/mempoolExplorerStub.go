@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// MempoolExplorerStub -
+type MempoolExplorerStub struct {
+	GetMempoolPageCalled       func(options common.MempoolQueryOptions) (*data.MempoolPage, error)
+	GetMempoolStatisticsCalled func() (*data.MempoolStatistics, error)
+}
+
+// GetMempoolPage -
+func (stub *MempoolExplorerStub) GetMempoolPage(options common.MempoolQueryOptions) (*data.MempoolPage, error) {
+	if stub.GetMempoolPageCalled != nil {
+		return stub.GetMempoolPageCalled(options)
+	}
+
+	return &data.MempoolPage{}, nil
+}
+
+// GetMempoolStatistics -
+func (stub *MempoolExplorerStub) GetMempoolStatistics() (*data.MempoolStatistics, error) {
+	if stub.GetMempoolStatisticsCalled != nil {
+		return stub.GetMempoolStatisticsCalled()
+	}
+
+	return &data.MempoolStatistics{}, nil
+}
@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// NonceProcessorStub -
+type NonceProcessorStub struct {
+	GetNextNonceCalled            func(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error)
+	ReleaseNonceReservationCalled func(address string, nonce uint64, token string) error
+}
+
+// GetNextNonce -
+func (stub *NonceProcessorStub) GetNextNonce(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error) {
+	if stub.GetNextNonceCalled != nil {
+		return stub.GetNextNonceCalled(address, options)
+	}
+
+	return &data.NextNonceResponseData{}, nil
+}
+
+// ReleaseNonceReservation -
+func (stub *NonceProcessorStub) ReleaseNonceReservation(address string, nonce uint64, token string) error {
+	if stub.ReleaseNonceReservationCalled != nil {
+		return stub.ReleaseNonceReservationCalled(address, nonce, token)
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// StakingPositionProcessorStub -
+type StakingPositionProcessorStub struct {
+	GetStakingPositionCalled func(address string) (*data.StakingPosition, error)
+}
+
+// GetStakingPosition -
+func (stub *StakingPositionProcessorStub) GetStakingPosition(address string) (*data.StakingPosition, error) {
+	if stub.GetStakingPositionCalled != nil {
+		return stub.GetStakingPositionCalled(address)
+	}
+
+	return &data.StakingPosition{}, nil
+}
@@ -0,0 +1,17 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// TokenProfileProcessorStub -
+type TokenProfileProcessorStub struct {
+	GetTokenProfileCalled func(identifier string) (*data.ESDTTokenProfile, error)
+}
+
+// GetTokenProfile -
+func (stub *TokenProfileProcessorStub) GetTokenProfile(identifier string) (*data.ESDTTokenProfile, error) {
+	if stub.GetTokenProfileCalled != nil {
+		return stub.GetTokenProfileCalled(identifier)
+	}
+
+	return &data.ESDTTokenProfile{}, nil
+}
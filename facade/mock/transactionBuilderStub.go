@@ -0,0 +1,17 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// TransactionBuilderStub -
+type TransactionBuilderStub struct {
+	BuildTransactionCalled func(request *data.TransactionBuildRequest) (*data.TransactionBuildResponseData, error)
+}
+
+// BuildTransaction -
+func (stub *TransactionBuilderStub) BuildTransaction(request *data.TransactionBuildRequest) (*data.TransactionBuildResponseData, error) {
+	if stub.BuildTransactionCalled != nil {
+		return stub.BuildTransactionCalled(request)
+	}
+
+	return &data.TransactionBuildResponseData{}, nil
+}
@@ -13,17 +13,20 @@ var errNotImplemented = errors.New("not implemented")
 // TransactionProcessorStub -
 type TransactionProcessorStub struct {
 	SendTransactionCalled                       func(tx *data.Transaction) (int, string, error)
+	BroadcastTransactionCalled                  func(tx *data.Transaction, numObservers int) (int, *data.TransactionBroadcastResponseData, error)
 	SendMultipleTransactionsCalled              func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
 	SimulateTransactionCalled                   func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
 	SendUserFundsCalled                         func(receiver string, value *big.Int) error
 	TransactionCostRequestCalled                func(tx *data.Transaction) (*data.TxCostResponseData, error)
 	GetTransactionStatusCalled                  func(txHash string, sender string) (string, error)
 	GetProcessedTransactionStatusCalled         func(txHash string) (*data.ProcessStatusResponse, error)
+	GetTransactionExecutionGraphCalled          func(txHash string) (*data.TransactionExecutionGraph, error)
 	GetTransactionCalled                        func(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error)
 	GetTransactionByHashAndSenderAddressCalled  func(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error)
 	ComputeTransactionHashCalled                func(tx *data.Transaction) (string, error)
 	GetTransactionsPoolCalled                   func(fields string) (*data.TransactionsPool, error)
 	GetTransactionsPoolForShardCalled           func(shardID uint32, fields string) (*data.TransactionsPool, error)
+	GetTransactionsPoolGasForShardCalled        func(shardID uint32) (*data.TransactionsPool, error)
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*data.TransactionsPoolForSender, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*data.TransactionsPoolNonceGaps, error)
@@ -47,6 +50,15 @@ func (tps *TransactionProcessorStub) SendTransaction(tx *data.Transaction) (int,
 	return 0, "", errNotImplemented
 }
 
+// BroadcastTransaction -
+func (tps *TransactionProcessorStub) BroadcastTransaction(tx *data.Transaction, numObservers int) (int, *data.TransactionBroadcastResponseData, error) {
+	if tps.BroadcastTransactionCalled != nil {
+		return tps.BroadcastTransactionCalled(tx, numObservers)
+	}
+
+	return 0, nil, errNotImplemented
+}
+
 // SendMultipleTransactions -
 func (tps *TransactionProcessorStub) SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
 	if tps.SendMultipleTransactionsCalled != nil {
@@ -92,6 +104,15 @@ func (tps *TransactionProcessorStub) GetProcessedTransactionStatus(txHash string
 	return &data.ProcessStatusResponse{}, errNotImplemented
 }
 
+// GetTransactionExecutionGraph -
+func (tps *TransactionProcessorStub) GetTransactionExecutionGraph(txHash string) (*data.TransactionExecutionGraph, error) {
+	if tps.GetTransactionExecutionGraphCalled != nil {
+		return tps.GetTransactionExecutionGraphCalled(txHash)
+	}
+
+	return nil, errNotImplemented
+}
+
 // GetTransaction -
 func (tps *TransactionProcessorStub) GetTransaction(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error) {
 	if tps.GetTransactionCalled != nil {
@@ -137,6 +158,15 @@ func (tps *TransactionProcessorStub) GetTransactionsPoolForShard(shardID uint32,
 	return nil, errNotImplemented
 }
 
+// GetTransactionsPoolGasForShard -
+func (tps *TransactionProcessorStub) GetTransactionsPoolGasForShard(shardID uint32) (*data.TransactionsPool, error) {
+	if tps.GetTransactionsPoolGasForShardCalled != nil {
+		return tps.GetTransactionsPoolGasForShardCalled(shardID)
+	}
+
+	return nil, errNotImplemented
+}
+
 // GetTransactionsPoolForSender -
 func (tps *TransactionProcessorStub) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
 	if tps.GetTransactionsPoolForSenderCalled != nil {
@@ -0,0 +1,17 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// TransactionValidatorStub -
+type TransactionValidatorStub struct {
+	ValidateTransactionCalled func(tx *data.Transaction) error
+}
+
+// ValidateTransaction -
+func (stub *TransactionValidatorStub) ValidateTransaction(tx *data.Transaction) error {
+	if stub.ValidateTransactionCalled != nil {
+		return stub.ValidateTransactionCalled(tx)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// TransactionWaiterStub -
+type TransactionWaiterStub struct {
+	WaitForTransactionCalled func(ctx context.Context, response *data.TransactionSendAndWaitResponseData, options common.TransactionSendOptions) error
+}
+
+// WaitForTransaction -
+func (stub *TransactionWaiterStub) WaitForTransaction(ctx context.Context, response *data.TransactionSendAndWaitResponseData, options common.TransactionSendOptions) error {
+	if stub.WaitForTransactionCalled != nil {
+		return stub.WaitForTransactionCalled(ctx, response, options)
+	}
+
+	return nil
+}
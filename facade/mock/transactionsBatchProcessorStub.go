@@ -0,0 +1,31 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// TransactionsBatchProcessorStub -
+type TransactionsBatchProcessorStub struct {
+	SendOrderedTransactionsCalled    func(txs []*data.Transaction, stopOnRejection bool, sendHandler func(tx *data.Transaction) (int, string, error)) (*data.OrderedTransactionsResponseData, error)
+	SimulateTransactionsBundleCalled func(txs []*data.Transaction, checkSignature bool) (*data.BundleSimulationResponseData, error)
+}
+
+// SendOrderedTransactions -
+func (stub *TransactionsBatchProcessorStub) SendOrderedTransactions(
+	txs []*data.Transaction,
+	stopOnRejection bool,
+	sendHandler func(tx *data.Transaction) (int, string, error),
+) (*data.OrderedTransactionsResponseData, error) {
+	if stub.SendOrderedTransactionsCalled != nil {
+		return stub.SendOrderedTransactionsCalled(txs, stopOnRejection, sendHandler)
+	}
+
+	return &data.OrderedTransactionsResponseData{}, nil
+}
+
+// SimulateTransactionsBundle -
+func (stub *TransactionsBatchProcessorStub) SimulateTransactionsBundle(txs []*data.Transaction, checkSignature bool) (*data.BundleSimulationResponseData, error) {
+	if stub.SimulateTransactionsBundleCalled != nil {
+		return stub.SimulateTransactionsBundleCalled(txs, checkSignature)
+	}
+
+	return &data.BundleSimulationResponseData{}, nil
+}
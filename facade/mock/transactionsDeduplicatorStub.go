@@ -0,0 +1,34 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// TransactionsDeduplicatorStub -
+type TransactionsDeduplicatorStub struct {
+	SendTransactionCalled          func(tx *data.Transaction, sendHandler func(tx *data.Transaction) (int, string, error)) (int, string, error)
+	SendMultipleTransactionsCalled func(txs []*data.Transaction, idempotencyKey string, sendHandler func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)) (data.MultipleTransactionsResponseData, error)
+}
+
+// SendTransaction -
+func (stub *TransactionsDeduplicatorStub) SendTransaction(
+	tx *data.Transaction,
+	sendHandler func(tx *data.Transaction) (int, string, error),
+) (int, string, error) {
+	if stub.SendTransactionCalled != nil {
+		return stub.SendTransactionCalled(tx, sendHandler)
+	}
+
+	return sendHandler(tx)
+}
+
+// SendMultipleTransactions -
+func (stub *TransactionsDeduplicatorStub) SendMultipleTransactions(
+	txs []*data.Transaction,
+	idempotencyKey string,
+	sendHandler func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error),
+) (data.MultipleTransactionsResponseData, error) {
+	if stub.SendMultipleTransactionsCalled != nil {
+		return stub.SendMultipleTransactionsCalled(txs, idempotencyKey, sendHandler)
+	}
+
+	return sendHandler(txs)
+}
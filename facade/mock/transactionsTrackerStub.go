@@ -0,0 +1,25 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// TransactionsTrackerStub -
+type TransactionsTrackerStub struct {
+	TrackCalled                 func(tx *data.Transaction, txHash string)
+	GetTrackedTransactionCalled func(txHash string) (*data.TrackedTransaction, error)
+}
+
+// Track -
+func (stub *TransactionsTrackerStub) Track(tx *data.Transaction, txHash string) {
+	if stub.TrackCalled != nil {
+		stub.TrackCalled(tx, txHash)
+	}
+}
+
+// GetTrackedTransaction -
+func (stub *TransactionsTrackerStub) GetTrackedTransaction(txHash string) (*data.TrackedTransaction, error) {
+	if stub.GetTrackedTransactionCalled != nil {
+		return stub.GetTrackedTransactionCalled(txHash)
+	}
+
+	return &data.TrackedTransaction{}, nil
+}
@@ -0,0 +1,27 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// UsernameResolverStub -
+type UsernameResolverStub struct {
+	ResolveUsernameCalled  func(username string) (*data.UsernameResolution, error)
+	ResolveUsernamesCalled func(usernames []string) (*data.UsernamesResolutionResponseData, error)
+}
+
+// ResolveUsername -
+func (stub *UsernameResolverStub) ResolveUsername(username string) (*data.UsernameResolution, error) {
+	if stub.ResolveUsernameCalled != nil {
+		return stub.ResolveUsernameCalled(username)
+	}
+
+	return &data.UsernameResolution{}, nil
+}
+
+// ResolveUsernames -
+func (stub *UsernameResolverStub) ResolveUsernames(usernames []string) (*data.UsernamesResolutionResponseData, error) {
+	if stub.ResolveUsernamesCalled != nil {
+		return stub.ResolveUsernamesCalled(usernames)
+	}
+
+	return &data.UsernamesResolutionResponseData{}, nil
+}
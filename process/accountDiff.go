@@ -0,0 +1,278 @@
+package process
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type accountState struct {
+	account *data.AccountModel
+	tokens  map[string]string
+	pairs   map[string]string
+}
+
+type keyValuePairsResponseData struct {
+	Pairs map[string]string `json:"pairs"`
+}
+
+// GetAccountStateDiff returns how the account, its tokens and its storage changed between the two provided block nonces.
+// For adjacent blocks, the altered accounts of the latter block are checked first, so that the tokens and the storage
+// of an account not touched by it are not fetched
+func (ap *AccountProcessor) GetAccountStateDiff(address string, fromNonce uint64, toNonce uint64) (*data.AccountStateDiff, error) {
+	getState := ap.getAccountStateAtNonce
+	if toNonce == fromNonce+1 && !ap.isAccountAlteredInBlock(address, toNonce) {
+		getState = ap.getAccountAtNonce
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	var fromState, toState *accountState
+	var fromErr, toErr error
+	go func() {
+		defer wg.Done()
+		fromState, fromErr = getState(address, fromNonce)
+	}()
+	go func() {
+		defer wg.Done()
+		toState, toErr = getState(address, toNonce)
+	}()
+
+	wg.Wait()
+
+	if fromErr != nil {
+		return nil, fromErr
+	}
+	if toErr != nil {
+		return nil, toErr
+	}
+
+	return computeAccountStateDiff(address, fromState, toState)
+}
+
+// isAccountAlteredInBlock returns true if the account was altered in the block or if this cannot be determined
+func (ap *AccountProcessor) isAccountAlteredInBlock(address string, nonce uint64) bool {
+	shardID, err := ap.GetShardIDForAddress(address)
+	if err != nil {
+		return true
+	}
+
+	observers, err := ap.proc.GetObservers(shardID, data.AvailabilityAll)
+	if err != nil {
+		return true
+	}
+
+	path := fmt.Sprintf("%s/%d", alteredAccountByBlockNonce, nonce)
+	for _, observer := range observers {
+		response := data.AlteredAccountsApiResponse{}
+		_, err = ap.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		if err != nil {
+			log.Error("altered accounts request by nonce", "observer", observer.Address, "error", err.Error())
+			continue
+		}
+
+		for _, alteredAccount := range response.Data.Accounts {
+			if alteredAccount != nil && alteredAccount.Address == address {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	log.Debug("cannot get altered accounts, falling back to a full account diff", "shard", shardID, "nonce", nonce)
+	return true
+}
+
+// getAccountAtNonce returns the state of the account without its tokens and its storage
+func (ap *AccountProcessor) getAccountAtNonce(address string, nonce uint64) (*accountState, error) {
+	options := common.AccountQueryOptions{
+		BlockNonce: core.OptionalUint64{Value: nonce, HasValue: true},
+	}
+
+	account, err := ap.GetAccount(address, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w while trying to get the account at block %d", err, nonce)
+	}
+
+	return &accountState{
+		account: account,
+	}, nil
+}
+
+func (ap *AccountProcessor) getAccountStateAtNonce(address string, nonce uint64) (*accountState, error) {
+	state, err := ap.getAccountAtNonce(address, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	options := common.AccountQueryOptions{
+		BlockNonce: core.OptionalUint64{Value: nonce, HasValue: true},
+	}
+
+	tokensResponse, err := ap.GetAllESDTTokens(address, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w while trying to get the tokens at block %d", err, nonce)
+	}
+	allTokens := allESDTTokensResponseData{}
+	err = convertResponseData(tokensResponse.Data, &allTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	pairsResponse, err := ap.GetKeyValuePairs(address, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w while trying to get the key-value pairs at block %d", err, nonce)
+	}
+	keyValuePairs := keyValuePairsResponseData{}
+	err = convertResponseData(pairsResponse.Data, &keyValuePairs)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make(map[string]string, len(allTokens.Tokens))
+	for identifier, tokenData := range allTokens.Tokens {
+		tokens[identifier] = tokenData.Balance
+	}
+
+	state.tokens = tokens
+	state.pairs = keyValuePairs.Pairs
+
+	return state, nil
+}
+
+func computeAccountStateDiff(address string, fromState *accountState, toState *accountState) (*data.AccountStateDiff, error) {
+	fromAccount := fromState.account.Account
+	toAccount := toState.account.Account
+	balanceDelta, err := computeBalanceDelta(fromAccount.Balance, toAccount.Balance)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &data.AccountStateDiff{
+		Address:        address,
+		FromBlock:      fromState.account.BlockInfo,
+		ToBlock:        toState.account.BlockInfo,
+		FromBalance:    fromAccount.Balance,
+		ToBalance:      toAccount.Balance,
+		BalanceDelta:   balanceDelta,
+		FromNonce:      fromAccount.Nonce,
+		ToNonce:        toAccount.Nonce,
+		NonceDelta:     toAccount.Nonce - fromAccount.Nonce,
+		TokensAdded:    make([]*data.TokenBalanceDiff, 0),
+		TokensRemoved:  make([]*data.TokenBalanceDiff, 0),
+		TokensChanged:  make([]*data.TokenBalanceDiff, 0),
+		StorageChanged: make([]*data.StorageKeyDiff, 0),
+	}
+
+	for _, identifier := range sortedUnionOfKeys(fromState.tokens, toState.tokens) {
+		fromBalance, existedBefore := fromState.tokens[identifier]
+		toBalance, existsAfter := toState.tokens[identifier]
+		if fromBalance == toBalance && existedBefore == existsAfter {
+			continue
+		}
+
+		tokenDiff, errToken := createTokenBalanceDiff(identifier, fromBalance, toBalance)
+		if errToken != nil {
+			return nil, errToken
+		}
+
+		switch {
+		case !existedBefore:
+			diff.TokensAdded = append(diff.TokensAdded, tokenDiff)
+		case !existsAfter:
+			diff.TokensRemoved = append(diff.TokensRemoved, tokenDiff)
+		default:
+			diff.TokensChanged = append(diff.TokensChanged, tokenDiff)
+		}
+	}
+
+	for _, key := range sortedUnionOfKeys(fromState.pairs, toState.pairs) {
+		fromValue := fromState.pairs[key]
+		toValue := toState.pairs[key]
+		if fromValue == toValue {
+			continue
+		}
+
+		diff.StorageChanged = append(diff.StorageChanged, &data.StorageKeyDiff{
+			Key:       key,
+			FromValue: fromValue,
+			ToValue:   toValue,
+		})
+	}
+
+	return diff, nil
+}
+
+func createTokenBalanceDiff(identifier string, fromBalance string, toBalance string) (*data.TokenBalanceDiff, error) {
+	delta, err := computeBalanceDelta(fromBalance, toBalance)
+	if err != nil {
+		return nil, fmt.Errorf("%w for token %s", err, identifier)
+	}
+
+	return &data.TokenBalanceDiff{
+		Identifier:  identifier,
+		FromBalance: fromBalance,
+		ToBalance:   toBalance,
+		Delta:       delta,
+	}, nil
+}
+
+// computeBalanceDelta returns the signed difference between the two balances, a missing balance being considered zero
+func computeBalanceDelta(fromBalance string, toBalance string) (string, error) {
+	from, err := parseBalance(fromBalance)
+	if err != nil {
+		return "", err
+	}
+	to, err := parseBalance(toBalance)
+	if err != nil {
+		return "", err
+	}
+
+	return big.NewInt(0).Sub(to, from).String(), nil
+}
+
+func parseBalance(balance string) (*big.Int, error) {
+	if len(balance) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	value, ok := big.NewInt(0).SetString(balance, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidBalance, balance)
+	}
+
+	return value, nil
+}
+
+func sortedUnionOfKeys(first map[string]string, second map[string]string) []string {
+	keys := make([]string, 0, len(first)+len(second))
+	for key := range first {
+		keys = append(keys, key)
+	}
+	for key := range second {
+		_, found := first[key]
+		if !found {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func convertResponseData(responseData interface{}, destination interface{}) error {
+	responseBytes, err := json.Marshal(responseData)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(responseBytes, destination)
+}
@@ -0,0 +1,186 @@
+package process_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountProcessor_GetAccountStateDiff(t *testing.T) {
+	t.Parallel()
+
+	type stateAtBlock struct {
+		balance string
+		nonce   uint64
+		tokens  map[string]interface{}
+		pairs   map[string]interface{}
+	}
+	states := map[string]stateAtBlock{
+		"blockNonce=10": {
+			balance: "1000",
+			nonce:   3,
+			tokens: map[string]interface{}{
+				"TKN-123456":   map[string]interface{}{"balance": "50"},
+				"OLD-abcdef":   map[string]interface{}{"balance": "7"},
+				"STABLE-abcde": map[string]interface{}{"balance": "1"},
+			},
+			pairs: map[string]interface{}{"aa": "01", "bb": "02"},
+		},
+		"blockNonce=20": {
+			balance: "400",
+			nonce:   5,
+			tokens: map[string]interface{}{
+				"TKN-123456":   map[string]interface{}{"balance": "80"},
+				"NEW-abcdef":   map[string]interface{}{"balance": "3"},
+				"STABLE-abcde": map[string]interface{}{"balance": "1"},
+			},
+			pairs: map[string]interface{}{"aa": "03", "cc": "04"},
+		},
+	}
+
+	createProcessorStub := func(accountErr error) *mock.ProcessorStub {
+		return &mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardID uint32, availability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer", ShardId: shardID}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				state := states[path[strings.Index(path, "?")+1:]]
+				switch {
+				case strings.Contains(path, "/esdt"):
+					value.(*data.GenericAPIResponse).Data = map[string]interface{}{"esdts": state.tokens}
+				case strings.Contains(path, "/keys"):
+					value.(*data.GenericAPIResponse).Data = map[string]interface{}{"pairs": state.pairs}
+				default:
+					if accountErr != nil {
+						return http.StatusInternalServerError, accountErr
+					}
+					value.(*data.AccountApiResponse).Data = data.AccountModel{
+						Account: data.Account{Balance: state.balance, Nonce: state.nonce},
+					}
+				}
+
+				return http.StatusOK, nil
+			},
+		}
+	}
+
+	t.Run("observers error should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(createProcessorStub(errors.New("observer error")), &mock.PubKeyConverterMock{}, createTestAccountsBulkLimits())
+
+		diff, err := ap.GetAccountStateDiff("aabb", 10, 20)
+		require.Nil(t, diff)
+		require.ErrorIs(t, err, process.ErrSendingRequest)
+	})
+	t.Run("should compute the diff", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(createProcessorStub(nil), &mock.PubKeyConverterMock{}, createTestAccountsBulkLimits())
+
+		diff, err := ap.GetAccountStateDiff("aabb", 10, 20)
+		require.Nil(t, err)
+		require.Equal(t, "aabb", diff.Address)
+		require.Equal(t, "1000", diff.FromBalance)
+		require.Equal(t, "400", diff.ToBalance)
+		require.Equal(t, "-600", diff.BalanceDelta)
+		require.Equal(t, uint64(2), diff.NonceDelta)
+		require.Equal(t, []*data.TokenBalanceDiff{{Identifier: "NEW-abcdef", ToBalance: "3", Delta: "3"}}, diff.TokensAdded)
+		require.Equal(t, []*data.TokenBalanceDiff{{Identifier: "OLD-abcdef", FromBalance: "7", Delta: "-7"}}, diff.TokensRemoved)
+		require.Equal(t, []*data.TokenBalanceDiff{{Identifier: "TKN-123456", FromBalance: "50", ToBalance: "80", Delta: "30"}}, diff.TokensChanged)
+		require.Equal(t, []*data.StorageKeyDiff{
+			{Key: "aa", FromValue: "01", ToValue: "03"},
+			{Key: "bb", FromValue: "02"},
+			{Key: "cc", ToValue: "04"},
+		}, diff.StorageChanged)
+	})
+}
+
+func TestAccountProcessor_GetAccountStateDiffAdjacentBlocks(t *testing.T) {
+	t.Parallel()
+
+	address := "aabb"
+	createProcessorStub := func(alteredAddresses []string, fetchedPaths *[]string, mut *sync.Mutex) *mock.ProcessorStub {
+		return &mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 1, nil
+			},
+			GetObserversCalled: func(shardID uint32, availability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer", ShardId: shardID}}, nil
+			},
+			CallGetRestEndPointCalled: func(observer string, path string, value interface{}) (int, error) {
+				mut.Lock()
+				*fetchedPaths = append(*fetchedPaths, path)
+				mut.Unlock()
+
+				switch {
+				case strings.HasPrefix(path, "/block/altered-accounts/by-nonce/"):
+					require.Equal(t, "/block/altered-accounts/by-nonce/11", path)
+					accounts := make([]*alteredAccount.AlteredAccount, 0, len(alteredAddresses))
+					for _, alteredAddress := range alteredAddresses {
+						accounts = append(accounts, &alteredAccount.AlteredAccount{Address: alteredAddress})
+					}
+					value.(*data.AlteredAccountsApiResponse).Data.Accounts = accounts
+				case strings.Contains(path, "/esdt"):
+					value.(*data.GenericAPIResponse).Data = map[string]interface{}{"esdts": map[string]interface{}{}}
+				case strings.Contains(path, "/keys"):
+					value.(*data.GenericAPIResponse).Data = map[string]interface{}{"pairs": map[string]interface{}{}}
+				default:
+					nonce := uint64(10)
+					if strings.HasSuffix(path, "blockNonce=11") {
+						nonce = 11
+					}
+					value.(*data.AccountApiResponse).Data = data.AccountModel{
+						Account:   data.Account{Address: address, Nonce: 5, Balance: "100"},
+						BlockInfo: data.BlockInfo{Nonce: nonce, Hash: fmt.Sprintf("hash%d", nonce), RootHash: fmt.Sprintf("rootHash%d", nonce)},
+					}
+				}
+
+				return http.StatusOK, nil
+			},
+		}
+	}
+
+	t.Run("account not altered in the latter block should not fetch the tokens and the storage", func(t *testing.T) {
+		t.Parallel()
+
+		mut := &sync.Mutex{}
+		fetchedPaths := make([]string, 0)
+		ap, _ := process.NewAccountProcessor(createProcessorStub([]string{"other"}, &fetchedPaths, mut), &mock.PubKeyConverterMock{}, createTestAccountsBulkLimits())
+
+		diff, err := ap.GetAccountStateDiff(address, 10, 11)
+		require.Nil(t, err)
+		require.Len(t, fetchedPaths, 3)
+		require.Equal(t, "0", diff.BalanceDelta)
+		require.Equal(t, "100", diff.FromBalance)
+		require.Equal(t, uint64(0), diff.NonceDelta)
+		require.Equal(t, data.BlockInfo{Nonce: 10, Hash: "hash10", RootHash: "rootHash10"}, diff.FromBlock)
+		require.Equal(t, data.BlockInfo{Nonce: 11, Hash: "hash11", RootHash: "rootHash11"}, diff.ToBlock)
+		require.Empty(t, diff.TokensChanged)
+		require.Empty(t, diff.StorageChanged)
+	})
+	t.Run("account altered in the latter block should be fully diffed", func(t *testing.T) {
+		t.Parallel()
+
+		mut := &sync.Mutex{}
+		fetchedPaths := make([]string, 0)
+		ap, _ := process.NewAccountProcessor(createProcessorStub([]string{"other", address}, &fetchedPaths, mut), &mock.PubKeyConverterMock{}, createTestAccountsBulkLimits())
+
+		diff, err := ap.GetAccountStateDiff(address, 10, 11)
+		require.Nil(t, err)
+		require.Len(t, fetchedPaths, 7)
+		require.Equal(t, data.BlockInfo{Nonce: 10, Hash: "hash10", RootHash: "rootHash10"}, diff.FromBlock)
+	})
+}
@@ -21,21 +21,27 @@ type AccountProcessor struct {
 	proc                 Processor
 	pubKeyConverter      core.PubkeyConverter
 	availabilityProvider availabilityCommon.AvailabilityProvider
+	bulkLimits           AccountsBulkLimits
 }
 
 // NewAccountProcessor creates a new instance of AccountProcessor
-func NewAccountProcessor(proc Processor, pubKeyConverter core.PubkeyConverter) (*AccountProcessor, error) {
+func NewAccountProcessor(proc Processor, pubKeyConverter core.PubkeyConverter, bulkLimits AccountsBulkLimits) (*AccountProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
 	if check.IfNil(pubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
+	err := checkAccountsBulkLimits(bulkLimits)
+	if err != nil {
+		return nil, err
+	}
 
 	return &AccountProcessor{
 		proc:                 proc,
 		pubKeyConverter:      pubKeyConverter,
 		availabilityProvider: availabilityCommon.AvailabilityProvider{},
+		bulkLimits:           bulkLimits,
 	}, nil
 }
 
@@ -125,7 +131,8 @@ func (ap *AccountProcessor) GetAccounts(addresses []string, options common.Accou
 }
 
 func (ap *AccountProcessor) getAccountsInShard(addresses []string, shardID uint32, options common.AccountQueryOptions) (map[string]*data.Account, error) {
-	observers, err := ap.proc.GetObservers(shardID, data.AvailabilityRecent)
+	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
+	observers, err := ap.proc.GetObservers(shardID, availability)
 	if err != nil {
 		return nil, err
 	}
@@ -17,10 +17,19 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func createTestAccountsBulkLimits() process.AccountsBulkLimits {
+	return process.AccountsBulkLimits{
+		MaxAccounts:                         100,
+		MaxTokens:                           20,
+		MaxConcurrentGroups:                 5,
+		MaxConcurrentTokensRequestsPerGroup: 10,
+	}
+}
+
 func TestNewAccountProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ap, err := process.NewAccountProcessor(nil, &mock.PubKeyConverterMock{})
+	ap, err := process.NewAccountProcessor(nil, &mock.PubKeyConverterMock{}, createTestAccountsBulkLimits())
 
 	assert.Nil(t, ap)
 	assert.Equal(t, process.ErrNilCoreProcessor, err)
@@ -29,16 +38,38 @@ func TestNewAccountProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 func TestNewAccountProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, nil)
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, nil, createTestAccountsBulkLimits())
 
 	assert.Nil(t, ap)
 	assert.Equal(t, process.ErrNilPubKeyConverter, err)
 }
 
+func TestNewAccountProcessor_InvalidAccountsBulkLimitsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	limits := createTestAccountsBulkLimits()
+	limits.MaxAccounts = 0
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, limits)
+	assert.Nil(t, ap)
+	assert.Equal(t, process.ErrInvalidAccountsBulkLimits, err)
+
+	limits = createTestAccountsBulkLimits()
+	limits.MaxConcurrentGroups = 0
+	ap, err = process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, limits)
+	assert.Nil(t, ap)
+	assert.Equal(t, process.ErrInvalidAccountsBulkLimits, err)
+
+	limits = createTestAccountsBulkLimits()
+	limits.MaxConcurrentTokensRequestsPerGroup = 0
+	ap, err = process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, limits)
+	assert.Nil(t, ap)
+	assert.Equal(t, process.ErrInvalidAccountsBulkLimits, err)
+}
+
 func TestNewAccountProcessor_WithCoreProcessorShouldWork(t *testing.T) {
 	t.Parallel()
 
-	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{})
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, createTestAccountsBulkLimits())
 
 	assert.NotNil(t, ap)
 	assert.Nil(t, err)
@@ -49,7 +80,7 @@ func TestNewAccountProcessor_WithCoreProcessorShouldWork(t *testing.T) {
 func TestAccountProcessor_GetAccountInvalidHexAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{})
+	ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, createTestAccountsBulkLimits())
 	accnt, err := ap.GetAccount("invalid hex number", common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
@@ -68,6 +99,7 @@ func TestAccountProcessor_GetAccountComputeShardIdFailsShouldErr(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		createTestAccountsBulkLimits(),
 	)
 	address := "DEADBEEF"
 	accnt, err := ap.GetAccount(address, common.AccountQueryOptions{})
@@ -90,6 +122,7 @@ func TestAccountProcessor_GetAccountGetObserversFailsShouldErr(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		createTestAccountsBulkLimits(),
 	)
 	address := "DEADBEEF"
 	accnt, err := ap.GetAccount(address, common.AccountQueryOptions{})
@@ -118,6 +151,7 @@ func TestAccountProcessor_GetAccountSendingFailsOnAllObserversShouldErr(t *testi
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		createTestAccountsBulkLimits(),
 	)
 	address := "DEADBEEF"
 	accnt, err := ap.GetAccount(address, common.AccountQueryOptions{})
@@ -158,6 +192,7 @@ func TestAccountProcessor_GetAccountSendingFailsOnFirstObserverShouldStillSend(t
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		createTestAccountsBulkLimits(),
 	)
 	address := "DEADBEEF"
 	accountModel, err := ap.GetAccount(address, common.AccountQueryOptions{})
@@ -187,6 +222,7 @@ func TestAccountProcessor_GetValueForAKeyShouldWork(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		createTestAccountsBulkLimits(),
 	)
 
 	key := "key"
@@ -215,6 +251,7 @@ func TestAccountProcessor_GetValueForAKeyShouldError(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		createTestAccountsBulkLimits(),
 	)
 
 	key := "key"
@@ -248,6 +285,7 @@ func TestAccountProcessor_GetShardIForAddressShouldWork(t *testing.T) {
 			},
 		},
 		bech32C,
+		createTestAccountsBulkLimits(),
 	)
 
 	shardID, err := ap.GetShardIDForAddress(addressShard1)
@@ -270,6 +308,7 @@ func TestAccountProcessor_GetShardIDForAddressShouldError(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		createTestAccountsBulkLimits(),
 	)
 
 	shardID, err := ap.GetShardIDForAddress("aaaa")
@@ -288,6 +327,7 @@ func TestAccountProcessor_GetESDTsWithRoleGetObserversFails(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		createTestAccountsBulkLimits(),
 	)
 
 	result, err := ap.GetESDTsWithRole("address", "role", common.AccountQueryOptions{})
@@ -315,6 +355,7 @@ func TestAccountProcessor_GetESDTsWithRoleApiCallFails(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		createTestAccountsBulkLimits(),
 	)
 
 	result, err := ap.GetESDTsWithRole("address", "role", common.AccountQueryOptions{})
@@ -343,6 +384,7 @@ func TestAccountProcessor_GetESDTsWithRoleShouldWork(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		createTestAccountsBulkLimits(),
 	)
 	address := "DEADBEEF"
 	response, err := ap.GetESDTsWithRole(address, "role", common.AccountQueryOptions{})
@@ -361,6 +403,7 @@ func TestAccountProcessor_GetESDTsRolesGetObserversFails(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		createTestAccountsBulkLimits(),
 	)
 
 	result, err := ap.GetESDTsRoles("address", common.AccountQueryOptions{})
@@ -388,6 +431,7 @@ func TestAccountProcessor_GetESDTsRolesApiCallFails(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		createTestAccountsBulkLimits(),
 	)
 
 	result, err := ap.GetESDTsRoles("address", common.AccountQueryOptions{})
@@ -416,6 +460,7 @@ func TestAccountProcessor_GetESDTsRolesShouldWork(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		createTestAccountsBulkLimits(),
 	)
 	address := "DEADBEEF"
 	response, err := ap.GetESDTsRoles(address, common.AccountQueryOptions{})
@@ -443,6 +488,7 @@ func TestAccountProcessor_GetCodeHash(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		createTestAccountsBulkLimits(),
 	)
 	address := "DEADBEEF"
 	response, err := ap.GetCodeHash(address, common.AccountQueryOptions{})
@@ -461,6 +507,7 @@ func TestAccountProcessor_IsDataTrieMigrated(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			createTestAccountsBulkLimits(),
 		)
 
 		result, err := ap.IsDataTrieMigrated("address", common.AccountQueryOptions{})
@@ -488,6 +535,7 @@ func TestAccountProcessor_IsDataTrieMigrated(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			createTestAccountsBulkLimits(),
 		)
 
 		result, err := ap.IsDataTrieMigrated("DEADBEEF", common.AccountQueryOptions{})
@@ -517,6 +565,7 @@ func TestAccountProcessor_IsDataTrieMigrated(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			createTestAccountsBulkLimits(),
 		)
 
 		result, err := ap.IsDataTrieMigrated("DEADBEEF", common.AccountQueryOptions{})
@@ -563,6 +612,7 @@ func TestAccountProcessor_GetAccounts(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			createTestAccountsBulkLimits(),
 		)
 
 		result, err := ap.GetAccounts([]string{"aabb", "bbaa"}, common.AccountQueryOptions{})
@@ -608,6 +658,7 @@ func TestAccountProcessor_GetAccounts(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			createTestAccountsBulkLimits(),
 		)
 
 		result, err := ap.GetAccounts([]string{"aabb", "bbaa"}, common.AccountQueryOptions{})
@@ -0,0 +1,217 @@
+package process
+
+import (
+	"container/heap"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// StreamKeyValuePairs reads the key-value pairs of the given address from an observer and hands the ones matching the
+// filter to the handler while they are decoded, so that the data trie is never held in memory. The pairs are handed
+// in the order the observer returns them
+func (ap *AccountProcessor) StreamKeyValuePairs(
+	address string,
+	options common.AccountQueryOptions,
+	filter common.KeyValuePairsQueryOptions,
+	handler func(pair *data.KeyValuePair) error,
+) error {
+	_, err := ap.streamKeyValuePairs(address, options, filter, handler)
+	return err
+}
+
+// GetKeyValuePairsPage returns the first filter.Size key-value pairs of the given address that match the filter, sorted
+// by key. Only the pairs of the page are kept in memory while the data trie is read from the observer
+func (ap *AccountProcessor) GetKeyValuePairsPage(
+	address string,
+	options common.AccountQueryOptions,
+	filter common.KeyValuePairsQueryOptions,
+) (*data.KeyValuePairsPage, error) {
+	// one more pair than requested is kept in order to know if there is a next page
+	maxPairs := filter.Size + 1
+	pairs := make(keyValuePairsMaxHeap, 0, maxPairs)
+	blockInfo, err := ap.streamKeyValuePairs(address, options, filter, func(pair *data.KeyValuePair) error {
+		if pairs.Len() < maxPairs {
+			heap.Push(&pairs, pair)
+			return nil
+		}
+		if pair.Key < pairs[0].Key {
+			pairs[0] = pair
+			heap.Fix(&pairs, 0)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sortedPairs := []*data.KeyValuePair(pairs)
+	sort.Slice(sortedPairs, func(i, j int) bool {
+		return sortedPairs[i].Key < sortedPairs[j].Key
+	})
+
+	page := &data.KeyValuePairsPage{
+		Pairs:     sortedPairs,
+		BlockInfo: blockInfo,
+	}
+	if len(sortedPairs) > filter.Size {
+		page.Pairs = sortedPairs[:filter.Size]
+		page.NextCursor = page.Pairs[filter.Size-1].Key
+	}
+
+	return page, nil
+}
+
+func (ap *AccountProcessor) streamKeyValuePairs(
+	address string,
+	options common.AccountQueryOptions,
+	filter common.KeyValuePairsQueryOptions,
+	handler func(pair *data.KeyValuePair) error,
+) (data.BlockInfo, error) {
+	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
+	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+	if err != nil {
+		return data.BlockInfo{}, err
+	}
+
+	apiPath := common.BuildUrlWithAccountQueryOptions(addressPath+address+"/keys", options)
+	for _, observer := range observers {
+		var blockInfo data.BlockInfo
+		respCode, err := ap.proc.CallGetRestEndPointStream(observer.Address, apiPath, func(body io.Reader) error {
+			var errDecode error
+			blockInfo, errDecode = decodeKeyValuePairsStream(body, func(key string, value string) error {
+				if !filter.Matches(key) {
+					return nil
+				}
+
+				return handler(&data.KeyValuePair{Key: key, Value: value})
+			})
+
+			return errDecode
+		})
+		if err == nil {
+			log.Info("account stream key-value pairs", "address", address, "shard ID", observer.ShardId, "observer", observer.Address)
+			return blockInfo, nil
+		}
+		// once the observer responded, the pairs might have already been handed over, so another observer is not tried
+		if respCode == http.StatusOK || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
+			return data.BlockInfo{}, err
+		}
+
+		log.Error("account stream key-value pairs error", "observer", observer.Address, "address", address, "error", err.Error())
+	}
+
+	return data.BlockInfo{}, WrapObserversError("")
+}
+
+// decodeKeyValuePairsStream walks the observer's key-value pairs response token by token, calling onPair for each pair
+// of the data.pairs object, and returns the block info of the response
+func decodeKeyValuePairsStream(body io.Reader, onPair func(key string, value string) error) (data.BlockInfo, error) {
+	blockInfo := data.BlockInfo{}
+	decoder := json.NewDecoder(body)
+	err := readObject(decoder, func(field string) error {
+		if field != "data" {
+			return skipValue(decoder)
+		}
+
+		return readObject(decoder, func(dataField string) error {
+			switch dataField {
+			case "blockInfo":
+				return decoder.Decode(&blockInfo)
+			case "pairs":
+				return readObject(decoder, func(key string) error {
+					var value string
+					errValue := decoder.Decode(&value)
+					if errValue != nil {
+						return errValue
+					}
+
+					return onPair(key, value)
+				})
+			default:
+				return skipValue(decoder)
+			}
+		})
+	})
+	if err != nil {
+		return data.BlockInfo{}, err
+	}
+
+	return blockInfo, nil
+}
+
+// readObject reads a JSON object, calling onField for each of its fields. onField must consume the field's value
+func readObject(decoder *json.Decoder, onField func(field string) error) error {
+	err := expectDelimiter(decoder, '{')
+	if err != nil {
+		return err
+	}
+
+	for decoder.More() {
+		token, errToken := decoder.Token()
+		if errToken != nil {
+			return errToken
+		}
+		field, ok := token.(string)
+		if !ok {
+			return fmt.Errorf("%w: unexpected token %v", ErrInvalidKeyValuePairsResponse, token)
+		}
+
+		err = onField(field)
+		if err != nil {
+			return err
+		}
+	}
+
+	return expectDelimiter(decoder, '}')
+}
+
+func expectDelimiter(decoder *json.Decoder, delimiter json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delimiter {
+		return fmt.Errorf("%w: expected %v, got %v", ErrInvalidKeyValuePairsResponse, delimiter, token)
+	}
+
+	return nil
+}
+
+func skipValue(decoder *json.Decoder) error {
+	var value json.RawMessage
+	return decoder.Decode(&value)
+}
+
+// keyValuePairsMaxHeap keeps the pairs with the greatest key at its root, so that it can be replaced by a lower one
+type keyValuePairsMaxHeap []*data.KeyValuePair
+
+// Len -
+func (h keyValuePairsMaxHeap) Len() int { return len(h) }
+
+// Less -
+func (h keyValuePairsMaxHeap) Less(i, j int) bool { return h[i].Key > h[j].Key }
+
+// Swap -
+func (h keyValuePairsMaxHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+// Push -
+func (h *keyValuePairsMaxHeap) Push(x interface{}) {
+	*h = append(*h, x.(*data.KeyValuePair))
+}
+
+// Pop -
+func (h *keyValuePairsMaxHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+
+	return item
+}
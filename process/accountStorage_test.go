@@ -0,0 +1,125 @@
+package process_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const keyValuePairsObserverResponse = `{
+	"data": {
+		"blockInfo": {"nonce": 37, "hash": "hash", "rootHash": "rootHash"},
+		"pairs": {"aa02": "02", "bb01": "03", "aa01": "01", "aa03": "04"}
+	},
+	"error": "",
+	"code": "successful"
+}`
+
+func createAccountProcessorForStorage(observerResponses map[string]string) *process.AccountProcessor {
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardID uint32, availability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer0", ShardId: shardID}, {Address: "observer1", ShardId: shardID}}, nil
+			},
+			CallGetRestEndPointStreamCalled: func(address string, path string, handler func(body io.Reader) error) (int, error) {
+				response, found := observerResponses[address]
+				if !found {
+					return http.StatusNotFound, errors.New("observer offline")
+				}
+
+				return http.StatusOK, handler(strings.NewReader(response))
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		createTestAccountsBulkLimits(),
+	)
+
+	return ap
+}
+
+func TestAccountProcessor_StreamKeyValuePairs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should skip offline observers and filter the pairs", func(t *testing.T) {
+		t.Parallel()
+
+		ap := createAccountProcessorForStorage(map[string]string{"observer1": keyValuePairsObserverResponse})
+
+		pairs := make([]*data.KeyValuePair, 0)
+		filter := common.KeyValuePairsQueryOptions{Prefix: "aa", Cursor: "aa01"}
+		err := ap.StreamKeyValuePairs("aabb", common.AccountQueryOptions{}, filter, func(pair *data.KeyValuePair) error {
+			pairs = append(pairs, pair)
+			return nil
+		})
+		require.Nil(t, err)
+		require.Equal(t, []*data.KeyValuePair{{Key: "aa02", Value: "02"}, {Key: "aa03", Value: "04"}}, pairs)
+	})
+	t.Run("handler error should stop the stream", func(t *testing.T) {
+		t.Parallel()
+
+		ap := createAccountProcessorForStorage(map[string]string{"observer0": keyValuePairsObserverResponse})
+
+		expectedErr := errors.New("client disconnected")
+		numPairs := 0
+		err := ap.StreamKeyValuePairs("aabb", common.AccountQueryOptions{}, common.KeyValuePairsQueryOptions{}, func(pair *data.KeyValuePair) error {
+			numPairs++
+			return expectedErr
+		})
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, 1, numPairs)
+	})
+	t.Run("malformed response should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap := createAccountProcessorForStorage(map[string]string{"observer0": `{"data": {"pairs": ["aa"]}}`})
+
+		err := ap.StreamKeyValuePairs("aabb", common.AccountQueryOptions{}, common.KeyValuePairsQueryOptions{}, func(pair *data.KeyValuePair) error {
+			return nil
+		})
+		require.ErrorIs(t, err, process.ErrInvalidKeyValuePairsResponse)
+	})
+	t.Run("all observers offline should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap := createAccountProcessorForStorage(nil)
+
+		err := ap.StreamKeyValuePairs("aabb", common.AccountQueryOptions{}, common.KeyValuePairsQueryOptions{}, func(pair *data.KeyValuePair) error {
+			return nil
+		})
+		require.ErrorIs(t, err, process.ErrSendingRequest)
+	})
+}
+
+func TestAccountProcessor_GetKeyValuePairsPage(t *testing.T) {
+	t.Parallel()
+
+	ap := createAccountProcessorForStorage(map[string]string{"observer0": keyValuePairsObserverResponse})
+
+	page, err := ap.GetKeyValuePairsPage("aabb", common.AccountQueryOptions{}, common.KeyValuePairsQueryOptions{Size: 2})
+	require.Nil(t, err)
+	require.Equal(t, []*data.KeyValuePair{{Key: "aa01", Value: "01"}, {Key: "aa02", Value: "02"}}, page.Pairs)
+	require.Equal(t, "aa02", page.NextCursor)
+	require.Equal(t, data.BlockInfo{Nonce: 37, Hash: "hash", RootHash: "rootHash"}, page.BlockInfo)
+
+	page, err = ap.GetKeyValuePairsPage("aabb", common.AccountQueryOptions{}, common.KeyValuePairsQueryOptions{Cursor: page.NextCursor, Size: 2})
+	require.Nil(t, err)
+	require.Equal(t, []*data.KeyValuePair{{Key: "aa03", Value: "04"}, {Key: "bb01", Value: "03"}}, page.Pairs)
+	require.Empty(t, page.NextCursor)
+
+	page, err = ap.GetKeyValuePairsPage("aabb", common.AccountQueryOptions{}, common.KeyValuePairsQueryOptions{Prefix: "cc", Size: 2})
+	require.Nil(t, err)
+	require.NotNil(t, page.Pairs)
+	require.Empty(t, page.Pairs)
+	require.Empty(t, page.NextCursor)
+}
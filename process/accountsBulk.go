@@ -0,0 +1,318 @@
+package process
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// AccountsBulkLimits holds the limits applied to the requests for a bulk of accounts
+type AccountsBulkLimits struct {
+	MaxAccounts                         int
+	MaxTokens                           int
+	MaxConcurrentGroups                 int
+	MaxConcurrentTokensRequestsPerGroup int
+}
+
+func checkAccountsBulkLimits(limits AccountsBulkLimits) error {
+	if limits.MaxAccounts <= 0 || limits.MaxTokens < 0 {
+		return ErrInvalidAccountsBulkLimits
+	}
+	if limits.MaxConcurrentGroups <= 0 || limits.MaxConcurrentTokensRequestsPerGroup <= 0 {
+		return ErrInvalidAccountsBulkLimits
+	}
+
+	return nil
+}
+
+type accountsBulkGroup struct {
+	shardID   uint32
+	options   common.AccountQueryOptions
+	addresses []string
+}
+
+type tokenBalanceResponseData struct {
+	TokenData struct {
+		Balance string `json:"balance"`
+	} `json:"tokenData"`
+}
+
+type accountsBulkToken struct {
+	identifier string
+	collection string
+	nonce      uint64
+}
+
+// GetAccountsBulk returns the accounts of the provided addresses, each one at its requested block, together with the
+// balances of the requested tokens. The addresses of the same shard requested at the same block are fetched at once
+func (ap *AccountProcessor) GetAccountsBulk(request *data.AccountsBulkRequest, options common.AccountQueryOptions) (*data.AccountsBulkModel, error) {
+	if len(request.Accounts) > ap.bulkLimits.MaxAccounts {
+		return nil, newInvalidAccountsBulkRequestError(fmt.Sprintf("at most %d accounts can be requested at once", ap.bulkLimits.MaxAccounts))
+	}
+	if len(request.Tokens) > ap.bulkLimits.MaxTokens {
+		return nil, newInvalidAccountsBulkRequestError(fmt.Sprintf("at most %d tokens can be requested at once", ap.bulkLimits.MaxTokens))
+	}
+
+	tokens, err := parseAccountsBulkTokens(request.Tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := ap.groupAccountsBulkEntries(request.Accounts, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var wg sync.WaitGroup
+	var groupErr error
+	var mut sync.Mutex
+	throttler := make(chan struct{}, ap.bulkLimits.MaxConcurrentGroups)
+	accountsResponse := make(map[string]*data.AccountBulkModel)
+	for _, group := range groups {
+		wg.Add(1)
+		throttler <- struct{}{}
+		go func(group *accountsBulkGroup) {
+			defer func() {
+				<-throttler
+				wg.Done()
+			}()
+			accountsInGroup, errGetAccounts := ap.getAccountsBulkGroup(group, tokens)
+
+			mut.Lock()
+			defer mut.Unlock()
+
+			if errGetAccounts != nil {
+				groupErr = errGetAccounts
+				return
+			}
+
+			for address, account := range accountsInGroup {
+				accountsResponse[address] = account
+			}
+		}(group)
+	}
+
+	wg.Wait()
+
+	if groupErr != nil {
+		return nil, groupErr
+	}
+
+	return &data.AccountsBulkModel{
+		Accounts: accountsResponse,
+	}, nil
+}
+
+// parseAccountsBulkTokens splits the requested token identifiers into the collection and the nonce, as the
+// non-fungible tokens are fetched by their nonce
+func parseAccountsBulkTokens(identifiers []string) ([]*accountsBulkToken, error) {
+	tokens := make([]*accountsBulkToken, 0, len(identifiers))
+	seenTokens := make(map[string]struct{}, len(identifiers))
+	for _, identifier := range identifiers {
+		_, isDuplicated := seenTokens[identifier]
+		if isDuplicated {
+			return nil, newInvalidAccountsBulkRequestError("duplicated token " + identifier)
+		}
+		seenTokens[identifier] = struct{}{}
+
+		token := &accountsBulkToken{
+			identifier: identifier,
+			collection: extractCollection(identifier),
+		}
+		if token.collection != identifier {
+			nonceHex := identifier[len(token.collection)+len(tokenIDSeparator):]
+			nonceBytes, err := hex.DecodeString(nonceHex)
+			if err != nil || len(nonceBytes) == 0 {
+				return nil, newInvalidAccountsBulkRequestError("invalid nonce of token " + identifier)
+			}
+			token.nonce = big.NewInt(0).SetBytes(nonceBytes).Uint64()
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
+// groupAccountsBulkEntries groups the entries by shard and by the block they are requested at
+func (ap *AccountProcessor) groupAccountsBulkEntries(entries []*data.AccountsBulkEntry, options common.AccountQueryOptions) ([]*accountsBulkGroup, error) {
+	groupsByKey := make(map[string]*accountsBulkGroup)
+	groups := make([]*accountsBulkGroup, 0)
+	seenAddresses := make(map[string]struct{}, len(entries))
+	for _, entry := range entries {
+		if entry == nil {
+			return nil, newInvalidAccountsBulkRequestError("nil entry")
+		}
+		_, isDuplicated := seenAddresses[entry.Address]
+		if isDuplicated {
+			return nil, newInvalidAccountsBulkRequestError("duplicated address " + entry.Address)
+		}
+		seenAddresses[entry.Address] = struct{}{}
+
+		shardID, err := ap.GetShardIDForAddress(entry.Address)
+		if err != nil {
+			return nil, fmt.Errorf("%w while trying to compute shard ID of address %s", err, entry.Address)
+		}
+
+		entryOptions, err := applyBulkEntryCoordinates(options, entry)
+		if err != nil {
+			return nil, err
+		}
+
+		key := fmt.Sprintf("%d%s", shardID, common.BuildUrlWithAccountQueryOptions("", entryOptions))
+		group, found := groupsByKey[key]
+		if !found {
+			group = &accountsBulkGroup{
+				shardID: shardID,
+				options: entryOptions,
+			}
+			groupsByKey[key] = group
+			groups = append(groups, group)
+		}
+
+		group.addresses = append(group.addresses, entry.Address)
+	}
+
+	return groups, nil
+}
+
+// applyBulkEntryCoordinates returns the query options of an entry, its block coordinates, if any, replacing the ones
+// of the request
+func applyBulkEntryCoordinates(options common.AccountQueryOptions, entry *data.AccountsBulkEntry) (common.AccountQueryOptions, error) {
+	numCoordinates := 0
+	if entry.BlockNonce != nil {
+		numCoordinates++
+	}
+	if len(entry.BlockHash) > 0 {
+		numCoordinates++
+	}
+	if len(entry.BlockRootHash) > 0 {
+		numCoordinates++
+	}
+	if numCoordinates == 0 {
+		return options, nil
+	}
+	if numCoordinates > 1 {
+		return common.AccountQueryOptions{}, newInvalidAccountsBulkRequestError(
+			"only one of blockNonce, blockHash and blockRootHash can be provided for address " + entry.Address,
+		)
+	}
+
+	blockHash, err := hex.DecodeString(entry.BlockHash)
+	if err != nil {
+		return common.AccountQueryOptions{}, newInvalidAccountsBulkRequestError("invalid blockHash for address " + entry.Address)
+	}
+	blockRootHash, err := hex.DecodeString(entry.BlockRootHash)
+	if err != nil {
+		return common.AccountQueryOptions{}, newInvalidAccountsBulkRequestError("invalid blockRootHash for address " + entry.Address)
+	}
+
+	entryOptions := common.AccountQueryOptions{
+		BlockHash:     blockHash,
+		BlockRootHash: blockRootHash,
+		HintEpoch:     options.HintEpoch,
+		WithKeys:      options.WithKeys,
+	}
+	if entry.BlockNonce != nil {
+		entryOptions.BlockNonce = core.OptionalUint64{Value: *entry.BlockNonce, HasValue: true}
+	}
+
+	return entryOptions, nil
+}
+
+func (ap *AccountProcessor) getAccountsBulkGroup(group *accountsBulkGroup, tokens []*accountsBulkToken) (map[string]*data.AccountBulkModel, error) {
+	accounts, err := ap.getAccountsInShard(group.addresses, group.shardID, group.options)
+	if err != nil {
+		return nil, err
+	}
+
+	accountsInGroup := make(map[string]*data.AccountBulkModel, len(accounts))
+	for address, account := range accounts {
+		accountsInGroup[address] = &data.AccountBulkModel{
+			Account: account,
+		}
+	}
+	if len(tokens) == 0 {
+		return accountsInGroup, nil
+	}
+
+	err = ap.fillTokensBalances(accountsInGroup, tokens, group.options)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountsInGroup, nil
+}
+
+// fillTokensBalances fetches each requested token of each account, a token not held by the address having a zero balance
+func (ap *AccountProcessor) fillTokensBalances(accounts map[string]*data.AccountBulkModel, tokens []*accountsBulkToken, options common.AccountQueryOptions) error {
+	var wg sync.WaitGroup
+	var mut sync.Mutex
+	var tokensErr error
+	throttler := make(chan struct{}, ap.bulkLimits.MaxConcurrentTokensRequestsPerGroup)
+	for address, account := range accounts {
+		account.Tokens = make(map[string]string, len(tokens))
+		for _, token := range tokens {
+			wg.Add(1)
+			throttler <- struct{}{}
+			go func(address string, account *data.AccountBulkModel, token *accountsBulkToken) {
+				defer func() {
+					<-throttler
+					wg.Done()
+				}()
+
+				balance, err := ap.getTokenBalance(address, token, options)
+
+				mut.Lock()
+				defer mut.Unlock()
+
+				if err != nil {
+					tokensErr = err
+					return
+				}
+				account.Tokens[token.identifier] = balance
+			}(address, account, token)
+		}
+	}
+
+	wg.Wait()
+
+	return tokensErr
+}
+
+func (ap *AccountProcessor) getTokenBalance(address string, token *accountsBulkToken, options common.AccountQueryOptions) (string, error) {
+	var response *data.GenericAPIResponse
+	var err error
+	if token.nonce == 0 {
+		response, err = ap.GetESDTTokenData(address, token.identifier, options)
+	} else {
+		response, err = ap.GetESDTNftTokenData(address, token.collection, token.nonce, options)
+	}
+	if err != nil {
+		return "", fmt.Errorf("%w while trying to get the token %s of address %s", err, token.identifier, address)
+	}
+
+	tokenBalance := tokenBalanceResponseData{}
+	err = convertResponseData(response.Data, &tokenBalance)
+	if err != nil {
+		return "", err
+	}
+	if len(tokenBalance.TokenData.Balance) == 0 {
+		return "0", nil
+	}
+
+	return tokenBalance.TokenData.Balance, nil
+}
+
+func newInvalidAccountsBulkRequestError(reason string) error {
+	return &apiErrors.ErrInvalidRequest{
+		Message: apiErrors.ErrInvalidAccountsBulkRequest.Error(),
+		Reason:  reason,
+	}
+}
@@ -0,0 +1,138 @@
+package process_test
+
+import (
+	"encoding/hex"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountProcessor_GetAccountsBulk(t *testing.T) {
+	t.Parallel()
+
+	aliceShard0 := hex.EncodeToString([]byte{0, 1})
+	bobShard0 := hex.EncodeToString([]byte{0, 2})
+	carolShard1 := hex.EncodeToString([]byte{1, 3})
+	blockNonce := uint64(100)
+
+	createProcessorStub := func(mut *sync.Mutex, bulkPaths map[string][]string, tokenPaths map[string]struct{}) *mock.ProcessorStub {
+		return &mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return uint32(addressBuff[0]), nil
+			},
+			GetObserversCalled: func(shardID uint32, availability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer", ShardId: shardID}}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				addresses := value.([]string)
+				mut.Lock()
+				bulkPaths[path] = append(bulkPaths[path], addresses...)
+				mut.Unlock()
+
+				accounts := make(map[string]*data.Account)
+				for _, addr := range addresses {
+					accounts[addr] = &data.Account{Address: addr, Balance: "10"}
+				}
+				response.(*data.AccountsApiResponse).Data.Accounts = accounts
+				return http.StatusOK, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				mut.Lock()
+				tokenPaths[path] = struct{}{}
+				mut.Unlock()
+
+				balance := "0"
+				if strings.Contains(path, aliceShard0) {
+					switch {
+					case strings.Contains(path, "/esdt/TKN-123456"):
+						balance = "5"
+					case strings.Contains(path, "/nft/NFT-abcdef/nonce/1"):
+						balance = "1"
+					}
+				}
+
+				value.(*data.GenericAPIResponse).Data = map[string]interface{}{
+					"tokenData": map[string]interface{}{"balance": balance},
+				}
+				return http.StatusOK, nil
+			},
+		}
+	}
+
+	t.Run("invalid entries should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(createProcessorStub(&sync.Mutex{}, make(map[string][]string), make(map[string]struct{})), &mock.PubKeyConverterMock{}, createTestAccountsBulkLimits())
+
+		invalidRequests := []*data.AccountsBulkRequest{
+			{Accounts: []*data.AccountsBulkEntry{nil}},
+			{Accounts: []*data.AccountsBulkEntry{{Address: aliceShard0}, {Address: aliceShard0}}},
+			{Accounts: []*data.AccountsBulkEntry{{Address: aliceShard0, BlockNonce: &blockNonce, BlockHash: "aa"}}},
+			{Accounts: []*data.AccountsBulkEntry{{Address: aliceShard0, BlockHash: "not hex"}}},
+			{Accounts: make([]*data.AccountsBulkEntry, 101)},
+			{Accounts: []*data.AccountsBulkEntry{{Address: aliceShard0}}, Tokens: make([]string, 21)},
+			{Accounts: []*data.AccountsBulkEntry{{Address: aliceShard0}}, Tokens: []string{"TKN-123456", "TKN-123456"}},
+			{Accounts: []*data.AccountsBulkEntry{{Address: aliceShard0}}, Tokens: []string{"NFT-abcdef-zz"}},
+		}
+		for _, request := range invalidRequests {
+			result, err := ap.GetAccountsBulk(request, common.AccountQueryOptions{})
+			require.Nil(t, result)
+			errInvalidRequest, ok := err.(*apiErrors.ErrInvalidRequest)
+			require.True(t, ok)
+			require.Equal(t, apiErrors.ErrInvalidAccountsBulkRequest.Error(), errInvalidRequest.Message)
+		}
+	})
+	t.Run("should group by shard and block and fill the tokens balances", func(t *testing.T) {
+		t.Parallel()
+
+		mut := &sync.Mutex{}
+		bulkPaths := make(map[string][]string)
+		tokenPaths := make(map[string]struct{})
+		ap, _ := process.NewAccountProcessor(createProcessorStub(mut, bulkPaths, tokenPaths), &mock.PubKeyConverterMock{}, createTestAccountsBulkLimits())
+
+		request := &data.AccountsBulkRequest{
+			Accounts: []*data.AccountsBulkEntry{
+				{Address: aliceShard0},
+				{Address: bobShard0, BlockNonce: &blockNonce},
+				{Address: carolShard1},
+			},
+			Tokens: []string{"TKN-123456", "NFT-abcdef-01"},
+		}
+		result, err := ap.GetAccountsBulk(request, common.AccountQueryOptions{OnFinalBlock: true})
+		require.Nil(t, err)
+
+		require.Equal(t, map[string][]string{
+			"/address/bulk?onFinalBlock=true": {aliceShard0, carolShard1},
+			"/address/bulk?blockNonce=100":    {bobShard0},
+		}, sortedPaths(bulkPaths))
+
+		require.Len(t, result.Accounts, 3)
+		require.Equal(t, "10", result.Accounts[aliceShard0].Account.Balance)
+		require.Equal(t, map[string]string{"TKN-123456": "5", "NFT-abcdef-01": "1"}, result.Accounts[aliceShard0].Tokens)
+		require.Equal(t, map[string]string{"TKN-123456": "0", "NFT-abcdef-01": "0"}, result.Accounts[bobShard0].Tokens)
+		require.Equal(t, map[string]string{"TKN-123456": "0", "NFT-abcdef-01": "0"}, result.Accounts[carolShard1].Tokens)
+
+		require.Len(t, tokenPaths, 6)
+		_, found := tokenPaths["/address/"+bobShard0+"/nft/NFT-abcdef/nonce/1?blockNonce=100"]
+		require.True(t, found)
+	})
+}
+
+func sortedPaths(paths map[string][]string) map[string][]string {
+	sorted := make(map[string][]string, len(paths))
+	for path, addresses := range paths {
+		sorted[path] = append([]string{}, addresses...)
+		sort.Strings(sorted[path])
+	}
+
+	return sorted
+}
@@ -0,0 +1,227 @@
+package addressutils
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	numInitZeroBytes        = core.NumInitCharactersForScAddress - core.VMTypeLen
+	systemContractIndexByte = 29
+)
+
+// systemContractNames holds the names of the system smart contracts deployed on the metachain, indexed by the byte
+// which tells them apart
+var systemContractNames = map[byte]string{
+	0: "staking",
+	1: "validator",
+	2: "esdt",
+	3: "governance",
+	4: "delegation manager",
+}
+
+// ArgsAddressUtilsProcessor holds the arguments needed for creating a new address utils processor
+type ArgsAddressUtilsProcessor struct {
+	ShardIDComputer     ShardIDComputer
+	PubKeyConverter     core.PubkeyConverter
+	Hasher              hashing.Hasher
+	Hrp                 string
+	MaxAddressesInBatch int
+}
+
+type addressUtilsProcessor struct {
+	shardIDComputer     ShardIDComputer
+	pubKeyConverter     core.PubkeyConverter
+	hasher              hashing.Hasher
+	hrp                 string
+	maxAddressesInBatch int
+}
+
+// NewAddressUtilsProcessor creates a new instance of addressUtilsProcessor
+func NewAddressUtilsProcessor(args ArgsAddressUtilsProcessor) (*addressUtilsProcessor, error) {
+	if args.ShardIDComputer == nil {
+		return nil, ErrNilShardIDComputer
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if !check.IfHrp(args.Hrp) {
+		return nil, ErrInvalidHrp
+	}
+	if args.MaxAddressesInBatch <= 0 {
+		return nil, ErrInvalidMaxAddressesInBatch
+	}
+
+	return &addressUtilsProcessor{
+		shardIDComputer:     args.ShardIDComputer,
+		pubKeyConverter:     args.PubKeyConverter,
+		hasher:              args.Hasher,
+		hrp:                 args.Hrp,
+		maxAddressesInBatch: args.MaxAddressesInBatch,
+	}, nil
+}
+
+// ConvertAddress returns both the bech32 and the hex encodings of the provided address, which can be given in either
+// of them. The bech32 encoding uses the provided human readable part, or the configured one if empty
+func (aup *addressUtilsProcessor) ConvertAddress(address string, hrp string) (*data.AddressConversion, error) {
+	converter := aup.pubKeyConverter
+	if len(hrp) == 0 {
+		hrp = aup.hrp
+	}
+	if hrp != aup.hrp {
+		var err error
+		converter, err = pubkeyConverter.NewBech32PubkeyConverter(aup.pubKeyConverter.Len(), hrp)
+		if err != nil {
+			return nil, newInvalidAddressError("invalid hrp " + hrp)
+		}
+	}
+
+	addressBytes, err := decodeAddress(converter, address)
+	if err != nil {
+		return nil, err
+	}
+
+	bech32Address, err := converter.Encode(addressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.AddressConversion{
+		Bech32: bech32Address,
+		Hex:    hex.EncodeToString(addressBytes),
+		Hrp:    hrp,
+	}, nil
+}
+
+// ComputeShardIDs returns the shards of the provided addresses, computed by the configured shard coordinator
+func (aup *addressUtilsProcessor) ComputeShardIDs(addresses []string) (*data.AddressesShardsResponseData, error) {
+	if len(addresses) == 0 {
+		return nil, newInvalidAddressError("no address provided")
+	}
+	if len(addresses) > aup.maxAddressesInBatch {
+		return nil, newInvalidAddressError("at most " + strconv.Itoa(aup.maxAddressesInBatch) + " addresses can be provided at once")
+	}
+
+	shards := make(map[string]uint32, len(addresses))
+	for _, address := range addresses {
+		addressBytes, err := decodeAddress(aup.pubKeyConverter, address)
+		if err != nil {
+			return nil, err
+		}
+
+		shards[address], err = aup.shardIDComputer.ComputeShardId(addressBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &data.AddressesShardsResponseData{
+		Shards: shards,
+	}, nil
+}
+
+// ComputeContractAddress returns the address of the contract deployed by the provided deployer at the provided nonce.
+// The VM type is hex encoded and defaults to the WASM VM if empty
+func (aup *addressUtilsProcessor) ComputeContractAddress(deployer string, nonce uint64, vmType string) (*data.AddressDetails, error) {
+	deployerBytes, err := decodeAddress(aup.pubKeyConverter, deployer)
+	if err != nil {
+		return nil, err
+	}
+
+	vmTypeBytes := common.WasmVMType
+	if len(vmType) > 0 {
+		vmTypeBytes, err = hex.DecodeString(vmType)
+		if err != nil || len(vmTypeBytes) != core.VMTypeLen {
+			return nil, newInvalidAddressError("invalid vm type " + vmType)
+		}
+	}
+
+	return aup.computeAddressDetails(common.ComputeContractAddress(aup.hasher, deployerBytes, nonce, vmTypeBytes))
+}
+
+// GetAddressDetails returns the encodings and the shard of the provided address, together with whether it is a smart
+// contract, a system smart contract or the system account
+func (aup *addressUtilsProcessor) GetAddressDetails(address string) (*data.AddressDetails, error) {
+	addressBytes, err := decodeAddress(aup.pubKeyConverter, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return aup.computeAddressDetails(addressBytes)
+}
+
+func (aup *addressUtilsProcessor) computeAddressDetails(addressBytes []byte) (*data.AddressDetails, error) {
+	bech32Address, err := aup.pubKeyConverter.Encode(addressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	shardID, err := aup.shardIDComputer.ComputeShardId(addressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	details := &data.AddressDetails{
+		Bech32:          bech32Address,
+		Hex:             hex.EncodeToString(addressBytes),
+		ShardID:         shardID,
+		IsSmartContract: core.IsSmartContractAddress(addressBytes) && !core.IsEmptyAddress(addressBytes),
+		IsSystemAccount: core.IsSystemAccountAddress(addressBytes),
+	}
+	if !details.IsSmartContract {
+		return details, nil
+	}
+
+	details.VMType = hex.EncodeToString(addressBytes[numInitZeroBytes:core.NumInitCharactersForScAddress])
+	shardIdentifier := addressBytes[len(addressBytes)-core.ShardIdentiferLen:]
+	details.IsSystemSmartContract = core.IsSmartContractOnMetachain(shardIdentifier, addressBytes)
+	if details.IsSystemSmartContract {
+		details.SystemContractName = systemContractNames[addressBytes[systemContractIndexByte]]
+	}
+
+	return details, nil
+}
+
+// decodeAddress accepts both the hex and the bech32 encodings of an address
+func decodeAddress(converter core.PubkeyConverter, address string) ([]byte, error) {
+	address = strings.TrimSpace(address)
+	if len(address) == 0 {
+		return nil, newInvalidAddressError("empty address")
+	}
+
+	addressBytes, err := hex.DecodeString(address)
+	if err == nil && len(addressBytes) == converter.Len() {
+		return addressBytes, nil
+	}
+
+	addressBytes, err = converter.Decode(address)
+	if err != nil {
+		return nil, newInvalidAddressError(address + ": " + err.Error())
+	}
+
+	return addressBytes, nil
+}
+
+func newInvalidAddressError(reason string) error {
+	return &apiErrors.ErrInvalidRequest{
+		Message: apiErrors.ErrInvalidAddress.Error(),
+		Reason:  reason,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (aup *addressUtilsProcessor) IsInterfaceNil() bool {
+	return aup == nil
+}
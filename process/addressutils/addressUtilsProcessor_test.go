@@ -0,0 +1,265 @@
+package addressutils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAddress         = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	testAddressHex      = "0139472eff6886771a982f3083da5d421f24c29181e63888228dc81ca60d69e1"
+	testContractAddress = "erd1qqqqqqqqqqqqqpgqak8zt22wl2ph4tswtyc39namqx6ysa2sd8ss4xmlj3"
+	testStakingAddress  = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqllls0lczs7"
+	testESDTAddress     = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzllls8a5w6u"
+)
+
+type shardIDComputerStub struct{}
+
+func (stub *shardIDComputerStub) ComputeShardId(addressBuff []byte) (uint32, error) {
+	return uint32(addressBuff[len(addressBuff)-1] % 3), nil
+}
+
+func createMockArgs() ArgsAddressUtilsProcessor {
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+
+	return ArgsAddressUtilsProcessor{
+		ShardIDComputer:     &shardIDComputerStub{},
+		PubKeyConverter:     converter,
+		Hasher:              keccak.NewKeccak(),
+		Hrp:                 "erd",
+		MaxAddressesInBatch: 2,
+	}
+}
+
+func requireInvalidAddressError(t *testing.T, err error) {
+	_, isInvalidRequest := err.(*apiErrors.ErrInvalidRequest)
+	require.True(t, isInvalidRequest)
+	require.Contains(t, err.Error(), apiErrors.ErrInvalidAddress.Error())
+}
+
+func TestNewAddressUtilsProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil shard ID computer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.ShardIDComputer = nil
+		aup, err := NewAddressUtilsProcessor(args)
+		require.Nil(t, aup)
+		require.Equal(t, ErrNilShardIDComputer, err)
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.PubKeyConverter = nil
+		aup, err := NewAddressUtilsProcessor(args)
+		require.Nil(t, aup)
+		require.Equal(t, ErrNilPubKeyConverter, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Hasher = nil
+		aup, err := NewAddressUtilsProcessor(args)
+		require.Nil(t, aup)
+		require.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("invalid hrp should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Hrp = ""
+		aup, err := NewAddressUtilsProcessor(args)
+		require.Nil(t, aup)
+		require.Equal(t, ErrInvalidHrp, err)
+	})
+	t.Run("invalid max addresses in batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.MaxAddressesInBatch = 0
+		aup, err := NewAddressUtilsProcessor(args)
+		require.Nil(t, aup)
+		require.Equal(t, ErrInvalidMaxAddressesInBatch, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		aup, err := NewAddressUtilsProcessor(createMockArgs())
+		require.Nil(t, err)
+		require.False(t, aup.IsInterfaceNil())
+	})
+}
+
+func TestAddressUtilsProcessor_ConvertAddress(t *testing.T) {
+	t.Parallel()
+
+	aup, _ := NewAddressUtilsProcessor(createMockArgs())
+
+	t.Run("bech32 address should convert", func(t *testing.T) {
+		t.Parallel()
+
+		conversion, err := aup.ConvertAddress(testAddress, "")
+		require.Nil(t, err)
+		require.Equal(t, &data.AddressConversion{Bech32: testAddress, Hex: testAddressHex, Hrp: "erd"}, conversion)
+	})
+	t.Run("hex address should convert", func(t *testing.T) {
+		t.Parallel()
+
+		conversion, err := aup.ConvertAddress(testAddressHex, "erd")
+		require.Nil(t, err)
+		require.Equal(t, &data.AddressConversion{Bech32: testAddress, Hex: testAddressHex, Hrp: "erd"}, conversion)
+	})
+	t.Run("custom hrp should be used for both decoding and encoding", func(t *testing.T) {
+		t.Parallel()
+
+		conversion, err := aup.ConvertAddress(testAddressHex, "test")
+		require.Nil(t, err)
+		require.True(t, strings.HasPrefix(conversion.Bech32, "test1"))
+		require.Equal(t, testAddressHex, conversion.Hex)
+
+		reversed, err := aup.ConvertAddress(conversion.Bech32, "test")
+		require.Nil(t, err)
+		require.Equal(t, conversion, reversed)
+
+		_, err = aup.ConvertAddress(testAddress, "test")
+		requireInvalidAddressError(t, err)
+	})
+	t.Run("invalid hrp should error", func(t *testing.T) {
+		t.Parallel()
+
+		conversion, err := aup.ConvertAddress(testAddressHex, "in valid")
+		require.Nil(t, conversion)
+		requireInvalidAddressError(t, err)
+	})
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, address := range []string{"", "aabb", "erd1invalid"} {
+			conversion, err := aup.ConvertAddress(address, "")
+			require.Nil(t, conversion)
+			requireInvalidAddressError(t, err)
+		}
+	})
+}
+
+func TestAddressUtilsProcessor_ComputeShardIDs(t *testing.T) {
+	t.Parallel()
+
+	aup, _ := NewAddressUtilsProcessor(createMockArgs())
+
+	t.Run("invalid batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := aup.ComputeShardIDs(nil)
+		require.Nil(t, response)
+		requireInvalidAddressError(t, err)
+
+		response, err = aup.ComputeShardIDs([]string{testAddress, testAddressHex, testStakingAddress})
+		require.Nil(t, response)
+		requireInvalidAddressError(t, err)
+
+		response, err = aup.ComputeShardIDs([]string{testAddress, "erd1invalid"})
+		require.Nil(t, response)
+		requireInvalidAddressError(t, err)
+	})
+	t.Run("should index the shards by the requested addresses", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := aup.ComputeShardIDs([]string{testAddress, testStakingAddress})
+		require.Nil(t, err)
+		require.Equal(t, &data.AddressesShardsResponseData{
+			Shards: map[string]uint32{
+				testAddress:        0xe1 % 3,
+				testStakingAddress: 0xff % 3,
+			},
+		}, response)
+	})
+}
+
+func TestAddressUtilsProcessor_ComputeContractAddress(t *testing.T) {
+	t.Parallel()
+
+	aup, _ := NewAddressUtilsProcessor(createMockArgs())
+
+	t.Run("invalid vm type should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, vmType := range []string{"05", "zz00", "050000"} {
+			details, err := aup.ComputeContractAddress(testAddress, 0, vmType)
+			require.Nil(t, details)
+			requireInvalidAddressError(t, err)
+		}
+	})
+	t.Run("should compute the address the same way the protocol does", func(t *testing.T) {
+		t.Parallel()
+
+		details, err := aup.ComputeContractAddress(testAddress, 0, "")
+		require.Nil(t, err)
+		require.Equal(t, testContractAddress, details.Bech32)
+		require.True(t, details.IsSmartContract)
+		require.False(t, details.IsSystemSmartContract)
+		require.Equal(t, "0500", details.VMType)
+		require.Equal(t, uint32(0xe1%3), details.ShardID)
+
+		details, err = aup.ComputeContractAddress(testAddressHex, 1, "0500")
+		require.Nil(t, err)
+		require.NotEqual(t, testContractAddress, details.Bech32)
+	})
+}
+
+func TestAddressUtilsProcessor_GetAddressDetails(t *testing.T) {
+	t.Parallel()
+
+	aup, _ := NewAddressUtilsProcessor(createMockArgs())
+
+	t.Run("user address", func(t *testing.T) {
+		t.Parallel()
+
+		details, err := aup.GetAddressDetails(testAddressHex)
+		require.Nil(t, err)
+		require.Equal(t, &data.AddressDetails{
+			Bech32:  testAddress,
+			Hex:     testAddressHex,
+			ShardID: 0xe1 % 3,
+		}, details)
+	})
+	t.Run("system smart contracts", func(t *testing.T) {
+		t.Parallel()
+
+		details, err := aup.GetAddressDetails(testStakingAddress)
+		require.Nil(t, err)
+		require.True(t, details.IsSmartContract)
+		require.True(t, details.IsSystemSmartContract)
+		require.Equal(t, "0001", details.VMType)
+		require.Equal(t, "staking", details.SystemContractName)
+
+		details, err = aup.GetAddressDetails(testESDTAddress)
+		require.Nil(t, err)
+		require.Equal(t, "esdt", details.SystemContractName)
+	})
+	t.Run("system account", func(t *testing.T) {
+		t.Parallel()
+
+		details, err := aup.GetAddressDetails(strings.Repeat("ff", 32))
+		require.Nil(t, err)
+		require.True(t, details.IsSystemAccount)
+		require.False(t, details.IsSmartContract)
+	})
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		details, err := aup.GetAddressDetails("erd1invalid")
+		require.Nil(t, details)
+		requireInvalidAddressError(t, err)
+	})
+}
@@ -0,0 +1,18 @@
+package addressutils
+
+import "errors"
+
+// ErrNilShardIDComputer signals that a nil shard ID computer has been provided
+var ErrNilShardIDComputer = errors.New("nil shard ID computer")
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrInvalidHrp signals that an invalid human readable part has been provided
+var ErrInvalidHrp = errors.New("invalid human readable part")
+
+// ErrInvalidMaxAddressesInBatch signals that an invalid maximum number of addresses in a batch has been provided
+var ErrInvalidMaxAddressesInBatch = errors.New("invalid maximum number of addresses in a batch")
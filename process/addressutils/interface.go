@@ -0,0 +1,6 @@
+package addressutils
+
+// ShardIDComputer defines what a component which computes the shard of an address should do
+type ShardIDComputer interface {
+	ComputeShardId(addressBuff []byte) (uint32, error)
+}
@@ -0,0 +1,474 @@
+package addresswatch
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("process/addresswatch")
+
+// ArgsAddressWatcher holds the arguments needed for creating a new address watcher
+type ArgsAddressWatcher struct {
+	HyperblockProvider          HyperblockProvider
+	LatestNonceProvider         LatestNonceProvider
+	AccountsProvider            AccountsProvider
+	PubKeyConverter             core.PubkeyConverter
+	CheckInterval               time.Duration
+	MaxSubscriptions            int
+	MaxAddressesPerSubscription int
+	EventsBufferSize            int
+}
+
+type subscription struct {
+	id        string
+	addresses map[string]struct{}
+	tokens    map[string]struct{}
+	events    chan *data.AddressActivityEvent
+}
+
+// addressActivity holds the activity of an address in a hyperblock, before being filtered for each subscription
+type addressActivity struct {
+	account      *data.AddressActivityEvent
+	tokens       []*data.AddressActivityToken
+	transactions []string
+	// shardID is the shard of the address, as seen in its transactions
+	shardID uint32
+}
+
+type addressWatcher struct {
+	hyperblockProvider          HyperblockProvider
+	latestNonceProvider         LatestNonceProvider
+	accountsProvider            AccountsProvider
+	pubKeyConverter             core.PubkeyConverter
+	checkInterval               time.Duration
+	maxSubscriptions            int
+	maxAddressesPerSubscription int
+	eventsBufferSize            int
+
+	mutSubscriptions   sync.RWMutex
+	subscriptions      map[string]*subscription
+	lastSubscriptionID uint64
+
+	// lastNonce is only accessed by the following goroutine
+	lastNonce  uint64
+	cancelFunc func()
+}
+
+// NewAddressWatcher creates a new instance of addressWatcher
+func NewAddressWatcher(args ArgsAddressWatcher) (*addressWatcher, error) {
+	if args.HyperblockProvider == nil {
+		return nil, ErrNilHyperblockProvider
+	}
+	if args.LatestNonceProvider == nil {
+		return nil, ErrNilLatestNonceProvider
+	}
+	if args.AccountsProvider == nil {
+		return nil, ErrNilAccountsProvider
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if args.CheckInterval <= 0 {
+		return nil, ErrInvalidCheckInterval
+	}
+	if args.MaxSubscriptions <= 0 {
+		return nil, ErrInvalidMaxSubscriptions
+	}
+	if args.MaxAddressesPerSubscription <= 0 {
+		return nil, ErrInvalidMaxAddressesPerSubscription
+	}
+	if args.EventsBufferSize <= 0 {
+		return nil, ErrInvalidEventsBufferSize
+	}
+
+	return &addressWatcher{
+		hyperblockProvider:          args.HyperblockProvider,
+		latestNonceProvider:         args.LatestNonceProvider,
+		accountsProvider:            args.AccountsProvider,
+		pubKeyConverter:             args.PubKeyConverter,
+		checkInterval:               args.CheckInterval,
+		maxSubscriptions:            args.MaxSubscriptions,
+		maxAddressesPerSubscription: args.MaxAddressesPerSubscription,
+		eventsBufferSize:            args.EventsBufferSize,
+		subscriptions:               make(map[string]*subscription),
+	}, nil
+}
+
+// Subscribe registers a new subscription for the activity of the requested addresses. The events are delivered
+// starting with the first hyperblock followed after the subscription
+func (aw *addressWatcher) Subscribe(request *data.AddressWatchRequest) (*data.AddressWatchSubscription, error) {
+	sub, err := aw.createSubscription(request)
+	if err != nil {
+		return nil, err
+	}
+
+	aw.mutSubscriptions.Lock()
+	defer aw.mutSubscriptions.Unlock()
+
+	if len(aw.subscriptions) >= aw.maxSubscriptions {
+		return nil, apiErrors.ErrTooManyAddressWatchSubscriptions
+	}
+
+	aw.lastSubscriptionID++
+	sub.id = strconv.FormatUint(aw.lastSubscriptionID, 10)
+	aw.subscriptions[sub.id] = sub
+
+	return &data.AddressWatchSubscription{
+		ID:     sub.id,
+		Events: sub.events,
+	}, nil
+}
+
+func (aw *addressWatcher) createSubscription(request *data.AddressWatchRequest) (*subscription, error) {
+	if len(request.Addresses) == 0 {
+		return nil, newInvalidAddressWatchRequestError("no address provided")
+	}
+	if len(request.Addresses) > aw.maxAddressesPerSubscription {
+		return nil, newInvalidAddressWatchRequestError("at most " + strconv.Itoa(aw.maxAddressesPerSubscription) + " addresses can be watched")
+	}
+
+	addresses := make(map[string]struct{}, len(request.Addresses))
+	for _, address := range request.Addresses {
+		_, err := aw.pubKeyConverter.Decode(address)
+		if err != nil {
+			return nil, newInvalidAddressWatchRequestError("invalid address " + address)
+		}
+
+		addresses[address] = struct{}{}
+	}
+
+	tokens := make(map[string]struct{}, len(request.Tokens))
+	for _, token := range request.Tokens {
+		tokens[token] = struct{}{}
+	}
+
+	return &subscription{
+		addresses: addresses,
+		tokens:    tokens,
+		events:    make(chan *data.AddressActivityEvent, aw.eventsBufferSize),
+	}, nil
+}
+
+// Unsubscribe ends the provided subscription, closing its events channel
+func (aw *addressWatcher) Unsubscribe(subscriptionID string) {
+	aw.mutSubscriptions.Lock()
+	defer aw.mutSubscriptions.Unlock()
+
+	aw.removeSubscription(subscriptionID)
+}
+
+// removeSubscription must be called under the subscriptions mutex
+func (aw *addressWatcher) removeSubscription(subscriptionID string) {
+	sub, found := aw.subscriptions[subscriptionID]
+	if !found {
+		return
+	}
+
+	delete(aw.subscriptions, subscriptionID)
+	close(sub.events)
+}
+
+// StartFollowing will start following the new hyperblocks periodically, while there are subscriptions
+func (aw *addressWatcher) StartFollowing() {
+	if aw.cancelFunc != nil {
+		log.Error("addressWatcher - following already started")
+		return
+	}
+
+	var ctx context.Context
+	ctx, aw.cancelFunc = context.WithCancel(context.Background())
+
+	go func(ctx context.Context) {
+		timer := time.NewTimer(aw.checkInterval)
+		defer timer.Stop()
+
+		for {
+			timer.Reset(aw.checkInterval)
+
+			select {
+			case <-timer.C:
+				aw.followHyperblocks(ctx)
+			case <-ctx.Done():
+				log.Debug("finishing addressWatcher following...")
+				return
+			}
+		}
+	}(ctx)
+}
+
+// followHyperblocks processes the hyperblocks produced since the last check. Without subscriptions no hyperblock is
+// fetched, the following being resumed from the latest hyperblock once a subscription is registered
+func (aw *addressWatcher) followHyperblocks(ctx context.Context) {
+	if aw.numSubscriptions() == 0 {
+		aw.lastNonce = 0
+		return
+	}
+
+	latestNonce, err := aw.latestNonceProvider.GetLatestFullySynchronizedHyperblockNonce()
+	if err != nil {
+		log.Debug("addressWatcher: cannot get the latest hyperblock nonce", "error", err)
+		return
+	}
+	if aw.lastNonce == 0 && latestNonce > 0 {
+		aw.lastNonce = latestNonce - 1
+	}
+
+	for nonce := aw.lastNonce + 1; nonce <= latestNonce; nonce++ {
+		if ctx.Err() != nil {
+			return
+		}
+
+		err = aw.processHyperblock(nonce)
+		if err != nil {
+			// the hyperblock is fetched again on the next check, so that no activity is skipped
+			log.Debug("addressWatcher: cannot process hyperblock", "nonce", nonce, "error", err)
+			return
+		}
+
+		aw.lastNonce = nonce
+	}
+}
+
+func (aw *addressWatcher) processHyperblock(nonce uint64) error {
+	response, err := aw.hyperblockProvider.GetHyperBlockByNonce(nonce, common.HyperblockQueryOptions{WithAlteredAccounts: true})
+	if err != nil {
+		return err
+	}
+
+	activities := collectAddressesActivity(&response.Data.Hyperblock)
+	if len(activities) == 0 {
+		return nil
+	}
+
+	aw.fillNotAlteredAccounts(&response.Data.Hyperblock, activities)
+
+	aw.mutSubscriptions.Lock()
+	defer aw.mutSubscriptions.Unlock()
+
+	for _, sub := range aw.subscriptions {
+		aw.notifySubscription(sub, activities)
+	}
+
+	return nil
+}
+
+// fillNotAlteredAccounts fetches the balance and the nonce of the watched addresses which only appear in the
+// transactions of the hyperblock, as they are missing from the altered accounts (e.g. an invalid transaction or a
+// transfer of zero value). The state is fetched at the shard block of the address notarized in the hyperblock
+func (aw *addressWatcher) fillNotAlteredAccounts(hyperblock *api.Hyperblock, activities map[string]*addressActivity) {
+	watchedAddresses := aw.getWatchedAddresses()
+	shardBlockNonces := getShardBlockNonces(hyperblock)
+	for address, activity := range activities {
+		_, isWatched := watchedAddresses[address]
+		if !isWatched || activity.account.Altered {
+			continue
+		}
+
+		options := common.AccountQueryOptions{}
+		blockNonce, found := shardBlockNonces[activity.shardID]
+		if found {
+			options.BlockNonce = core.OptionalUint64{Value: blockNonce, HasValue: true}
+		}
+
+		account, err := aw.accountsProvider.GetAccount(address, options)
+		if err != nil {
+			log.Debug("addressWatcher: cannot get the account which only appears in transactions",
+				"address", address, "error", err)
+			continue
+		}
+
+		activity.account.Balance = account.Account.Balance
+		activity.account.Nonce = account.Account.Nonce
+	}
+}
+
+func (aw *addressWatcher) getWatchedAddresses() map[string]struct{} {
+	aw.mutSubscriptions.RLock()
+	defer aw.mutSubscriptions.RUnlock()
+
+	watchedAddresses := make(map[string]struct{})
+	for _, sub := range aw.subscriptions {
+		for address := range sub.addresses {
+			watchedAddresses[address] = struct{}{}
+		}
+	}
+
+	return watchedAddresses
+}
+
+// getShardBlockNonces returns the nonce of the last block of each shard notarized in the hyperblock
+func getShardBlockNonces(hyperblock *api.Hyperblock) map[uint32]uint64 {
+	shardBlockNonces := map[uint32]uint64{
+		core.MetachainShardId: hyperblock.Nonce,
+	}
+	for _, shardBlock := range hyperblock.ShardBlocks {
+		if shardBlock == nil {
+			continue
+		}
+		if shardBlock.Nonce > shardBlockNonces[shardBlock.Shard] {
+			shardBlockNonces[shardBlock.Shard] = shardBlock.Nonce
+		}
+	}
+
+	return shardBlockNonces
+}
+
+// notifySubscription must be called under the subscriptions mutex
+func (aw *addressWatcher) notifySubscription(sub *subscription, activities map[string]*addressActivity) {
+	for address, activity := range activities {
+		_, isWatched := sub.addresses[address]
+		if !isWatched {
+			continue
+		}
+
+		event := createEventForSubscription(activity, sub)
+		if event == nil {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			// a consumer which does not keep up is disconnected, as dropping its events would make it miss activity
+			log.Debug("addressWatcher: subscription events not consumed, ending it", "subscription", sub.id)
+			aw.removeSubscription(sub.id)
+			return
+		}
+	}
+}
+
+// collectAddressesActivity indexes the altered accounts and the transactions of the hyperblock by address
+func collectAddressesActivity(hyperblock *api.Hyperblock) map[string]*addressActivity {
+	activities := make(map[string]*addressActivity)
+	getActivity := func(address string) *addressActivity {
+		activity, found := activities[address]
+		if !found {
+			activity = &addressActivity{
+				account: &data.AddressActivityEvent{
+					Address:         address,
+					HyperblockNonce: hyperblock.Nonce,
+					HyperblockHash:  hyperblock.Hash,
+				},
+			}
+			activities[address] = activity
+		}
+
+		return activity
+	}
+
+	// the shard blocks are ordered, so the state of an account altered in more of them is the one after the last one
+	for _, shardBlock := range hyperblock.ShardBlocks {
+		if shardBlock == nil {
+			continue
+		}
+
+		for _, alteredAccount := range shardBlock.AlteredAccounts {
+			if alteredAccount == nil {
+				continue
+			}
+
+			activity := getActivity(alteredAccount.Address)
+			activity.account.Altered = true
+			activity.account.Balance = alteredAccount.Balance
+			activity.account.Nonce = alteredAccount.Nonce
+			activity.tokens = make([]*data.AddressActivityToken, 0, len(alteredAccount.Tokens))
+			for _, token := range alteredAccount.Tokens {
+				if token == nil {
+					continue
+				}
+
+				activity.tokens = append(activity.tokens, &data.AddressActivityToken{
+					Identifier: token.Identifier,
+					Nonce:      token.Nonce,
+					Balance:    token.Balance,
+				})
+			}
+		}
+	}
+
+	for _, tx := range hyperblock.Transactions {
+		if tx == nil {
+			continue
+		}
+
+		sender := getActivity(tx.Sender)
+		sender.transactions = append(sender.transactions, tx.Hash)
+		sender.shardID = tx.SourceShard
+		if tx.Receiver != tx.Sender {
+			receiver := getActivity(tx.Receiver)
+			receiver.transactions = append(receiver.transactions, tx.Hash)
+			receiver.shardID = tx.DestinationShard
+		}
+	}
+
+	return activities
+}
+
+// createEventForSubscription returns the event of the address activity as seen by the subscription, or nil if the
+// subscription watches tokens and none of them changed
+func createEventForSubscription(activity *addressActivity, sub *subscription) *data.AddressActivityEvent {
+	event := *activity.account
+	event.Transactions = activity.transactions
+
+	if len(sub.tokens) == 0 {
+		event.Tokens = activity.tokens
+		return &event
+	}
+
+	for _, token := range activity.tokens {
+		_, isWatched := sub.tokens[token.Identifier]
+		if isWatched {
+			event.Tokens = append(event.Tokens, token)
+		}
+	}
+	if len(event.Tokens) == 0 {
+		return nil
+	}
+
+	return &event
+}
+
+func (aw *addressWatcher) numSubscriptions() int {
+	aw.mutSubscriptions.RLock()
+	defer aw.mutSubscriptions.RUnlock()
+
+	return len(aw.subscriptions)
+}
+
+func newInvalidAddressWatchRequestError(reason string) error {
+	return &apiErrors.ErrInvalidRequest{
+		Message: apiErrors.ErrInvalidAddressWatchRequest.Error(),
+		Reason:  reason,
+	}
+}
+
+// Close will stop the following of the hyperblocks and end all the subscriptions
+func (aw *addressWatcher) Close() error {
+	if aw.cancelFunc != nil {
+		aw.cancelFunc()
+	}
+
+	aw.mutSubscriptions.Lock()
+	defer aw.mutSubscriptions.Unlock()
+
+	for id := range aw.subscriptions {
+		aw.removeSubscription(id)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (aw *addressWatcher) IsInterfaceNil() bool {
+	return aw == nil
+}
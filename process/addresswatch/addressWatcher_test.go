@@ -0,0 +1,360 @@
+package addresswatch
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAddress      = "aa01"
+	testOtherAddress = "bb02"
+)
+
+type hyperblockProviderStub struct {
+	getHyperBlockByNonceCalled func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+}
+
+func (stub *hyperblockProviderStub) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	if stub.getHyperBlockByNonceCalled != nil {
+		return stub.getHyperBlockByNonceCalled(nonce, options)
+	}
+
+	return data.NewHyperblockApiResponse(api.Hyperblock{Nonce: nonce}), nil
+}
+
+type latestNonceProviderStub struct {
+	latestNonce uint64
+	err         error
+}
+
+func (stub *latestNonceProviderStub) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
+	return stub.latestNonce, stub.err
+}
+
+func createMockArgs() ArgsAddressWatcher {
+	return ArgsAddressWatcher{
+		HyperblockProvider:          &hyperblockProviderStub{},
+		LatestNonceProvider:         &latestNonceProviderStub{},
+		AccountsProvider:            &mock.AccountsHandlerStub{},
+		PubKeyConverter:             &mock.PubKeyConverterMock{},
+		CheckInterval:               time.Second,
+		MaxSubscriptions:            2,
+		MaxAddressesPerSubscription: 2,
+		EventsBufferSize:            2,
+	}
+}
+
+func createTestHyperblock(nonce uint64) api.Hyperblock {
+	return api.Hyperblock{
+		Nonce: nonce,
+		Hash:  "hyperblockHash",
+		ShardBlocks: []*api.NotarizedBlock{
+			{
+				Shard: 1,
+				Nonce: nonce + 100,
+				AlteredAccounts: []*alteredAccount.AlteredAccount{
+					{
+						Address: testAddress,
+						Balance: "100",
+						Nonce:   3,
+						Tokens: []*alteredAccount.AccountTokenData{
+							{Identifier: "TKN-123456", Balance: "10"},
+							{Identifier: "NFT-abcdef", Nonce: 1, Balance: "1"},
+						},
+					},
+				},
+			},
+		},
+		Transactions: []*transaction.ApiTransactionResult{
+			{Hash: "txHash", Sender: testAddress, Receiver: testOtherAddress, SourceShard: 1, DestinationShard: 1},
+		},
+	}
+}
+
+func TestNewAddressWatcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil hyperblock provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.HyperblockProvider = nil
+		aw, err := NewAddressWatcher(args)
+		require.Nil(t, aw)
+		require.Equal(t, ErrNilHyperblockProvider, err)
+	})
+	t.Run("nil latest nonce provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.LatestNonceProvider = nil
+		aw, err := NewAddressWatcher(args)
+		require.Nil(t, aw)
+		require.Equal(t, ErrNilLatestNonceProvider, err)
+	})
+	t.Run("nil accounts provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.AccountsProvider = nil
+		aw, err := NewAddressWatcher(args)
+		require.Nil(t, aw)
+		require.Equal(t, ErrNilAccountsProvider, err)
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.PubKeyConverter = nil
+		aw, err := NewAddressWatcher(args)
+		require.Nil(t, aw)
+		require.Equal(t, ErrNilPubKeyConverter, err)
+	})
+	t.Run("invalid check interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.CheckInterval = 0
+		aw, err := NewAddressWatcher(args)
+		require.Nil(t, aw)
+		require.Equal(t, ErrInvalidCheckInterval, err)
+	})
+	t.Run("invalid max subscriptions should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.MaxSubscriptions = 0
+		aw, err := NewAddressWatcher(args)
+		require.Nil(t, aw)
+		require.Equal(t, ErrInvalidMaxSubscriptions, err)
+	})
+	t.Run("invalid max addresses per subscription should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.MaxAddressesPerSubscription = 0
+		aw, err := NewAddressWatcher(args)
+		require.Nil(t, aw)
+		require.Equal(t, ErrInvalidMaxAddressesPerSubscription, err)
+	})
+	t.Run("invalid events buffer size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.EventsBufferSize = 0
+		aw, err := NewAddressWatcher(args)
+		require.Nil(t, aw)
+		require.Equal(t, ErrInvalidEventsBufferSize, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		aw, err := NewAddressWatcher(createMockArgs())
+		require.Nil(t, err)
+		require.False(t, aw.IsInterfaceNil())
+	})
+}
+
+func TestAddressWatcher_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid requests should error", func(t *testing.T) {
+		t.Parallel()
+
+		aw, _ := NewAddressWatcher(createMockArgs())
+
+		requests := []*data.AddressWatchRequest{
+			{},
+			{Addresses: []string{testAddress, testOtherAddress, "cc03"}},
+			{Addresses: []string{"not hex"}},
+		}
+		for _, request := range requests {
+			subscription, err := aw.Subscribe(request)
+			require.Nil(t, subscription)
+			_, isInvalidRequest := err.(*apiErrors.ErrInvalidRequest)
+			require.True(t, isInvalidRequest)
+		}
+	})
+	t.Run("too many subscriptions should error", func(t *testing.T) {
+		t.Parallel()
+
+		aw, _ := NewAddressWatcher(createMockArgs())
+		request := &data.AddressWatchRequest{Addresses: []string{testAddress}}
+
+		first, err := aw.Subscribe(request)
+		require.Nil(t, err)
+		second, err := aw.Subscribe(request)
+		require.Nil(t, err)
+		require.NotEqual(t, first.ID, second.ID)
+
+		_, err = aw.Subscribe(request)
+		require.Equal(t, apiErrors.ErrTooManyAddressWatchSubscriptions, err)
+
+		aw.Unsubscribe(first.ID)
+		_, isOpen := <-first.Events
+		require.False(t, isOpen)
+
+		_, err = aw.Subscribe(request)
+		require.Nil(t, err)
+	})
+}
+
+func TestAddressWatcher_FollowHyperblocks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no subscription should not fetch hyperblocks", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.LatestNonceProvider = &latestNonceProviderStub{latestNonce: 10}
+		args.HyperblockProvider = &hyperblockProviderStub{
+			getHyperBlockByNonceCalled: func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}
+		aw, _ := NewAddressWatcher(args)
+
+		aw.followHyperblocks(context.Background())
+	})
+	t.Run("should notify the activity of the watched addresses", func(t *testing.T) {
+		t.Parallel()
+
+		latestNonceProvider := &latestNonceProviderStub{latestNonce: 10}
+		fetchedNonces := make([]uint64, 0)
+		args := createMockArgs()
+		args.LatestNonceProvider = latestNonceProvider
+		args.HyperblockProvider = &hyperblockProviderStub{
+			getHyperBlockByNonceCalled: func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				require.True(t, options.WithAlteredAccounts)
+				fetchedNonces = append(fetchedNonces, nonce)
+				if nonce == 12 {
+					return nil, errors.New("hyperblock not available")
+				}
+
+				return data.NewHyperblockApiResponse(createTestHyperblock(nonce)), nil
+			},
+		}
+		args.AccountsProvider = &mock.AccountsHandlerStub{
+			GetAccountCalled: func(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+				// only the address which is not among the altered accounts is fetched, at the shard block nonce
+				require.Equal(t, testOtherAddress, address)
+				require.True(t, options.BlockNonce.HasValue)
+				require.Equal(t, fetchedNonces[len(fetchedNonces)-1]+100, options.BlockNonce.Value)
+				return &data.AccountModel{Account: data.Account{Address: address, Balance: "7", Nonce: 2}}, nil
+			},
+		}
+		aw, _ := NewAddressWatcher(args)
+
+		subscription, _ := aw.Subscribe(&data.AddressWatchRequest{Addresses: []string{testAddress}})
+		otherSubscription, _ := aw.Subscribe(&data.AddressWatchRequest{Addresses: []string{testOtherAddress}})
+
+		aw.followHyperblocks(context.Background())
+		require.Equal(t, []uint64{10}, fetchedNonces)
+
+		event := <-subscription.Events
+		require.Equal(t, &data.AddressActivityEvent{
+			Address:         testAddress,
+			HyperblockNonce: 10,
+			HyperblockHash:  "hyperblockHash",
+			Altered:         true,
+			Balance:         "100",
+			Nonce:           3,
+			Tokens: []*data.AddressActivityToken{
+				{Identifier: "TKN-123456", Balance: "10"},
+				{Identifier: "NFT-abcdef", Nonce: 1, Balance: "1"},
+			},
+			Transactions: []string{"txHash"},
+		}, event)
+
+		event = <-otherSubscription.Events
+		require.Equal(t, &data.AddressActivityEvent{
+			Address:         testOtherAddress,
+			HyperblockNonce: 10,
+			HyperblockHash:  "hyperblockHash",
+			Balance:         "7",
+			Nonce:           2,
+			Transactions:    []string{"txHash"},
+		}, event)
+
+		// the failed hyperblock is fetched again on the next check
+		latestNonceProvider.latestNonce = 12
+		aw.followHyperblocks(context.Background())
+		aw.followHyperblocks(context.Background())
+		require.Equal(t, []uint64{10, 11, 12, 12}, fetchedNonces)
+	})
+	t.Run("should only notify the watched tokens", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.LatestNonceProvider = &latestNonceProviderStub{latestNonce: 10}
+		args.HyperblockProvider = &hyperblockProviderStub{
+			getHyperBlockByNonceCalled: func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				return data.NewHyperblockApiResponse(createTestHyperblock(nonce)), nil
+			},
+		}
+		aw, _ := NewAddressWatcher(args)
+
+		subscription, _ := aw.Subscribe(&data.AddressWatchRequest{Addresses: []string{testAddress}, Tokens: []string{"TKN-123456"}})
+		otherSubscription, _ := aw.Subscribe(&data.AddressWatchRequest{Addresses: []string{testAddress}, Tokens: []string{"OTHER-123456"}})
+
+		aw.followHyperblocks(context.Background())
+
+		event := <-subscription.Events
+		require.Equal(t, []*data.AddressActivityToken{{Identifier: "TKN-123456", Balance: "10"}}, event.Tokens)
+		require.Len(t, otherSubscription.Events, 0)
+	})
+	t.Run("subscription not consumed should be ended", func(t *testing.T) {
+		t.Parallel()
+
+		latestNonceProvider := &latestNonceProviderStub{latestNonce: 10}
+		args := createMockArgs()
+		args.LatestNonceProvider = latestNonceProvider
+		args.HyperblockProvider = &hyperblockProviderStub{
+			getHyperBlockByNonceCalled: func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				return data.NewHyperblockApiResponse(createTestHyperblock(nonce)), nil
+			},
+		}
+		aw, _ := NewAddressWatcher(args)
+
+		subscription, _ := aw.Subscribe(&data.AddressWatchRequest{Addresses: []string{testAddress}})
+		aw.followHyperblocks(context.Background())
+
+		latestNonceProvider.latestNonce = 12
+		aw.followHyperblocks(context.Background())
+
+		numEvents := 0
+		for range subscription.Events {
+			numEvents++
+		}
+		require.Equal(t, args.EventsBufferSize, numEvents)
+		require.Equal(t, 0, aw.numSubscriptions())
+	})
+}
+
+func TestAddressWatcher_Close(t *testing.T) {
+	t.Parallel()
+
+	aw, _ := NewAddressWatcher(createMockArgs())
+	aw.StartFollowing()
+
+	subscription, _ := aw.Subscribe(&data.AddressWatchRequest{Addresses: []string{testAddress}})
+
+	err := aw.Close()
+	require.Nil(t, err)
+
+	_, isOpen := <-subscription.Events
+	require.False(t, isOpen)
+	require.Equal(t, 0, aw.numSubscriptions())
+}
@@ -0,0 +1,27 @@
+package addresswatch
+
+import "errors"
+
+// ErrNilHyperblockProvider signals that a nil hyperblock provider has been provided
+var ErrNilHyperblockProvider = errors.New("nil hyperblock provider")
+
+// ErrNilLatestNonceProvider signals that a nil latest nonce provider has been provided
+var ErrNilLatestNonceProvider = errors.New("nil latest nonce provider")
+
+// ErrNilAccountsProvider signals that a nil accounts provider has been provided
+var ErrNilAccountsProvider = errors.New("nil accounts provider")
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrInvalidCheckInterval signals that an invalid check interval has been provided
+var ErrInvalidCheckInterval = errors.New("invalid check interval")
+
+// ErrInvalidMaxSubscriptions signals that an invalid maximum number of subscriptions has been provided
+var ErrInvalidMaxSubscriptions = errors.New("invalid maximum number of subscriptions")
+
+// ErrInvalidMaxAddressesPerSubscription signals that an invalid maximum number of addresses per subscription has been provided
+var ErrInvalidMaxAddressesPerSubscription = errors.New("invalid maximum number of addresses per subscription")
+
+// ErrInvalidEventsBufferSize signals that an invalid events buffer size has been provided
+var ErrInvalidEventsBufferSize = errors.New("invalid events buffer size")
@@ -0,0 +1,21 @@
+package addresswatch
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// HyperblockProvider defines the hyperblocks related actions needed by the address watcher
+type HyperblockProvider interface {
+	GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+}
+
+// AccountsProvider defines the component able to fetch the state of an account
+type AccountsProvider interface {
+	GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+}
+
+// LatestNonceProvider defines the component able to return the nonce of the latest hyperblock that can be fetched
+type LatestNonceProvider interface {
+	GetLatestFullySynchronizedHyperblockNonce() (uint64, error)
+}
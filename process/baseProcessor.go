@@ -44,7 +44,8 @@ type BaseProcessor struct {
 	cancelFunc                     func()
 	noStatusCheck                  bool
 
-	httpClient *http.Client
+	httpClient          *http.Client
+	streamingHttpClient *http.Client
 }
 
 // NewBaseProcessor creates a new instance of BaseProcessor struct
@@ -77,11 +78,21 @@ func NewBaseProcessor(
 	httpClient.Timeout = time.Duration(requestTimeoutSec) * time.Second
 	mutHttpClient.Unlock()
 
+	// the streamed responses can take longer than the request timeout to be read, so only the wait for the
+	// response headers is bounded
+	streamingHttpClient := &http.Client{
+		Transport: &http.Transport{
+			Proxy:                 http.ProxyFromEnvironment,
+			ResponseHeaderTimeout: time.Duration(requestTimeoutSec) * time.Second,
+		},
+	}
+
 	bp := &BaseProcessor{
 		shardCoordinator:               shardCoord,
 		observersProvider:              observersProvider,
 		fullHistoryNodesProvider:       fullHistoryNodesProvider,
 		httpClient:                     httpClient,
+		streamingHttpClient:            streamingHttpClient,
 		pubKeyConverter:                pubKeyConverter,
 		shardIDs:                       computeShardIDs(shardCoord),
 		delayForCheckingNodesSyncState: stepDelayForCheckingNodesSyncState,
@@ -243,6 +254,55 @@ func (bp *BaseProcessor) CallGetRestEndPoint(
 	return responseStatusCode, errors.New(string(responseBodyBytes))
 }
 
+// CallGetRestEndPointStream calls an external end point and hands the response body to the provided handler while it
+// is being received, without holding it in memory
+func (bp *BaseProcessor) CallGetRestEndPointStream(
+	address string,
+	path string,
+	handler func(body io.Reader) error,
+) (int, error) {
+
+	req, err := http.NewRequest("GET", address+path, nil)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	userAgent := "Multiversx Proxy / 1.0.0 <Streaming data from nodes>"
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := bp.streamingHttpClient.Do(req)
+	if err != nil {
+		bp.triggerNodesSyncCheck(address)
+		if isTimeoutError(err) {
+			return http.StatusRequestTimeout, err
+		}
+
+		return http.StatusNotFound, err
+	}
+
+	defer func() {
+		errNotCritical := resp.Body.Close()
+		if errNotCritical != nil {
+			log.Warn("base process GET stream: close body", "error", errNotCritical.Error())
+		}
+	}()
+
+	responseStatusCode := resp.StatusCode
+	if responseStatusCode == http.StatusOK {
+		return responseStatusCode, handler(resp.Body)
+	}
+
+	// status response not ok, return the error
+	genericApiResponse := proxyData.GenericAPIResponse{}
+	err = json.NewDecoder(resp.Body).Decode(&genericApiResponse)
+	if err != nil {
+		return responseStatusCode, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return responseStatusCode, errors.New(genericApiResponse.Error)
+}
+
 // CallPostRestEndPoint calls an external end point (sends a request on a node)
 func (bp *BaseProcessor) CallPostRestEndPoint(
 	address string,
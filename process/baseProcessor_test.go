@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"sync/atomic"
@@ -247,6 +248,49 @@ func TestBaseProcessor_CallGetRestEndPointShouldTimeout(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestBaseProcessor_CallGetRestEndPointStream(t *testing.T) {
+	t.Parallel()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/some/path" {
+			rw.WriteHeader(http.StatusBadRequest)
+			_, _ = rw.Write([]byte(`{"error":"bad path"}`))
+			return
+		}
+
+		_, _ = rw.Write([]byte("streamed body"))
+	}))
+	defer testServer.Close()
+
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		false,
+	)
+
+	var received []byte
+	statusCode, err := bp.CallGetRestEndPointStream(testServer.URL, "/some/path", func(body io.Reader) error {
+		var errRead error
+		received, errRead = io.ReadAll(body)
+		return errRead
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, "streamed body", string(received))
+
+	handlerCalled := false
+	statusCode, err = bp.CallGetRestEndPointStream(testServer.URL, "/other/path", func(body io.Reader) error {
+		handlerCalled = true
+		return nil
+	})
+	assert.Equal(t, "bad path", err.Error())
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	assert.False(t, handlerCalled)
+}
+
 func TestBaseProcessor_CallPostRestEndPoint(t *testing.T) {
 	ts := &testStruct{
 		Nonce: 10000,
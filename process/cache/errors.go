@@ -19,3 +19,6 @@ var ErrNilGenericApiResponseInCache = errors.New("nil generic api response in ca
 
 // ErrNilGenericApiResponseToStoreInCache signals that the provided generic api response is nil
 var ErrNilGenericApiResponseToStoreInCache = errors.New("nil generic api response to store in cache")
+
+// ErrInvalidTimeToLive signals that an invalid time to live has been provided
+var ErrInvalidTimeToLive = errors.New("invalid time to live")
@@ -40,3 +40,10 @@ func (garmc *genericApiResponseMemoryCacher) SetGenericApiResponse(response *dat
 	garmc.storedResponse = response
 	garmc.mutGenericApiResponse.Unlock()
 }
+
+func (tmc *timedMemoryCacher) NumItems() int {
+	tmc.mutItems.Lock()
+	defer tmc.mutItems.Unlock()
+
+	return len(tmc.items)
+}
@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+type timedItem struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+// timedMemoryCacher will hold values for a limited period of time
+type timedMemoryCacher struct {
+	timeToLive time.Duration
+
+	mutItems  sync.Mutex
+	items     map[string]*timedItem
+	lastSweep time.Time
+}
+
+// NewTimedMemoryCacher will return a new instance of timedMemoryCacher
+func NewTimedMemoryCacher(timeToLive time.Duration) (*timedMemoryCacher, error) {
+	if timeToLive <= 0 {
+		return nil, ErrInvalidTimeToLive
+	}
+
+	return &timedMemoryCacher{
+		timeToLive: timeToLive,
+		items:      make(map[string]*timedItem),
+		lastSweep:  time.Now(),
+	}, nil
+}
+
+// Get will return the value stored under the provided key, if it did not expire
+func (tmc *timedMemoryCacher) Get(key string) (interface{}, bool) {
+	tmc.mutItems.Lock()
+	defer tmc.mutItems.Unlock()
+
+	item, found := tmc.items[key]
+	if !found || time.Now().After(item.expiresAt) {
+		return nil, false
+	}
+
+	return item.value, true
+}
+
+// Put will store the value under the provided key, for the configured time to live
+func (tmc *timedMemoryCacher) Put(key string, value interface{}) {
+	tmc.mutItems.Lock()
+	defer tmc.mutItems.Unlock()
+
+	tmc.putUnprotected(key, value)
+}
+
+// PutIfAbsent will store the value under the provided key only if there is no unexpired value stored under it. The
+// check and the store are done atomically. The value already stored is returned, if found
+func (tmc *timedMemoryCacher) PutIfAbsent(key string, value interface{}) (interface{}, bool) {
+	tmc.mutItems.Lock()
+	defer tmc.mutItems.Unlock()
+
+	item, found := tmc.items[key]
+	if found && !time.Now().After(item.expiresAt) {
+		return item.value, true
+	}
+
+	tmc.putUnprotected(key, value)
+
+	return nil, false
+}
+
+// Remove will remove the value stored under the provided key
+func (tmc *timedMemoryCacher) Remove(key string) {
+	tmc.mutItems.Lock()
+	delete(tmc.items, key)
+	tmc.mutItems.Unlock()
+}
+
+func (tmc *timedMemoryCacher) putUnprotected(key string, value interface{}) {
+	now := time.Now()
+	tmc.items[key] = &timedItem{
+		value:     value,
+		expiresAt: now.Add(tmc.timeToLive),
+	}
+
+	// the expired items are removed at most once per time to live
+	if now.Sub(tmc.lastSweep) < tmc.timeToLive {
+		return
+	}
+
+	for itemKey, item := range tmc.items {
+		if now.After(item.expiresAt) {
+			delete(tmc.items, itemKey)
+		}
+	}
+	tmc.lastSweep = now
+}
+
+// IsInterfaceNil will return true if there is no value under the interface
+func (tmc *timedMemoryCacher) IsInterfaceNil() bool {
+	return tmc == nil
+}
@@ -0,0 +1,96 @@
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTimedMemoryCacher(t *testing.T) {
+	t.Parallel()
+
+	tmc, err := cache.NewTimedMemoryCacher(0)
+	assert.Nil(t, tmc)
+	assert.Equal(t, cache.ErrInvalidTimeToLive, err)
+
+	tmc, err = cache.NewTimedMemoryCacher(time.Second)
+	assert.Nil(t, err)
+	assert.False(t, tmc.IsInterfaceNil())
+}
+
+func TestTimedMemoryCacher_PutGet(t *testing.T) {
+	t.Parallel()
+
+	tmc, _ := cache.NewTimedMemoryCacher(time.Minute)
+
+	value, found := tmc.Get("key")
+	assert.False(t, found)
+	assert.Nil(t, value)
+
+	tmc.Put("key", "value")
+	value, found = tmc.Get("key")
+	assert.True(t, found)
+	assert.Equal(t, "value", value)
+}
+
+func TestTimedMemoryCacher_ExpiredItemsShouldBeRemoved(t *testing.T) {
+	t.Parallel()
+
+	timeToLive := 50 * time.Millisecond
+	tmc, _ := cache.NewTimedMemoryCacher(timeToLive)
+
+	tmc.Put("key1", 1)
+	tmc.Put("key2", 2)
+	require.Equal(t, 2, tmc.NumItems())
+
+	time.Sleep(timeToLive + 10*time.Millisecond)
+	_, found := tmc.Get("key1")
+	assert.False(t, found)
+
+	tmc.Put("key3", 3)
+	assert.Equal(t, 1, tmc.NumItems())
+	value, found := tmc.Get("key3")
+	assert.True(t, found)
+	assert.Equal(t, 3, value)
+}
+
+func TestTimedMemoryCacher_PutIfAbsent(t *testing.T) {
+	t.Parallel()
+
+	timeToLive := 50 * time.Millisecond
+	tmc, _ := cache.NewTimedMemoryCacher(timeToLive)
+
+	value, found := tmc.PutIfAbsent("key", "value1")
+	assert.False(t, found)
+	assert.Nil(t, value)
+
+	value, found = tmc.PutIfAbsent("key", "value2")
+	assert.True(t, found)
+	assert.Equal(t, "value1", value)
+
+	time.Sleep(timeToLive + 10*time.Millisecond)
+	value, found = tmc.PutIfAbsent("key", "value3")
+	assert.False(t, found)
+	assert.Nil(t, value)
+
+	value, _ = tmc.Get("key")
+	assert.Equal(t, "value3", value)
+}
+
+func TestTimedMemoryCacher_Remove(t *testing.T) {
+	t.Parallel()
+
+	tmc, _ := cache.NewTimedMemoryCacher(time.Minute)
+
+	tmc.Put("key", "value")
+	tmc.Remove("key")
+	_, found := tmc.Get("key")
+	assert.False(t, found)
+
+	value, found := tmc.PutIfAbsent("key", "value")
+	assert.False(t, found)
+	assert.Nil(t, value)
+}
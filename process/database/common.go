@@ -34,20 +34,26 @@ func convertObjectToTransactions(obj object) ([]data.DatabaseTransaction, error)
 		return nil, errCannotGetTxsFromBody
 	}
 
+	hitsList, ok := hits["hits"].([]interface{})
+	if !ok {
+		return nil, errCannotGetTxsFromBody
+	}
+
 	txs := make([]data.DatabaseTransaction, 0)
-	for _, h1 := range hits["hits"].([]interface{}) {
-		h2 := h1.(object)["_source"]
+	for _, h1 := range hitsList {
+		hit, isObject := h1.(object)
+		if !isObject {
+			return nil, errCannotGetTxsFromBody
+		}
 
 		var tx data.DatabaseTransaction
-		marshalizedTx, _ := json.Marshal(h2)
+		marshalizedTx, _ := json.Marshal(hit["_source"])
 		err := json.Unmarshal(marshalizedTx, &tx)
 		if err != nil {
 			continue
 		}
 
-		h3 := h1.(object)["_id"]
-		txHash := fmt.Sprint(h3)
-		tx.Hash = txHash
+		tx.Hash = fmt.Sprint(hit["_id"])
 		tx.Fee = tx.CalculateFee()
 		txs = append(txs, tx)
 	}
@@ -0,0 +1,101 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	transactionsIndex = "transactions"
+	searchEndpoint    = "_search"
+)
+
+type elasticSearchConnector struct {
+	url        string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+// NewElasticSearchConnector creates a new connector able to query the indices of an Elasticsearch cluster
+func NewElasticSearchConnector(url, username, password string, requestTimeout time.Duration) (*elasticSearchConnector, error) {
+	if len(url) == 0 {
+		return nil, ErrEmptyElasticSearchURL
+	}
+	if requestTimeout <= 0 {
+		return nil, ErrInvalidRequestTimeout
+	}
+
+	return &elasticSearchConnector{
+		url:      strings.TrimSuffix(url, "/"),
+		username: username,
+		password: password,
+		httpClient: &http.Client{
+			Timeout: requestTimeout,
+		},
+	}, nil
+}
+
+// GetTransactionsByAddress returns the transactions sent or received by the provided address, with the fee computed
+func (esc *elasticSearchConnector) GetTransactionsByAddress(
+	address string,
+	options common.TransactionsHistoryOptions,
+) ([]data.DatabaseTransaction, error) {
+	decodedBody, err := esc.doSearchRequest(transactionsIndex, txsByAddressQuery(address, options))
+	if err != nil {
+		return nil, err
+	}
+
+	return convertObjectToTransactions(decodedBody)
+}
+
+func (esc *elasticSearchConnector) doSearchRequest(index string, query object) (object, error) {
+	buff, err := encodeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	searchURL := fmt.Sprintf("%s/%s/%s", esc.url, index, searchEndpoint)
+	req, err := http.NewRequest(http.MethodPost, searchURL, &buff)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if len(esc.username) > 0 {
+		req.SetBasicAuth(esc.username, esc.password)
+	}
+
+	resp, err := esc.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		responseBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("%w, status code: %d, response: %s", errSearchRequestFailed, resp.StatusCode, string(responseBody))
+	}
+
+	decodedBody := make(object)
+	err = json.NewDecoder(resp.Body).Decode(&decodedBody)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errCannotDecodeResponse, err)
+	}
+
+	return decodedBody, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (esc *elasticSearchConnector) IsInterfaceNil() bool {
+	return esc == nil
+}
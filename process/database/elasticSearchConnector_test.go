@@ -0,0 +1,165 @@
+package database
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/stretchr/testify/require"
+)
+
+const testAddress = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+
+func createHistoryOptions() common.TransactionsHistoryOptions {
+	return common.TransactionsHistoryOptions{
+		From:  0,
+		Size:  10,
+		Order: common.OrderDescending,
+	}
+}
+
+func TestNewElasticSearchConnector(t *testing.T) {
+	t.Parallel()
+
+	esc, err := NewElasticSearchConnector("", "", "", time.Second)
+	require.Nil(t, esc)
+	require.Equal(t, ErrEmptyElasticSearchURL, err)
+
+	esc, err = NewElasticSearchConnector("http://localhost:9200", "", "", 0)
+	require.Nil(t, esc)
+	require.Equal(t, ErrInvalidRequestTimeout, err)
+
+	esc, err = NewElasticSearchConnector("http://localhost:9200/", "", "", time.Second)
+	require.Nil(t, err)
+	require.False(t, esc.IsInterfaceNil())
+	require.Equal(t, "http://localhost:9200", esc.url)
+}
+
+func TestElasticSearchConnector_GetTransactionsByAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should query the transactions index and compute fees", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedQuery object
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodPost, r.Method)
+			require.Equal(t, "/transactions/_search", r.URL.Path)
+
+			username, password, ok := r.BasicAuth()
+			require.True(t, ok)
+			require.Equal(t, "user", username)
+			require.Equal(t, "pass", password)
+
+			err := json.NewDecoder(r.Body).Decode(&receivedQuery)
+			require.Nil(t, err)
+
+			_, _ = w.Write([]byte(`{"hits":{"hits":[
+				{"_id":"hash1","_source":{"sender":"` + testAddress + `","gasPrice":1000000000,"gasUsed":50000,"timestamp":20}},
+				{"_id":"hash2","_source":{"receiver":"` + testAddress + `","gasPrice":1000000000,"gasUsed":70000,"timestamp":10}}
+			]}}`))
+		}))
+		defer server.Close()
+
+		esc, _ := NewElasticSearchConnector(server.URL, "user", "pass", time.Second)
+		options := createHistoryOptions()
+		options.From = 10
+		txs, err := esc.GetTransactionsByAddress(testAddress, options)
+		require.Nil(t, err)
+		require.Len(t, txs, 2)
+		require.Equal(t, "hash1", txs[0].Hash)
+		require.Equal(t, "50000000000000", txs[0].Fee)
+		require.Equal(t, "hash2", txs[1].Hash)
+		require.Equal(t, "70000000000000", txs[1].Fee)
+
+		require.Equal(t, float64(10), receivedQuery["from"])
+		require.Equal(t, float64(10), receivedQuery["size"])
+	})
+	t.Run("error status code should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte("bad query"))
+		}))
+		defer server.Close()
+
+		esc, _ := NewElasticSearchConnector(server.URL, "", "", time.Second)
+		txs, err := esc.GetTransactionsByAddress(testAddress, createHistoryOptions())
+		require.Nil(t, txs)
+		require.True(t, errors.Is(err, errSearchRequestFailed))
+		require.Contains(t, err.Error(), "bad query")
+	})
+	t.Run("invalid response should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"took":1}`))
+		}))
+		defer server.Close()
+
+		esc, _ := NewElasticSearchConnector(server.URL, "", "", time.Second)
+		txs, err := esc.GetTransactionsByAddress(testAddress, createHistoryOptions())
+		require.Nil(t, txs)
+		require.Equal(t, errCannotGetTxsFromBody, err)
+	})
+	t.Run("invalid hit should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"hits":{"hits":["hash1"]}}`))
+		}))
+		defer server.Close()
+
+		esc, _ := NewElasticSearchConnector(server.URL, "", "", time.Second)
+		txs, err := esc.GetTransactionsByAddress(testAddress, createHistoryOptions())
+		require.Nil(t, txs)
+		require.Equal(t, errCannotGetTxsFromBody, err)
+	})
+	t.Run("unreachable cluster should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		server.Close()
+
+		esc, _ := NewElasticSearchConnector(server.URL, "", "", time.Second)
+		txs, err := esc.GetTransactionsByAddress(testAddress, createHistoryOptions())
+		require.Nil(t, txs)
+		require.NotNil(t, err)
+	})
+}
+
+func TestTxsByAddressQuery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without filters", func(t *testing.T) {
+		t.Parallel()
+
+		query := txsByAddressQuery(testAddress, createHistoryOptions())
+		mustClauses := query["query"].(object)["bool"].(object)["must"].([]interface{})
+		require.Len(t, mustClauses, 1)
+		require.Equal(t, []interface{}{object{"timestamp": object{"order": common.OrderDescending}}}, query["sort"])
+	})
+	t.Run("with all filters", func(t *testing.T) {
+		t.Parallel()
+
+		options := createHistoryOptions()
+		options.Order = common.OrderAscending
+		options.Sender = testAddress
+		options.Receiver = "receiver"
+		options.After = 100
+		options.Before = 200
+
+		query := txsByAddressQuery(testAddress, options)
+		mustClauses := query["query"].(object)["bool"].(object)["must"].([]interface{})
+		require.Len(t, mustClauses, 4)
+		require.Equal(t, object{"match": object{"sender": testAddress}}, mustClauses[1])
+		require.Equal(t, object{"match": object{"receiver": "receiver"}}, mustClauses[2])
+		require.Equal(t, object{"range": object{"timestamp": object{"gte": uint64(100), "lte": uint64(200)}}}, mustClauses[3])
+		require.Equal(t, []interface{}{object{"timestamp": object{"order": common.OrderAscending}}}, query["sort"])
+	})
+}
@@ -5,3 +5,11 @@ import "errors"
 var errCannotFindBlockInDb = errors.New("cannot find blocks in database")
 var errCannotUnmarshalBlock = errors.New("cannot unmarshal block")
 var errCannotGetTxsFromBody = errors.New("cannot get transactions from decoded body")
+var errSearchRequestFailed = errors.New("elasticsearch search request failed")
+var errCannotDecodeResponse = errors.New("cannot decode elasticsearch response")
+
+// ErrEmptyElasticSearchURL signals that an empty Elasticsearch URL has been provided
+var ErrEmptyElasticSearchURL = errors.New("empty elasticsearch URL")
+
+// ErrInvalidRequestTimeout signals that an invalid request timeout has been provided
+var ErrInvalidRequestTimeout = errors.New("invalid request timeout")
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
 )
 
 type object = map[string]interface{}
@@ -57,3 +59,71 @@ func txsByMiniblockHashQuery(hash string) object {
 		},
 	}
 }
+
+func txsByAddressQuery(address string, options common.TransactionsHistoryOptions) object {
+	mustClauses := []interface{}{
+		object{
+			"bool": object{
+				"should": []interface{}{
+					object{
+						"match": object{
+							"sender": address,
+						},
+					},
+					object{
+						"match": object{
+							"receiver": address,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if len(options.Sender) > 0 {
+		mustClauses = append(mustClauses, object{
+			"match": object{
+				"sender": options.Sender,
+			},
+		})
+	}
+	if len(options.Receiver) > 0 {
+		mustClauses = append(mustClauses, object{
+			"match": object{
+				"receiver": options.Receiver,
+			},
+		})
+	}
+
+	timestampRange := object{}
+	if options.After > 0 {
+		timestampRange["gte"] = options.After
+	}
+	if options.Before > 0 {
+		timestampRange["lte"] = options.Before
+	}
+	if len(timestampRange) > 0 {
+		mustClauses = append(mustClauses, object{
+			"range": object{
+				"timestamp": timestampRange,
+			},
+		})
+	}
+
+	return object{
+		"query": object{
+			"bool": object{
+				"must": mustClauses,
+			},
+		},
+		"sort": []interface{}{
+			object{
+				"timestamp": object{
+					"order": options.Order,
+				},
+			},
+		},
+		"from": options.From,
+		"size": options.Size,
+	}
+}
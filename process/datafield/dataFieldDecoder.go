@@ -0,0 +1,363 @@
+package datafield
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"unicode"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// OperationTransfer is the operation of a transaction which only moves EGLD, optionally with a message
+	OperationTransfer = "transfer"
+	// OperationSCDeploy is the operation of a smart contract deployment
+	OperationSCDeploy = "scDeploy"
+	// OperationSCCall is the operation of a smart contract call
+	OperationSCCall = "scCall"
+
+	argsSeparator = "@"
+
+	esdtTransferNumFixedArgs      = 2
+	esdtNFTTransferNumFixedArgs   = 4
+	multiESDTTransferNumFixedArgs = 2
+	multiESDTTransferArgsPerToken = 3
+	relayedTxV2NumArgs            = 4
+)
+
+var log = logger.GetOrCreate("process/datafield")
+
+var builtInFunctions = map[string]struct{}{
+	core.BuiltInFunctionClaimDeveloperRewards:     {},
+	core.BuiltInFunctionChangeOwnerAddress:        {},
+	core.BuiltInFunctionSetUserName:               {},
+	core.BuiltInFunctionSaveKeyValue:              {},
+	core.BuiltInFunctionESDTTransfer:              {},
+	core.BuiltInFunctionESDTBurn:                  {},
+	core.BuiltInFunctionESDTFreeze:                {},
+	core.BuiltInFunctionESDTUnFreeze:              {},
+	core.BuiltInFunctionESDTWipe:                  {},
+	core.BuiltInFunctionESDTPause:                 {},
+	core.BuiltInFunctionESDTUnPause:               {},
+	core.BuiltInFunctionSetESDTRole:               {},
+	core.BuiltInFunctionUnSetESDTRole:             {},
+	core.BuiltInFunctionESDTSetLimitedTransfer:    {},
+	core.BuiltInFunctionESDTUnSetLimitedTransfer:  {},
+	core.BuiltInFunctionESDTLocalMint:             {},
+	core.BuiltInFunctionESDTLocalBurn:             {},
+	core.BuiltInFunctionESDTNFTTransfer:           {},
+	core.BuiltInFunctionESDTNFTCreate:             {},
+	core.BuiltInFunctionESDTNFTAddQuantity:        {},
+	core.BuiltInFunctionESDTNFTCreateRoleTransfer: {},
+	core.BuiltInFunctionESDTNFTBurn:               {},
+	core.BuiltInFunctionESDTNFTAddURI:             {},
+	core.BuiltInFunctionESDTNFTUpdateAttributes:   {},
+	core.BuiltInFunctionMultiESDTNFTTransfer:      {},
+	core.BuiltInFunctionSetGuardian:               {},
+	core.BuiltInFunctionGuardAccount:              {},
+	core.BuiltInFunctionUnGuardAccount:            {},
+	core.BuiltInFunctionMigrateDataTrie:           {},
+}
+
+// innerTransaction holds the fields of a relayed transaction's inner transaction which are relevant for decoding
+type innerTransaction struct {
+	Sender   []byte `json:"sender"`
+	Receiver []byte `json:"receiver"`
+	Data     []byte `json:"data"`
+}
+
+type dataFieldDecoder struct {
+	pubKeyConverter core.PubkeyConverter
+}
+
+// NewDataFieldDecoder creates a new instance of dataFieldDecoder
+func NewDataFieldDecoder(pubKeyConverter core.PubkeyConverter) (*dataFieldDecoder, error) {
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	return &dataFieldDecoder{
+		pubKeyConverter: pubKeyConverter,
+	}, nil
+}
+
+// Decode will decode the data field of a transaction into a normalized operation. The sender and the receiver
+// are expected to be bech32 encoded. It returns nil if the data field is empty
+func (dfd *dataFieldDecoder) Decode(dataField []byte, sender string, receiver string) *data.DecodedTransactionData {
+	if len(dataField) == 0 {
+		return nil
+	}
+
+	receiverBytes, _ := dfd.pubKeyConverter.Decode(receiver)
+	if len(receiverBytes) > 0 && core.IsEmptyAddress(receiverBytes) {
+		return &data.DecodedTransactionData{
+			Operation: OperationSCDeploy,
+			Function:  core.SCDeployInitFunctionName,
+		}
+	}
+
+	function, args, ok := splitDataField(dataField)
+	if !ok {
+		return &data.DecodedTransactionData{
+			Operation: OperationTransfer,
+		}
+	}
+
+	switch function {
+	case core.BuiltInFunctionESDTTransfer:
+		return dfd.decodeESDTTransfer(args, receiver)
+	case core.BuiltInFunctionESDTNFTTransfer:
+		return dfd.decodeESDTNFTTransfer(args, sender, receiver)
+	case core.BuiltInFunctionMultiESDTNFTTransfer:
+		return dfd.decodeMultiESDTNFTTransfer(args, sender, receiver)
+	case core.RelayedTransaction:
+		return dfd.decodeRelayedTx(args)
+	case core.RelayedTransactionV2:
+		return dfd.decodeRelayedTxV2(args, receiver)
+	}
+
+	_, isBuiltIn := builtInFunctions[function]
+	if isBuiltIn {
+		return &data.DecodedTransactionData{
+			Operation: function,
+			Function:  function,
+			Arguments: args,
+		}
+	}
+
+	if !core.IsSmartContractAddress(receiverBytes) {
+		return &data.DecodedTransactionData{
+			Operation: OperationTransfer,
+		}
+	}
+
+	return &data.DecodedTransactionData{
+		Operation:         OperationSCCall,
+		Function:          function,
+		Arguments:         args,
+		EffectiveReceiver: receiver,
+	}
+}
+
+// ESDTTransfer@token@amount[@function@args...]
+func (dfd *dataFieldDecoder) decodeESDTTransfer(args []string, receiver string) *data.DecodedTransactionData {
+	decoded := &data.DecodedTransactionData{
+		Operation: core.BuiltInFunctionESDTTransfer,
+	}
+	if len(args) < esdtTransferNumFixedArgs {
+		decoded.Arguments = args
+		return decoded
+	}
+
+	decoded.Transfers = []data.DecodedTokenTransfer{
+		{
+			Identifier: decodeString(args[0]),
+			Amount:     decodeBigInt(args[1]),
+		},
+	}
+	decoded.EffectiveReceiver = receiver
+	decoded.Function, decoded.Arguments = extractFunctionCall(args[esdtTransferNumFixedArgs:])
+
+	return decoded
+}
+
+// ESDTNFTTransfer@token@nonce@amount@destination[@function@args...] when sent by the owner to self or
+// ESDTNFTTransfer@token@nonce@amount[@function@args...] when received as a smart contract result
+func (dfd *dataFieldDecoder) decodeESDTNFTTransfer(args []string, sender string, receiver string) *data.DecodedTransactionData {
+	decoded := &data.DecodedTransactionData{
+		Operation: core.BuiltInFunctionESDTNFTTransfer,
+	}
+
+	isSentToSelf := sender == receiver
+	numFixedArgs := esdtNFTTransferNumFixedArgs
+	if !isSentToSelf {
+		numFixedArgs--
+	}
+	if len(args) < numFixedArgs {
+		decoded.Arguments = args
+		return decoded
+	}
+
+	decoded.Transfers = []data.DecodedTokenTransfer{
+		{
+			Identifier: decodeString(args[0]),
+			Nonce:      decodeBigIntToUint64(args[1]),
+			Amount:     decodeBigInt(args[2]),
+		},
+	}
+	decoded.EffectiveReceiver = receiver
+	if isSentToSelf {
+		decoded.EffectiveReceiver = dfd.decodeAddress(args[3])
+	}
+	decoded.Function, decoded.Arguments = extractFunctionCall(args[numFixedArgs:])
+
+	return decoded
+}
+
+// MultiESDTNFTTransfer@destination@numTransfers{@token@nonce@amount}[@function@args...] when sent by the owner to self or
+// MultiESDTNFTTransfer@numTransfers{@token@nonce@amount}[@function@args...] when received as a smart contract result
+func (dfd *dataFieldDecoder) decodeMultiESDTNFTTransfer(args []string, sender string, receiver string) *data.DecodedTransactionData {
+	decoded := &data.DecodedTransactionData{
+		Operation: core.BuiltInFunctionMultiESDTNFTTransfer,
+	}
+
+	isSentToSelf := sender == receiver
+	numFixedArgs := multiESDTTransferNumFixedArgs
+	if !isSentToSelf {
+		numFixedArgs--
+	}
+	if len(args) < numFixedArgs {
+		decoded.Arguments = args
+		return decoded
+	}
+
+	numTransfers := int(decodeBigIntToUint64(args[numFixedArgs-1]))
+	endOfTransfers := numFixedArgs + numTransfers*multiESDTTransferArgsPerToken
+	if numTransfers == 0 || len(args) < endOfTransfers {
+		decoded.Arguments = args
+		return decoded
+	}
+
+	decoded.Transfers = make([]data.DecodedTokenTransfer, 0, numTransfers)
+	for idx := numFixedArgs; idx < endOfTransfers; idx += multiESDTTransferArgsPerToken {
+		decoded.Transfers = append(decoded.Transfers, data.DecodedTokenTransfer{
+			Identifier: decodeString(args[idx]),
+			Nonce:      decodeBigIntToUint64(args[idx+1]),
+			Amount:     decodeBigInt(args[idx+2]),
+		})
+	}
+	decoded.EffectiveReceiver = receiver
+	if isSentToSelf {
+		decoded.EffectiveReceiver = dfd.decodeAddress(args[0])
+	}
+	decoded.Function, decoded.Arguments = extractFunctionCall(args[endOfTransfers:])
+
+	return decoded
+}
+
+// relayedTx@hex(json(innerTransaction))
+func (dfd *dataFieldDecoder) decodeRelayedTx(args []string) *data.DecodedTransactionData {
+	decoded := &data.DecodedTransactionData{
+		Operation: core.RelayedTransaction,
+	}
+	if len(args) != 1 {
+		return decoded
+	}
+
+	innerTxBytes, err := hex.DecodeString(args[0])
+	if err != nil {
+		return decoded
+	}
+
+	innerTx := &innerTransaction{}
+	err = json.Unmarshal(innerTxBytes, innerTx)
+	if err != nil {
+		return decoded
+	}
+
+	innerSender := dfd.pubKeyConverter.SilentEncode(innerTx.Sender, log)
+	innerReceiver := dfd.pubKeyConverter.SilentEncode(innerTx.Receiver, log)
+	decoded.EffectiveReceiver = innerReceiver
+	decoded.InnerTransaction = dfd.Decode(innerTx.Data, innerSender, innerReceiver)
+
+	return decoded
+}
+
+// relayedTxV2@innerReceiver@innerNonce@innerData@innerSignature, where the inner sender is the relayed tx receiver
+func (dfd *dataFieldDecoder) decodeRelayedTxV2(args []string, receiver string) *data.DecodedTransactionData {
+	decoded := &data.DecodedTransactionData{
+		Operation: core.RelayedTransactionV2,
+	}
+	if len(args) != relayedTxV2NumArgs {
+		return decoded
+	}
+
+	innerData, err := hex.DecodeString(args[2])
+	if err != nil {
+		return decoded
+	}
+
+	innerReceiver := dfd.decodeAddress(args[0])
+	decoded.EffectiveReceiver = innerReceiver
+	decoded.InnerTransaction = dfd.Decode(innerData, receiver, innerReceiver)
+
+	return decoded
+}
+
+func (dfd *dataFieldDecoder) decodeAddress(hexAddress string) string {
+	addressBytes, err := hex.DecodeString(hexAddress)
+	if err != nil {
+		return ""
+	}
+
+	return dfd.pubKeyConverter.SilentEncode(addressBytes, log)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dfd *dataFieldDecoder) IsInterfaceNil() bool {
+	return dfd == nil
+}
+
+// splitDataField splits a data field of type function@arg1@arg2. It returns false if the data field does not
+// follow this format, as it happens for plain text messages
+func splitDataField(dataField []byte) (string, []string, bool) {
+	tokens := bytes.Split(dataField, []byte(argsSeparator))
+	function := string(tokens[0])
+	if !isValidFunctionName(function) {
+		return "", nil, false
+	}
+
+	args := make([]string, 0, len(tokens)-1)
+	for _, token := range tokens[1:] {
+		_, err := hex.DecodeString(string(token))
+		if err != nil {
+			return "", nil, false
+		}
+		args = append(args, string(token))
+	}
+
+	return function, args, true
+}
+
+func isValidFunctionName(function string) bool {
+	if len(function) == 0 {
+		return false
+	}
+
+	for _, c := range function {
+		isAllowed := c == '_' || (c < unicode.MaxASCII && (unicode.IsLetter(c) || unicode.IsDigit(c)))
+		if !isAllowed {
+			return false
+		}
+	}
+
+	return true
+}
+
+func extractFunctionCall(args []string) (string, []string) {
+	if len(args) == 0 {
+		return "", nil
+	}
+
+	return decodeString(args[0]), args[1:]
+}
+
+func decodeString(hexValue string) string {
+	decoded, _ := hex.DecodeString(hexValue)
+	return string(decoded)
+}
+
+func decodeBigInt(hexValue string) string {
+	decoded, _ := hex.DecodeString(hexValue)
+	return big.NewInt(0).SetBytes(decoded).String()
+}
+
+func decodeBigIntToUint64(hexValue string) uint64 {
+	decoded, _ := hex.DecodeString(hexValue)
+	return big.NewInt(0).SetBytes(decoded).Uint64()
+}
@@ -0,0 +1,205 @@
+package datafield
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+var testPubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+
+const (
+	userAddress    = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	scAddress      = "erd1qqqqqqqqqqqqqpgqhe8t5jewej70zupmh44jurgn29psua5l2jps3ntjj3"
+	deployReceiver = "erd1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq6gq4hu"
+)
+
+func createDecoder(t *testing.T) *dataFieldDecoder {
+	dfd, err := NewDataFieldDecoder(testPubKeyConverter)
+	require.Nil(t, err)
+
+	return dfd
+}
+
+func hexAddress(t *testing.T, address string) string {
+	addressBytes, err := testPubKeyConverter.Decode(address)
+	require.Nil(t, err)
+
+	return hex.EncodeToString(addressBytes)
+}
+
+func TestNewDataFieldDecoder(t *testing.T) {
+	t.Parallel()
+
+	dfd, err := NewDataFieldDecoder(nil)
+	require.Nil(t, dfd)
+	require.Equal(t, ErrNilPubKeyConverter, err)
+
+	dfd, err = NewDataFieldDecoder(testPubKeyConverter)
+	require.Nil(t, err)
+	require.False(t, dfd.IsInterfaceNil())
+}
+
+func TestDataFieldDecoder_Decode(t *testing.T) {
+	t.Parallel()
+
+	dfd := createDecoder(t)
+
+	t.Run("empty data field should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		require.Nil(t, dfd.Decode(nil, userAddress, userAddress))
+	})
+	t.Run("plain message should be a transfer", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := dfd.Decode([]byte("thanks for lunch!"), userAddress, scAddress)
+		require.Equal(t, &data.DecodedTransactionData{Operation: OperationTransfer}, decoded)
+	})
+	t.Run("call on user address should be a transfer", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := dfd.Decode([]byte("hello@01"), userAddress, userAddress)
+		require.Equal(t, &data.DecodedTransactionData{Operation: OperationTransfer}, decoded)
+	})
+	t.Run("deploy", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := dfd.Decode([]byte("0061736d@0500@0100"), userAddress, deployReceiver)
+		require.Equal(t, OperationSCDeploy, decoded.Operation)
+	})
+	t.Run("smart contract call", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := dfd.Decode([]byte("claimRewards@01@02"), userAddress, scAddress)
+		require.Equal(t, &data.DecodedTransactionData{
+			Operation:         OperationSCCall,
+			Function:          "claimRewards",
+			Arguments:         []string{"01", "02"},
+			EffectiveReceiver: scAddress,
+		}, decoded)
+	})
+	t.Run("built-in function", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := dfd.Decode([]byte("ESDTLocalMint@544f4b454e2d616263646566@0a"), userAddress, userAddress)
+		require.Equal(t, &data.DecodedTransactionData{
+			Operation: core.BuiltInFunctionESDTLocalMint,
+			Function:  core.BuiltInFunctionESDTLocalMint,
+			Arguments: []string{"544f4b454e2d616263646566", "0a"},
+		}, decoded)
+	})
+	t.Run("ESDTTransfer", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := dfd.Decode([]byte("ESDTTransfer@544f4b454e2d616263646566@0de0b6b3a7640000"), userAddress, userAddress)
+		require.Equal(t, &data.DecodedTransactionData{
+			Operation:         core.BuiltInFunctionESDTTransfer,
+			Transfers:         []data.DecodedTokenTransfer{{Identifier: "TOKEN-abcdef", Amount: "1000000000000000000"}},
+			EffectiveReceiver: userAddress,
+		}, decoded)
+	})
+	t.Run("ESDTTransfer and execute", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := dfd.Decode([]byte("ESDTTransfer@544f4b454e2d616263646566@64@73776170@05"), userAddress, scAddress)
+		require.Equal(t, &data.DecodedTransactionData{
+			Operation:         core.BuiltInFunctionESDTTransfer,
+			Function:          "swap",
+			Arguments:         []string{"05"},
+			Transfers:         []data.DecodedTokenTransfer{{Identifier: "TOKEN-abcdef", Amount: "100"}},
+			EffectiveReceiver: scAddress,
+		}, decoded)
+	})
+	t.Run("ESDTNFTTransfer and execute", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := fmt.Sprintf("ESDTNFTTransfer@4e46542d616263646566@0a@01@%s@7374616b65", hexAddress(t, scAddress))
+		decoded := dfd.Decode([]byte(dataField), userAddress, userAddress)
+		require.Equal(t, &data.DecodedTransactionData{
+			Operation:         core.BuiltInFunctionESDTNFTTransfer,
+			Function:          "stake",
+			Arguments:         []string{},
+			Transfers:         []data.DecodedTokenTransfer{{Identifier: "NFT-abcdef", Nonce: 10, Amount: "1"}},
+			EffectiveReceiver: scAddress,
+		}, decoded)
+	})
+	t.Run("ESDTNFTTransfer received as smart contract result", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := dfd.Decode([]byte("ESDTNFTTransfer@4e46542d616263646566@0a@01"), scAddress, userAddress)
+		require.Equal(t, &data.DecodedTransactionData{
+			Operation:         core.BuiltInFunctionESDTNFTTransfer,
+			Transfers:         []data.DecodedTokenTransfer{{Identifier: "NFT-abcdef", Nonce: 10, Amount: "1"}},
+			EffectiveReceiver: userAddress,
+		}, decoded)
+	})
+	t.Run("MultiESDTNFTTransfer", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := fmt.Sprintf("MultiESDTNFTTransfer@%s@02@544f4b454e2d616263646566@@64@4e46542d616263646566@0a@01", hexAddress(t, scAddress))
+		decoded := dfd.Decode([]byte(dataField), userAddress, userAddress)
+		require.Equal(t, &data.DecodedTransactionData{
+			Operation: core.BuiltInFunctionMultiESDTNFTTransfer,
+			Transfers: []data.DecodedTokenTransfer{
+				{Identifier: "TOKEN-abcdef", Amount: "100"},
+				{Identifier: "NFT-abcdef", Nonce: 10, Amount: "1"},
+			},
+			EffectiveReceiver: scAddress,
+		}, decoded)
+	})
+	t.Run("MultiESDTNFTTransfer with too few arguments should not decode transfers", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := fmt.Sprintf("MultiESDTNFTTransfer@%s@02@544f4b454e2d616263646566@@64", hexAddress(t, scAddress))
+		decoded := dfd.Decode([]byte(dataField), userAddress, userAddress)
+		require.Equal(t, core.BuiltInFunctionMultiESDTNFTTransfer, decoded.Operation)
+		require.Empty(t, decoded.Transfers)
+		require.Len(t, decoded.Arguments, 5)
+	})
+	t.Run("relayedTx", func(t *testing.T) {
+		t.Parallel()
+
+		userAddressBytes, _ := testPubKeyConverter.Decode(userAddress)
+		scAddressBytes, _ := testPubKeyConverter.Decode(scAddress)
+		innerTx, _ := json.Marshal(&innerTransaction{
+			Sender:   userAddressBytes,
+			Receiver: scAddressBytes,
+			Data:     []byte("claimRewards"),
+		})
+
+		decoded := dfd.Decode([]byte("relayedTx@"+hex.EncodeToString(innerTx)), scAddress, userAddress)
+		require.Equal(t, &data.DecodedTransactionData{
+			Operation:         core.RelayedTransaction,
+			EffectiveReceiver: scAddress,
+			InnerTransaction: &data.DecodedTransactionData{
+				Operation:         OperationSCCall,
+				Function:          "claimRewards",
+				Arguments:         []string{},
+				EffectiveReceiver: scAddress,
+			},
+		}, decoded)
+	})
+	t.Run("relayedTxV2", func(t *testing.T) {
+		t.Parallel()
+
+		innerData := hex.EncodeToString([]byte("ESDTTransfer@544f4b454e2d616263646566@64"))
+		dataField := fmt.Sprintf("relayedTxV2@%s@05@%s@aabb", hexAddress(t, scAddress), innerData)
+		decoded := dfd.Decode([]byte(dataField), scAddress, userAddress)
+		require.Equal(t, &data.DecodedTransactionData{
+			Operation:         core.RelayedTransactionV2,
+			EffectiveReceiver: scAddress,
+			InnerTransaction: &data.DecodedTransactionData{
+				Operation:         core.BuiltInFunctionESDTTransfer,
+				Transfers:         []data.DecodedTokenTransfer{{Identifier: "TOKEN-abcdef", Amount: "100"}},
+				EffectiveReceiver: scAddress,
+			},
+		}, decoded)
+	})
+}
@@ -0,0 +1,6 @@
+package datafield
+
+import "errors"
+
+// ErrNilPubKeyConverter signals that a nil pub key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil pub key converter provided")
@@ -112,3 +112,60 @@ var ErrEmptyPubKey = errors.New("public key is empty")
 
 // ErrNilHttpClient signals that a nil http client has been provided
 var ErrNilHttpClient = errors.New("nil http client")
+
+// ErrNilAccountsHandler signals that a nil accounts handler has been provided
+var ErrNilAccountsHandler = errors.New("nil accounts handler")
+
+// ErrNilTransactionsPoolNonceHandler signals that a nil transactions pool nonce handler has been provided
+var ErrNilTransactionsPoolNonceHandler = errors.New("nil transactions pool nonce handler")
+
+// ErrInvalidNonceReservationDuration signals that an invalid nonce reservation duration has been provided
+var ErrInvalidNonceReservationDuration = errors.New("invalid nonce reservation duration")
+
+// ErrInvalidBalance signals that an observer returned a balance that is not a valid number
+var ErrInvalidBalance = errors.New("invalid balance")
+
+// ErrInvalidKeyValuePairsResponse signals that an observer returned a malformed key-value pairs response
+var ErrInvalidKeyValuePairsResponse = errors.New("invalid key-value pairs response")
+
+// ErrInvalidTokenData signals that an observer returned token data which cannot be decoded
+var ErrInvalidTokenData = errors.New("invalid token data")
+
+// ErrNilNetworkConfigMetricsProvider signals that a nil network config metrics provider has been provided
+var ErrNilNetworkConfigMetricsProvider = errors.New("nil network config metrics provider")
+
+// ErrNilTransactionStatusProvider signals that a nil transaction status provider has been provided
+var ErrNilTransactionStatusProvider = errors.New("nil transaction status provider")
+
+// ErrNilNetworkConfigProvider signals that a nil network config provider has been provided
+var ErrNilNetworkConfigProvider = errors.New("nil network config provider")
+
+// ErrNilNextNonceProvider signals that a nil next nonce provider has been provided
+var ErrNilNextNonceProvider = errors.New("nil next nonce provider")
+
+// ErrNilTransactionsPoolForSenderProvider signals that a nil provider of the sender's transactions from pool has been provided
+var ErrNilTransactionsPoolForSenderProvider = errors.New("nil transactions pool for sender provider")
+
+// ErrNilTransactionSimulator signals that a nil transaction simulator has been provided
+var ErrNilTransactionSimulator = errors.New("nil transaction simulator")
+
+// ErrNilTransactionSendHandler signals that a nil transaction send handler has been provided
+var ErrNilTransactionSendHandler = errors.New("nil transaction send handler")
+
+// ErrNilNetworkConfig signals that the network config could not be fetched
+var ErrNilNetworkConfig = errors.New("nil network config")
+
+// ErrInvalidMaxNonceReservations signals that an invalid maximum number of nonce reservations has been provided
+var ErrInvalidMaxNonceReservations = errors.New("invalid maximum number of nonce reservations per address")
+
+// ErrNilSentTransactionsCacher signals that a nil sent transactions cacher has been provided
+var ErrNilSentTransactionsCacher = errors.New("nil sent transactions cacher")
+
+// ErrNilTransactionHashComputer signals that a nil transaction hash computer has been provided
+var ErrNilTransactionHashComputer = errors.New("nil transaction hash computer")
+
+// ErrNilTrackedTransactionsProvider signals that a nil tracked transactions provider has been provided
+var ErrNilTrackedTransactionsProvider = errors.New("nil tracked transactions provider")
+
+// ErrInvalidAccountsBulkLimits signals that invalid limits for the requests of a bulk of accounts have been provided
+var ErrInvalidAccountsBulkLimits = errors.New("invalid accounts bulk limits")
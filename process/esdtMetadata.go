@@ -0,0 +1,231 @@
+package process
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	metadataHashLength    = 32
+	maxRoyalties          = 10000
+	tokenIDSeparator      = "-"
+	attributesSeparator   = ";"
+	attributeKeySeparator = ":"
+	tagsSeparator         = ","
+	attributeTags         = "tags"
+	attributeMetadata     = "metadata"
+)
+
+type esdtTokenData struct {
+	TokenIdentifier string   `json:"tokenIdentifier"`
+	Balance         string   `json:"balance"`
+	Properties      string   `json:"properties"`
+	Name            string   `json:"name"`
+	Nonce           uint64   `json:"nonce"`
+	Creator         string   `json:"creator"`
+	Royalties       string   `json:"royalties"`
+	Hash            []byte   `json:"hash"`
+	URIs            [][]byte `json:"uris"`
+	Attributes      []byte   `json:"attributes"`
+}
+
+type esdtNftTokenDataResponseData struct {
+	TokenData esdtTokenData  `json:"tokenData"`
+	BlockInfo data.BlockInfo `json:"blockInfo"`
+}
+
+type allESDTTokensResponseData struct {
+	Tokens    map[string]esdtTokenData `json:"esdts"`
+	BlockInfo data.BlockInfo           `json:"blockInfo"`
+}
+
+// GetDecodedESDTNftTokenData returns the token data for a token with the given identifier and nonce, having the URIs,
+// the royalties, the hash and the attributes decoded
+func (ap *AccountProcessor) GetDecodedESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.ESDTTokenMetadataResponseData, error) {
+	response, err := ap.GetESDTNftTokenData(address, key, nonce, options)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenDataResponse := esdtNftTokenDataResponseData{}
+	err = convertResponseData(response.Data, &tokenDataResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := decodeESDTTokenData(&tokenDataResponse.TokenData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.ESDTTokenMetadataResponseData{
+		TokenData: metadata,
+		BlockInfo: tokenDataResponse.BlockInfo,
+	}, nil
+}
+
+// GetDecodedAllESDTTokens returns all the tokens of the given address, having the URIs, the royalties, the hash and
+// the attributes of the collections' tokens decoded. A token which cannot be decoded is returned with its error
+func (ap *AccountProcessor) GetDecodedAllESDTTokens(address string, options common.AccountQueryOptions) (*data.ESDTTokensMetadataResponseData, error) {
+	response, err := ap.GetAllESDTTokens(address, options)
+	if err != nil {
+		return nil, err
+	}
+
+	allTokens := allESDTTokensResponseData{}
+	err = convertResponseData(response.Data, &allTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make(map[string]*data.ESDTTokenMetadata, len(allTokens.Tokens))
+	for identifier, tokenData := range allTokens.Tokens {
+		tokenData := tokenData
+		if len(tokenData.TokenIdentifier) == 0 {
+			tokenData.TokenIdentifier = identifier
+		}
+
+		metadata, errDecode := decodeESDTTokenData(&tokenData)
+		if errDecode != nil {
+			metadata = &data.ESDTTokenMetadata{
+				Identifier: identifier,
+				Collection: extractCollection(tokenData.TokenIdentifier),
+				Nonce:      tokenData.Nonce,
+				Balance:    tokenData.Balance,
+				Error:      errDecode.Error(),
+			}
+		}
+		tokens[identifier] = metadata
+	}
+
+	return &data.ESDTTokensMetadataResponseData{
+		Tokens:    tokens,
+		BlockInfo: allTokens.BlockInfo,
+	}, nil
+}
+
+func decodeESDTTokenData(tokenData *esdtTokenData) (*data.ESDTTokenMetadata, error) {
+	collection := extractCollection(tokenData.TokenIdentifier)
+	metadata := &data.ESDTTokenMetadata{
+		Identifier: computeTokenIdentifier(collection, tokenData.Nonce),
+		Collection: collection,
+		Nonce:      tokenData.Nonce,
+		Balance:    tokenData.Balance,
+		Properties: tokenData.Properties,
+		Name:       tokenData.Name,
+		Creator:    tokenData.Creator,
+		Attributes: decodeTokenAttributes(tokenData.Attributes),
+	}
+
+	if len(tokenData.Royalties) > 0 {
+		royalties, err := strconv.ParseUint(tokenData.Royalties, 10, 32)
+		if err != nil || royalties > maxRoyalties {
+			return nil, fmt.Errorf("%w: invalid royalties %s", ErrInvalidTokenData, tokenData.Royalties)
+		}
+		metadata.Royalties = uint32(royalties)
+		metadata.RoyaltiesPercentage = float64(royalties) * 100 / maxRoyalties
+	}
+
+	metadata.Hash, metadata.IsHashValid = decodeMetadataHash(tokenData.Hash)
+
+	for _, uri := range tokenData.URIs {
+		metadata.URIs = append(metadata.URIs, string(uri))
+	}
+
+	return metadata, nil
+}
+
+// decodeMetadataHash returns the hex encoded hash and whether it has the length of a hash. The hash is also accepted
+// when it was set as its hex encoded text
+func decodeMetadataHash(hash []byte) (string, bool) {
+	if len(hash) == 0 {
+		return "", false
+	}
+	if len(hash) == metadataHashLength {
+		return hex.EncodeToString(hash), true
+	}
+
+	decodedHash, err := hex.DecodeString(string(hash))
+	if err == nil && len(decodedHash) == metadataHashLength {
+		return strings.ToLower(string(hash)), true
+	}
+
+	return hex.EncodeToString(hash), false
+}
+
+// decodeTokenAttributes decodes the attributes following the tags:tag1,tag2;metadata:path convention. The other
+// key:value pairs are returned as fields, while the attributes which are not text are only returned raw
+func decodeTokenAttributes(attributes []byte) *data.ESDTTokenAttributes {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	decodedAttributes := &data.ESDTTokenAttributes{
+		Raw: attributes,
+	}
+	if !utf8.Valid(attributes) {
+		return decodedAttributes
+	}
+
+	decodedAttributes.Text = string(attributes)
+	for _, attribute := range strings.Split(decodedAttributes.Text, attributesSeparator) {
+		key, value, found := strings.Cut(attribute, attributeKeySeparator)
+		if !found {
+			continue
+		}
+
+		key = strings.TrimSpace(key)
+		switch key {
+		case attributeTags:
+			decodedAttributes.Tags = splitTags(value)
+		case attributeMetadata:
+			decodedAttributes.Metadata = strings.TrimSpace(value)
+		default:
+			if decodedAttributes.Fields == nil {
+				decodedAttributes.Fields = make(map[string]string)
+			}
+			decodedAttributes.Fields[key] = strings.TrimSpace(value)
+		}
+	}
+
+	return decodedAttributes
+}
+
+func splitTags(value string) []string {
+	tags := make([]string, 0)
+	for _, tag := range strings.Split(value, tagsSeparator) {
+		tag = strings.TrimSpace(tag)
+		if len(tag) > 0 {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
+// extractCollection returns the ticker and the random sequence of the identifier, without the nonce of the token
+func extractCollection(tokenIdentifier string) string {
+	parts := strings.Split(tokenIdentifier, tokenIDSeparator)
+	if len(parts) < 3 {
+		return tokenIdentifier
+	}
+
+	return parts[0] + tokenIDSeparator + parts[1]
+}
+
+// computeTokenIdentifier appends the nonce to the collection, encoded the same way the protocol does
+func computeTokenIdentifier(collection string, nonce uint64) string {
+	if nonce == 0 {
+		return collection
+	}
+
+	nonceBytes := big.NewInt(0).SetUint64(nonce).Bytes()
+	return collection + tokenIDSeparator + hex.EncodeToString(nonceBytes)
+}
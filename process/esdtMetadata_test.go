@@ -0,0 +1,163 @@
+package process_test
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testMetadataHash = "8b1a9953c4611296a827abf8c47804d7e6c49c6b0e0e8d5c2f1a7a8f8d7f1e2a"
+
+func createESDTProcessorStub(tokens map[string]interface{}) *mock.ProcessorStub {
+	return &mock.ProcessorStub{
+		ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+			return 0, nil
+		},
+		GetObserversCalled: func(shardID uint32, availability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: "observer", ShardId: shardID}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			blockInfo := map[string]interface{}{"nonce": 7, "hash": "blockhash", "rootHash": "roothash"}
+			if strings.Contains(path, "/nft/") {
+				value.(*data.GenericAPIResponse).Data = map[string]interface{}{"tokenData": tokens["NFT-abcdef-0a"], "blockInfo": blockInfo}
+			} else {
+				value.(*data.GenericAPIResponse).Data = map[string]interface{}{"esdts": tokens, "blockInfo": blockInfo}
+			}
+
+			return http.StatusOK, nil
+		},
+	}
+}
+
+func encodeBase64(value string) string {
+	return base64.StdEncoding.EncodeToString([]byte(value))
+}
+
+func TestAccountProcessor_GetDecodedESDTNftTokenData(t *testing.T) {
+	t.Parallel()
+
+	hashBytes, _ := hex.DecodeString(testMetadataHash)
+	tokens := map[string]interface{}{
+		"NFT-abcdef-0a": map[string]interface{}{
+			"tokenIdentifier": "NFT-abcdef",
+			"balance":         "1",
+			"properties":      "00",
+			"name":            "Token #10",
+			"nonce":           10,
+			"creator":         "erd1creator",
+			"royalties":       "750",
+			"hash":            base64.StdEncoding.EncodeToString(hashBytes),
+			"uris":            []string{encodeBase64("https://ipfs.io/ipfs/Qm/10.png")},
+			"attributes":      encodeBase64("tags:art, pixel,;metadata:Qm/10.json;edition:first"),
+		},
+	}
+
+	t.Run("should decode the on-chain fields", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(createESDTProcessorStub(tokens), &mock.PubKeyConverterMock{}, createTestAccountsBulkLimits())
+
+		response, err := ap.GetDecodedESDTNftTokenData("aabb", "NFT-abcdef", 10, common.AccountQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, data.BlockInfo{Nonce: 7, Hash: "blockhash", RootHash: "roothash"}, response.BlockInfo)
+		require.Equal(t, &data.ESDTTokenMetadata{
+			Identifier:          "NFT-abcdef-0a",
+			Collection:          "NFT-abcdef",
+			Nonce:               10,
+			Balance:             "1",
+			Properties:          "00",
+			Name:                "Token #10",
+			Creator:             "erd1creator",
+			Royalties:           750,
+			RoyaltiesPercentage: 7.5,
+			Hash:                testMetadataHash,
+			IsHashValid:         true,
+			URIs:                []string{"https://ipfs.io/ipfs/Qm/10.png"},
+			Attributes: &data.ESDTTokenAttributes{
+				Raw:      []byte("tags:art, pixel,;metadata:Qm/10.json;edition:first"),
+				Text:     "tags:art, pixel,;metadata:Qm/10.json;edition:first",
+				Tags:     []string{"art", "pixel"},
+				Metadata: "Qm/10.json",
+				Fields:   map[string]string{"edition": "first"},
+			},
+		}, response.TokenData)
+	})
+	t.Run("invalid royalties should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidTokens := map[string]interface{}{
+			"NFT-abcdef-0a": map[string]interface{}{"tokenIdentifier": "NFT-abcdef", "nonce": 10, "royalties": "10001"},
+		}
+		ap, _ := process.NewAccountProcessor(createESDTProcessorStub(invalidTokens), &mock.PubKeyConverterMock{}, createTestAccountsBulkLimits())
+
+		response, err := ap.GetDecodedESDTNftTokenData("aabb", "NFT-abcdef", 10, common.AccountQueryOptions{})
+		require.Nil(t, response)
+		require.ErrorIs(t, err, process.ErrInvalidTokenData)
+	})
+}
+
+func TestAccountProcessor_GetDecodedAllESDTTokens(t *testing.T) {
+	t.Parallel()
+
+	tokens := map[string]interface{}{
+		"TKN-123456": map[string]interface{}{
+			"tokenIdentifier": "TKN-123456",
+			"balance":         "1000",
+		},
+		"SFT-abcdef-0100": map[string]interface{}{
+			"tokenIdentifier": "SFT-abcdef-0100",
+			"balance":         "5",
+			"nonce":           256,
+			"hash":            encodeBase64(testMetadataHash),
+			"attributes":      base64.StdEncoding.EncodeToString([]byte{0xff, 0x00}),
+		},
+		"NFT-abcdef-01": map[string]interface{}{
+			"tokenIdentifier": "NFT-abcdef-01",
+			"balance":         "1",
+			"nonce":           1,
+			"hash":            encodeBase64("not a hash"),
+		},
+		"BAD-abcdef-02": map[string]interface{}{
+			"tokenIdentifier": "BAD-abcdef-02",
+			"balance":         "1",
+			"nonce":           2,
+			"royalties":       "10001",
+		},
+	}
+	ap, _ := process.NewAccountProcessor(createESDTProcessorStub(tokens), &mock.PubKeyConverterMock{}, createTestAccountsBulkLimits())
+
+	response, err := ap.GetDecodedAllESDTTokens("aabb", common.AccountQueryOptions{})
+	require.Nil(t, err)
+	require.Len(t, response.Tokens, 4)
+
+	require.Equal(t, &data.ESDTTokenMetadata{
+		Identifier: "TKN-123456",
+		Collection: "TKN-123456",
+		Balance:    "1000",
+	}, response.Tokens["TKN-123456"])
+
+	sft := response.Tokens["SFT-abcdef-0100"]
+	require.Equal(t, "SFT-abcdef-0100", sft.Identifier)
+	require.Equal(t, "SFT-abcdef", sft.Collection)
+	require.Equal(t, testMetadataHash, sft.Hash)
+	require.True(t, sft.IsHashValid)
+	require.Equal(t, &data.ESDTTokenAttributes{Raw: []byte{0xff, 0x00}}, sft.Attributes)
+
+	nft := response.Tokens["NFT-abcdef-01"]
+	require.Equal(t, hex.EncodeToString([]byte("not a hash")), nft.Hash)
+	require.False(t, nft.IsHashValid)
+
+	invalidToken := response.Tokens["BAD-abcdef-02"]
+	require.Equal(t, "BAD-abcdef-02", invalidToken.Identifier)
+	require.Equal(t, "BAD-abcdef", invalidToken.Collection)
+	require.Equal(t, "1", invalidToken.Balance)
+	require.Contains(t, invalidToken.Error, process.ErrInvalidTokenData.Error())
+}
@@ -9,8 +9,10 @@ import (
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
+// ESDTContractAddress is the address of the ESDT system smart contract
+const ESDTContractAddress = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzllls8a5w6u"
+
 const (
-	esdtContractAddress   = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzllls8a5w6u"
 	initialESDTSupplyFunc = "getTokenProperties"
 
 	networkESDTSupplyPath = "/network/esdt/supply/"
@@ -130,7 +132,7 @@ func sumStr(s1, s2 string) string {
 
 func (esp *esdtSupplyProcessor) getInitialSupplyFromMeta(token string) (*big.Int, error) {
 	scQuery := &data.SCQuery{
-		ScAddress: esdtContractAddress,
+		ScAddress: ESDTContractAddress,
 		FuncName:  initialESDTSupplyFunc,
 		Arguments: [][]byte{[]byte(token)},
 	}
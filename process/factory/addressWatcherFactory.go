@@ -0,0 +1,48 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
+	"github.com/multiversx/mx-chain-proxy-go/process/addresswatch"
+)
+
+// AddressWatcherHandler defines an address watcher that can be closed
+type AddressWatcherHandler interface {
+	facade.AddressWatcher
+	Close() error
+}
+
+// CreateAddressWatcher will return the address watcher needed for current settings
+func CreateAddressWatcher(
+	cfg config.AddressWatchConfig,
+	hyperblockProvider addresswatch.HyperblockProvider,
+	latestNonceProvider addresswatch.LatestNonceProvider,
+	accountsProvider addresswatch.AccountsProvider,
+	pubKeyConverter core.PubkeyConverter,
+) (AddressWatcherHandler, error) {
+	if !cfg.Enabled {
+		return &disabledAddressWatcher{}, nil
+	}
+
+	watcher, err := addresswatch.NewAddressWatcher(addresswatch.ArgsAddressWatcher{
+		HyperblockProvider:          hyperblockProvider,
+		LatestNonceProvider:         latestNonceProvider,
+		AccountsProvider:            accountsProvider,
+		PubKeyConverter:             pubKeyConverter,
+		CheckInterval:               time.Duration(cfg.CheckIntervalInMillis) * time.Millisecond,
+		MaxSubscriptions:            cfg.MaxSubscriptions,
+		MaxAddressesPerSubscription: cfg.MaxAddressesPerSubscription,
+		EventsBufferSize:            cfg.EventsBufferSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("address watch is enabled", "max subscriptions", cfg.MaxSubscriptions)
+	watcher.StartFollowing()
+
+	return watcher, nil
+}
@@ -0,0 +1,25 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
+	"github.com/multiversx/mx-chain-proxy-go/process/database"
+)
+
+// CreateDatabaseConnector will return the database connector needed for current settings
+func CreateDatabaseConnector(cfg config.ElasticSearchConnectorConfig) (facade.DatabaseConnector, error) {
+	if len(cfg.URL) == 0 {
+		log.Info("transactions history is disabled, as no elasticsearch URL was provided")
+		return &disabledDatabaseConnector{}, nil
+	}
+
+	log.Info("transactions history is enabled", "elasticsearch URL", cfg.URL)
+	return database.NewElasticSearchConnector(
+		cfg.URL,
+		cfg.Username,
+		cfg.Password,
+		time.Duration(cfg.RequestTimeoutInSec)*time.Second,
+	)
+}
@@ -0,0 +1,23 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type disabledAddressWatcher struct {
+}
+
+// Subscribe will return an error that signals that the address watch is not enabled
+func (d *disabledAddressWatcher) Subscribe(_ *data.AddressWatchRequest) (*data.AddressWatchSubscription, error) {
+	return nil, errors.ErrAddressWatchNotEnabled
+}
+
+// Unsubscribe does nothing as the address watch is not enabled
+func (d *disabledAddressWatcher) Unsubscribe(_ string) {
+}
+
+// Close returns nil
+func (d *disabledAddressWatcher) Close() error {
+	return nil
+}
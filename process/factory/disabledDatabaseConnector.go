@@ -0,0 +1,15 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type disabledDatabaseConnector struct {
+}
+
+// GetTransactionsByAddress will return an error that signals that the transactions history is not enabled
+func (d *disabledDatabaseConnector) GetTransactionsByAddress(_ string, _ common.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	return nil, errors.ErrTransactionsHistoryNotEnabled
+}
@@ -0,0 +1,24 @@
+package factory
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+type disabledTransactionsDeduplicator struct {
+}
+
+// SendTransaction sends the transaction through the handler, as the deduplication of the sent transactions is not enabled
+func (d *disabledTransactionsDeduplicator) SendTransaction(
+	tx *data.Transaction,
+	sendHandler func(tx *data.Transaction) (int, string, error),
+) (int, string, error) {
+	return sendHandler(tx)
+}
+
+// SendMultipleTransactions sends the transactions through the handler, as the deduplication of the sent transactions
+// is not enabled
+func (d *disabledTransactionsDeduplicator) SendMultipleTransactions(
+	txs []*data.Transaction,
+	_ string,
+	sendHandler func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error),
+) (data.MultipleTransactionsResponseData, error) {
+	return sendHandler(txs)
+}
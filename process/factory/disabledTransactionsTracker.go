@@ -0,0 +1,23 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type disabledTransactionsTracker struct {
+}
+
+// Track does nothing as the transactions tracking is not enabled
+func (d *disabledTransactionsTracker) Track(_ *data.Transaction, _ string) {
+}
+
+// GetTrackedTransaction will return an error that signals that the transactions tracking is not enabled
+func (d *disabledTransactionsTracker) GetTrackedTransaction(_ string) (*data.TrackedTransaction, error) {
+	return nil, errors.ErrTransactionsTrackingNotEnabled
+}
+
+// Close returns nil
+func (d *disabledTransactionsTracker) Close() error {
+	return nil
+}
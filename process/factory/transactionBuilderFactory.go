@@ -0,0 +1,39 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	hasherFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
+	marshalFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
+	"github.com/multiversx/mx-chain-proxy-go/process/txbuilder"
+)
+
+// CreateTransactionBuilder will return the transaction builder which uses the same signing marshalizer and hasher
+// as the proxy-side transactions validation
+func CreateTransactionBuilder(
+	cfg config.TransactionValidationConfig,
+	pubKeyConverter core.PubkeyConverter,
+	networkConfigProvider txbuilder.NetworkConfigProvider,
+	nonceProvider txbuilder.NonceProvider,
+	txCostProvider txbuilder.TransactionCostProvider,
+) (facade.TransactionBuilder, error) {
+	signMarshalizer, err := marshalFactory.NewMarshalizer(cfg.SignMarshalizerType)
+	if err != nil {
+		return nil, err
+	}
+
+	signHasher, err := hasherFactory.NewHasher(cfg.SignHasherType)
+	if err != nil {
+		return nil, err
+	}
+
+	return txbuilder.NewTransactionBuilder(txbuilder.ArgsTransactionBuilder{
+		PubKeyConverter:       pubKeyConverter,
+		NetworkConfigProvider: networkConfigProvider,
+		NonceProvider:         nonceProvider,
+		TxCostProvider:        txCostProvider,
+		SignMarshalizer:       signMarshalizer,
+		SignHasher:            signHasher,
+	})
+}
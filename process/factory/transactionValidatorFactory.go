@@ -0,0 +1,40 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	hasherFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
+	marshalFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
+	"github.com/multiversx/mx-chain-proxy-go/process/txvalidator"
+)
+
+// CreateTransactionValidator will return the transaction validator needed for current settings
+func CreateTransactionValidator(
+	cfg config.TransactionValidationConfig,
+	pubKeyConverter core.PubkeyConverter,
+	networkConfigProvider txvalidator.NetworkConfigProvider,
+) (facade.TransactionValidator, error) {
+	if !cfg.Enabled {
+		log.Info("proxy-side transactions validation is disabled")
+		return &disabledTransactionValidator{}, nil
+	}
+
+	log.Info("proxy-side transactions validation is enabled")
+	signMarshalizer, err := marshalFactory.NewMarshalizer(cfg.SignMarshalizerType)
+	if err != nil {
+		return nil, err
+	}
+
+	signHasher, err := hasherFactory.NewHasher(cfg.SignHasherType)
+	if err != nil {
+		return nil, err
+	}
+
+	return txvalidator.NewTransactionValidator(
+		pubKeyConverter,
+		networkConfigProvider,
+		signMarshalizer,
+		signHasher,
+	)
+}
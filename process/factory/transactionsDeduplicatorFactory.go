@@ -0,0 +1,30 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+)
+
+// CreateTransactionsDeduplicator will return the deduplicator of the sent transactions needed for current settings
+func CreateTransactionsDeduplicator(
+	cfg config.DeduplicationConfig,
+	txHashComputer process.TransactionHashComputer,
+	trackedTxsProvider process.TrackedTransactionsProvider,
+) (facade.TransactionsDeduplicator, error) {
+	if !cfg.Enabled {
+		log.Info("deduplication of the sent transactions is disabled")
+		return &disabledTransactionsDeduplicator{}, nil
+	}
+
+	log.Info("deduplication of the sent transactions is enabled", "window in seconds", cfg.WindowInSec)
+	sentTxsCache, err := cache.NewTimedMemoryCacher(time.Duration(cfg.WindowInSec) * time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return process.NewTransactionsDeduplicator(sentTxsCache, txHashComputer, trackedTxsProvider)
+}
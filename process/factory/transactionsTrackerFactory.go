@@ -0,0 +1,43 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
+	"github.com/multiversx/mx-chain-proxy-go/process/txtracker"
+)
+
+// TransactionsTrackerHandler defines a transactions tracker that can be closed
+type TransactionsTrackerHandler interface {
+	facade.TransactionsTracker
+	Close() error
+}
+
+// CreateTransactionsTracker will return the transactions tracker needed for current settings
+func CreateTransactionsTracker(
+	cfg config.TransactionsTrackerConfig,
+	txHandler txtracker.TransactionsHandler,
+	accountsHandler txtracker.AccountsHandler,
+) (TransactionsTrackerHandler, error) {
+	if !cfg.Enabled {
+		return &disabledTransactionsTracker{}, nil
+	}
+
+	txTracker, err := txtracker.NewTransactionsTracker(txtracker.ArgsTransactionsTracker{
+		TransactionsHandler: txHandler,
+		AccountsHandler:     accountsHandler,
+		TrackingWindow:      time.Duration(cfg.TrackingWindowInSec) * time.Second,
+		CheckInterval:       time.Duration(cfg.CheckIntervalInSec) * time.Second,
+		MaxRebroadcasts:     cfg.MaxRebroadcasts,
+		MaxTrackedTxs:       cfg.MaxTrackedTxs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("transactions tracking is enabled", "tracking window in seconds", cfg.TrackingWindowInSec)
+	txTracker.StartMonitoring()
+
+	return txTracker, nil
+}
@@ -2,15 +2,16 @@ package process
 
 import (
 	"encoding/hex"
-	"encoding/json"
 	"math/big"
 	"math/rand"
 	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	ed25519SingleSigner "github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -139,7 +140,7 @@ func (fp *FaucetProcessor) GenerateTxForSendUserFunds(
 }
 
 func (fp *FaucetProcessor) getSignedTx(tx *data.Transaction, privKey crypto.PrivateKey) (*data.Transaction, error) {
-	marshalizedTxBeforeSigning, err := fp.marshalTxForSigning(tx)
+	marshalizedTxBeforeSigning, err := common.MarshalTransactionForSigning(tx, &marshal.JsonMarshalizer{})
 	if err != nil {
 		return nil, err
 	}
@@ -155,22 +156,6 @@ func (fp *FaucetProcessor) getSignedTx(tx *data.Transaction, privKey crypto.Priv
 	return tx, nil
 }
 
-func (fp *FaucetProcessor) marshalTxForSigning(tx *data.Transaction) ([]byte, error) {
-	erdTx := erdTransaction{
-		Nonce:    tx.Nonce,
-		Value:    tx.Value,
-		RcvAddr:  tx.Receiver,
-		SndAddr:  tx.Sender,
-		GasPrice: tx.GasPrice,
-		GasLimit: tx.GasLimit,
-		Data:     tx.Data,
-		ChainID:  tx.ChainID,
-		Version:  tx.Version,
-	}
-
-	return json.Marshal(erdTx)
-}
-
 func (fp *FaucetProcessor) getPrivKeyFromShard(shardID uint32) (crypto.PrivateKey, error) {
 	fp.mutMap.Lock()
 	defer fp.mutMap.Unlock()
@@ -0,0 +1,27 @@
+package gasprice
+
+import "errors"
+
+// ErrNilTransactionsPoolProvider signals that a nil transactions pool provider has been provided
+var ErrNilTransactionsPoolProvider = errors.New("nil transactions pool provider")
+
+// ErrNilNodeStatusProvider signals that a nil node status provider has been provided
+var ErrNilNodeStatusProvider = errors.New("nil node status provider")
+
+// ErrNilNetworkConfigProvider signals that a nil network config provider has been provided
+var ErrNilNetworkConfigProvider = errors.New("nil network config provider")
+
+// ErrNilBlockProvider signals that a nil block provider has been provided
+var ErrNilBlockProvider = errors.New("nil block provider")
+
+// ErrInvalidNumRecentBlocks signals that an invalid number of recent blocks has been provided
+var ErrInvalidNumRecentBlocks = errors.New("invalid number of recent blocks")
+
+// ErrInvalidMaxGasLimitPerBlock signals that an invalid maximum gas limit per block has been provided
+var ErrInvalidMaxGasLimitPerBlock = errors.New("invalid maximum gas limit per block")
+
+// ErrInvalidCacheValidity signals that an invalid cache validity has been provided
+var ErrInvalidCacheValidity = errors.New("invalid cache validity")
+
+// ErrNilNetworkMetrics signals that nil network metrics have been received
+var ErrNilNetworkMetrics = errors.New("nil network metrics")
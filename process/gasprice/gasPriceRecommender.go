@@ -0,0 +1,335 @@
+package gasprice
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	gasPriceField = "gasPrice"
+	gasLimitField = "gasLimit"
+
+	highPriorityTargetBlocks   = 1
+	mediumPriorityTargetBlocks = 3
+
+	// minThroughputShare bounds the throughput derived from the recent blocks, so that a few almost empty blocks
+	// do not turn into unrealistic estimations
+	minThroughputShare = 0.1
+)
+
+var log = logger.GetOrCreate("process/gasprice")
+
+// ArgsGasPriceRecommender holds the arguments needed for creating a new gas price recommender
+type ArgsGasPriceRecommender struct {
+	PoolProvider          TransactionsPoolProvider
+	NodeStatusProvider    NodeStatusProvider
+	NetworkConfigProvider NetworkConfigProvider
+	BlockProvider         BlockProvider
+	NumRecentBlocks       int
+	MaxGasLimitPerBlock   uint64
+	CacheValidity         time.Duration
+}
+
+type pendingTransaction struct {
+	gasPrice uint64
+	gasLimit uint64
+}
+
+type gasPriceRecommender struct {
+	poolProvider          TransactionsPoolProvider
+	nodeStatusProvider    NodeStatusProvider
+	networkConfigProvider NetworkConfigProvider
+	blockProvider         BlockProvider
+	numRecentBlocks       int
+	maxGasLimitPerBlock   uint64
+	cacheValidity         time.Duration
+
+	mutRecommendations sync.RWMutex
+	recommendations    map[uint32]*data.GasPriceRecommendation
+}
+
+// NewGasPriceRecommender creates a new instance of gasPriceRecommender
+func NewGasPriceRecommender(args ArgsGasPriceRecommender) (*gasPriceRecommender, error) {
+	if args.PoolProvider == nil {
+		return nil, ErrNilTransactionsPoolProvider
+	}
+	if args.NodeStatusProvider == nil {
+		return nil, ErrNilNodeStatusProvider
+	}
+	if args.NetworkConfigProvider == nil {
+		return nil, ErrNilNetworkConfigProvider
+	}
+	if args.BlockProvider == nil {
+		return nil, ErrNilBlockProvider
+	}
+	if args.NumRecentBlocks <= 0 {
+		return nil, ErrInvalidNumRecentBlocks
+	}
+	if args.MaxGasLimitPerBlock == 0 {
+		return nil, ErrInvalidMaxGasLimitPerBlock
+	}
+	if args.CacheValidity <= 0 {
+		return nil, ErrInvalidCacheValidity
+	}
+
+	return &gasPriceRecommender{
+		poolProvider:          args.PoolProvider,
+		nodeStatusProvider:    args.NodeStatusProvider,
+		networkConfigProvider: args.NetworkConfigProvider,
+		blockProvider:         args.BlockProvider,
+		numRecentBlocks:       args.NumRecentBlocks,
+		maxGasLimitPerBlock:   args.MaxGasLimitPerBlock,
+		cacheValidity:         args.CacheValidity,
+		recommendations:       make(map[uint32]*data.GasPriceRecommendation),
+	}, nil
+}
+
+// GetGasPriceRecommendation returns the low, medium and high gas price suggestions for the provided shard. The
+// transactions from the pool which pay at least the same gas price are considered to be included first, at the
+// throughput observed in the recent blocks. The recommendation is computed outside the lock, so that a slow observer
+// of a shard does not delay the requests for the other shards
+func (gpr *gasPriceRecommender) GetGasPriceRecommendation(shardID uint32) (*data.GasPriceRecommendation, error) {
+	recommendation, found := gpr.getCachedRecommendation(shardID)
+	if found {
+		return recommendation, nil
+	}
+
+	recommendation, err := gpr.computeRecommendation(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	gpr.mutRecommendations.Lock()
+	gpr.recommendations[shardID] = recommendation
+	gpr.mutRecommendations.Unlock()
+
+	return recommendation, nil
+}
+
+func (gpr *gasPriceRecommender) getCachedRecommendation(shardID uint32) (*data.GasPriceRecommendation, bool) {
+	gpr.mutRecommendations.RLock()
+	defer gpr.mutRecommendations.RUnlock()
+
+	recommendation, found := gpr.recommendations[shardID]
+	if !found || time.Since(time.Unix(recommendation.Timestamp, 0)) >= gpr.cacheValidity {
+		return nil, false
+	}
+
+	return recommendation, true
+}
+
+func (gpr *gasPriceRecommender) computeRecommendation(shardID uint32) (*data.GasPriceRecommendation, error) {
+	networkConfig, err := gpr.networkConfigProvider.GetNetworkConfig()
+	if err != nil {
+		return nil, err
+	}
+	minGasPrice := networkConfig.Config.MinGasPrice
+
+	pendingTxs, err := gpr.getPendingTransactions(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	avgGasPerBlock, fullness := gpr.getRecentBlocksUsage(shardID)
+
+	pendingGas := uint64(0)
+	for _, tx := range pendingTxs {
+		pendingGas += tx.gasLimit
+	}
+
+	// the recent blocks only show the throughput of the shard if there were enough transactions to fill them
+	throughput := gpr.maxGasLimitPerBlock
+	if pendingGas > gpr.maxGasLimitPerBlock {
+		minThroughput := uint64(minThroughputShare*float64(gpr.maxGasLimitPerBlock)) + 1
+		throughput = core.MaxUint64(avgGasPerBlock, minThroughput)
+		throughput = core.MinUint64(throughput, gpr.maxGasLimitPerBlock)
+	}
+
+	estimator := newInclusionEstimator(pendingTxs, throughput)
+
+	return &data.GasPriceRecommendation{
+		ShardID:                shardID,
+		MinGasPrice:            minGasPrice,
+		RecentBlocksFullness:   fullness,
+		NumPendingTransactions: len(pendingTxs),
+		PendingGas:             pendingGas,
+		Low:                    estimator.suggestionForPrice(minGasPrice),
+		Medium:                 estimator.suggestionForTarget(mediumPriorityTargetBlocks, minGasPrice),
+		High:                   estimator.suggestionForTarget(highPriorityTargetBlocks, minGasPrice),
+		Timestamp:              time.Now().Unix(),
+	}, nil
+}
+
+func (gpr *gasPriceRecommender) getNetworkStatus(shardID uint32) (*data.NetworkStatus, error) {
+	genericResponse, err := gpr.nodeStatusProvider.GetNetworkStatusMetrics(shardID)
+	if err != nil {
+		return nil, err
+	}
+	if genericResponse == nil {
+		return nil, ErrNilNetworkMetrics
+	}
+
+	networkStatusBytes, err := json.Marshal(&genericResponse.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	networkStatus := &data.NetworkStatus{}
+	err = json.Unmarshal(networkStatusBytes, networkStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	return networkStatus, nil
+}
+
+func (gpr *gasPriceRecommender) getPendingTransactions(shardID uint32) ([]pendingTransaction, error) {
+	txPool, err := gpr.poolProvider.GetTransactionsPoolGasForShard(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingTxs := make([]pendingTransaction, 0, len(txPool.RegularTransactions))
+	for _, tx := range txPool.RegularTransactions {
+		pendingTxs = append(pendingTxs, pendingTransaction{
+			gasPrice: tx.GetUint64Field(gasPriceField),
+			gasLimit: tx.GetUint64Field(gasLimitField),
+		})
+	}
+
+	return pendingTxs, nil
+}
+
+// getRecentBlocksUsage returns the average gas provided to the user transactions of the recent blocks, together
+// with the average fullness of those blocks. Blocks which cannot be fetched are skipped
+func (gpr *gasPriceRecommender) getRecentBlocksUsage(shardID uint32) (uint64, float64) {
+	networkStatus, err := gpr.getNetworkStatus(shardID)
+	if err != nil {
+		log.Debug("gasPriceRecommender: cannot get network status", "shard", shardID, "error", err)
+		return 0, 0
+	}
+
+	numBlocks := uint64(0)
+	totalGas := uint64(0)
+	totalFullness := float64(0)
+	options := common.BlockQueryOptions{WithTransactions: true}
+	latestNonce := networkStatus.Status.Nonce
+	for i := uint64(0); i < uint64(gpr.numRecentBlocks) && i <= latestNonce; i++ {
+		response, errGet := gpr.blockProvider.GetBlockByNonce(shardID, latestNonce-i, options)
+		if errGet != nil {
+			log.Debug("gasPriceRecommender: cannot get block", "shard", shardID, "nonce", latestNonce-i, "error", errGet)
+			continue
+		}
+
+		blockGas := getTransactionsGas(response)
+		fullness := float64(blockGas) / float64(gpr.maxGasLimitPerBlock)
+		if fullness > 1 {
+			fullness = 1
+		}
+
+		numBlocks++
+		totalGas += blockGas
+		totalFullness += fullness
+	}
+
+	if numBlocks == 0 {
+		return 0, 0
+	}
+
+	return totalGas / numBlocks, totalFullness / float64(numBlocks)
+}
+
+func getTransactionsGas(response *data.BlockApiResponse) uint64 {
+	gas := uint64(0)
+	for _, miniBlock := range response.Data.Block.MiniBlocks {
+		if miniBlock.Type != block.TxBlock.String() {
+			continue
+		}
+
+		for _, tx := range miniBlock.Transactions {
+			gas += tx.GasLimit
+		}
+	}
+
+	return gas
+}
+
+// inclusionEstimator estimates the number of blocks until a transaction is included, given the gas of the
+// pending transactions which pay at least the same gas price
+type inclusionEstimator struct {
+	sortedTxs    []pendingTransaction
+	cumulatedGas []uint64
+	throughput   uint64
+}
+
+func newInclusionEstimator(pendingTxs []pendingTransaction, throughput uint64) *inclusionEstimator {
+	sortedTxs := make([]pendingTransaction, len(pendingTxs))
+	copy(sortedTxs, pendingTxs)
+	sort.Slice(sortedTxs, func(i, j int) bool {
+		return sortedTxs[i].gasPrice > sortedTxs[j].gasPrice
+	})
+
+	// cumulatedGas[i] holds the gas of the first i transactions, in the descending order of their gas price
+	cumulatedGas := make([]uint64, len(sortedTxs)+1)
+	for i, tx := range sortedTxs {
+		cumulatedGas[i+1] = cumulatedGas[i] + tx.gasLimit
+	}
+
+	return &inclusionEstimator{
+		sortedTxs:    sortedTxs,
+		cumulatedGas: cumulatedGas,
+		throughput:   throughput,
+	}
+}
+
+func (ie *inclusionEstimator) estimateBlocks(gasPrice uint64) uint64 {
+	numTxsAhead := sort.Search(len(ie.sortedTxs), func(i int) bool {
+		return ie.sortedTxs[i].gasPrice < gasPrice
+	})
+
+	return ie.cumulatedGas[numTxsAhead]/ie.throughput + 1
+}
+
+func (ie *inclusionEstimator) suggestionForPrice(gasPrice uint64) data.GasPriceSuggestion {
+	return data.GasPriceSuggestion{
+		GasPrice:        gasPrice,
+		EstimatedBlocks: ie.estimateBlocks(gasPrice),
+	}
+}
+
+// suggestionForTarget returns the lowest gas price, not lower than the minimum one, which is estimated to be included
+// within the target number of blocks. Outbidding the most expensive pending transaction always meets the target
+func (ie *inclusionEstimator) suggestionForTarget(targetBlocks uint64, minGasPrice uint64) data.GasPriceSuggestion {
+	suggestion := ie.suggestionForPrice(minGasPrice)
+	if suggestion.EstimatedBlocks <= targetBlocks {
+		return suggestion
+	}
+
+	// the candidates are checked from the lowest price, so the first one meeting the target is returned
+	for i := len(ie.sortedTxs) - 1; i >= 0; i-- {
+		candidate := ie.sortedTxs[i].gasPrice + 1
+		if candidate <= minGasPrice {
+			continue
+		}
+
+		suggestion = ie.suggestionForPrice(candidate)
+		if suggestion.EstimatedBlocks <= targetBlocks {
+			return suggestion
+		}
+	}
+
+	return suggestion
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gpr *gasPriceRecommender) IsInterfaceNil() bool {
+	return gpr == nil
+}
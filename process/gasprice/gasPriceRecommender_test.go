@@ -0,0 +1,361 @@
+package gasprice
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMinGasPrice         = 100
+	testMaxGasLimitPerBlock = 1000
+	testLatestNonce         = 10
+)
+
+var expectedErr = errors.New("expected error")
+
+type poolProviderStub struct {
+	getTransactionsPoolGasForShardCalled func(shardID uint32) (*data.TransactionsPool, error)
+}
+
+func (stub *poolProviderStub) GetTransactionsPoolGasForShard(shardID uint32) (*data.TransactionsPool, error) {
+	if stub.getTransactionsPoolGasForShardCalled != nil {
+		return stub.getTransactionsPoolGasForShardCalled(shardID)
+	}
+
+	return &data.TransactionsPool{}, nil
+}
+
+type nodeStatusProviderStub struct {
+	getNetworkStatusMetricsCalled func(shardID uint32) (*data.GenericAPIResponse, error)
+}
+
+func (stub *nodeStatusProviderStub) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+	if stub.getNetworkStatusMetricsCalled != nil {
+		return stub.getNetworkStatusMetricsCalled(shardID)
+	}
+
+	return &data.GenericAPIResponse{
+		Data: map[string]interface{}{
+			"status": map[string]interface{}{
+				"erd_nonce": testLatestNonce,
+			},
+		},
+	}, nil
+}
+
+type networkConfigProviderStub struct {
+	getNetworkConfigCalled func() (*data.NetworkConfig, error)
+}
+
+func (stub *networkConfigProviderStub) GetNetworkConfig() (*data.NetworkConfig, error) {
+	if stub.getNetworkConfigCalled != nil {
+		return stub.getNetworkConfigCalled()
+	}
+
+	networkConfig := &data.NetworkConfig{}
+	networkConfig.Config.MinGasPrice = testMinGasPrice
+
+	return networkConfig, nil
+}
+
+type blockProviderStub struct {
+	getBlockByNonceCalled func(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+}
+
+func (stub *blockProviderStub) GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	if stub.getBlockByNonceCalled != nil {
+		return stub.getBlockByNonceCalled(shardID, nonce, options)
+	}
+
+	return &data.BlockApiResponse{}, nil
+}
+
+func createPoolTx(gasPrice uint64, gasLimit uint64) data.WrappedTransaction {
+	return data.WrappedTransaction{
+		TxFields: map[string]interface{}{
+			"gasPrice": float64(gasPrice),
+			"gasLimit": float64(gasLimit),
+		},
+	}
+}
+
+func createBlockResponse(txsGasLimits ...uint64) *data.BlockApiResponse {
+	txs := make([]*transaction.ApiTransactionResult, 0, len(txsGasLimits))
+	for _, gasLimit := range txsGasLimits {
+		txs = append(txs, &transaction.ApiTransactionResult{GasLimit: gasLimit})
+	}
+
+	response := &data.BlockApiResponse{}
+	response.Data.Block.MiniBlocks = []*api.MiniBlock{
+		{Type: block.TxBlock.String(), Transactions: txs},
+		{
+			Type:         block.SmartContractResultBlock.String(),
+			Transactions: []*transaction.ApiTransactionResult{{GasLimit: 5000}},
+		},
+	}
+
+	return response
+}
+
+func createMockArgs() ArgsGasPriceRecommender {
+	return ArgsGasPriceRecommender{
+		PoolProvider:          &poolProviderStub{},
+		NodeStatusProvider:    &nodeStatusProviderStub{},
+		NetworkConfigProvider: &networkConfigProviderStub{},
+		BlockProvider:         &blockProviderStub{},
+		NumRecentBlocks:       2,
+		MaxGasLimitPerBlock:   testMaxGasLimitPerBlock,
+		CacheValidity:         time.Minute,
+	}
+}
+
+func TestNewGasPriceRecommender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pool provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.PoolProvider = nil
+		recommender, err := NewGasPriceRecommender(args)
+		require.Nil(t, recommender)
+		require.Equal(t, ErrNilTransactionsPoolProvider, err)
+	})
+	t.Run("nil node status provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.NodeStatusProvider = nil
+		recommender, err := NewGasPriceRecommender(args)
+		require.Nil(t, recommender)
+		require.Equal(t, ErrNilNodeStatusProvider, err)
+	})
+	t.Run("nil network config provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.NetworkConfigProvider = nil
+		recommender, err := NewGasPriceRecommender(args)
+		require.Nil(t, recommender)
+		require.Equal(t, ErrNilNetworkConfigProvider, err)
+	})
+	t.Run("nil block provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.BlockProvider = nil
+		recommender, err := NewGasPriceRecommender(args)
+		require.Nil(t, recommender)
+		require.Equal(t, ErrNilBlockProvider, err)
+	})
+	t.Run("invalid number of recent blocks should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.NumRecentBlocks = 0
+		recommender, err := NewGasPriceRecommender(args)
+		require.Nil(t, recommender)
+		require.Equal(t, ErrInvalidNumRecentBlocks, err)
+	})
+	t.Run("invalid max gas limit per block should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.MaxGasLimitPerBlock = 0
+		recommender, err := NewGasPriceRecommender(args)
+		require.Nil(t, recommender)
+		require.Equal(t, ErrInvalidMaxGasLimitPerBlock, err)
+	})
+	t.Run("invalid cache validity should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.CacheValidity = 0
+		recommender, err := NewGasPriceRecommender(args)
+		require.Nil(t, recommender)
+		require.Equal(t, ErrInvalidCacheValidity, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		recommender, err := NewGasPriceRecommender(createMockArgs())
+		require.Nil(t, err)
+		require.False(t, recommender.IsInterfaceNil())
+	})
+}
+
+func TestGasPriceRecommender_GetGasPriceRecommendation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("network config error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.NetworkConfigProvider = &networkConfigProviderStub{
+			getNetworkConfigCalled: func() (*data.NetworkConfig, error) {
+				return nil, expectedErr
+			},
+		}
+		recommender, _ := NewGasPriceRecommender(args)
+
+		recommendation, err := recommender.GetGasPriceRecommendation(0)
+		require.Nil(t, recommendation)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("pool error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.PoolProvider = &poolProviderStub{
+			getTransactionsPoolGasForShardCalled: func(shardID uint32) (*data.TransactionsPool, error) {
+				return nil, expectedErr
+			},
+		}
+		recommender, _ := NewGasPriceRecommender(args)
+
+		recommendation, err := recommender.GetGasPriceRecommendation(0)
+		require.Nil(t, recommendation)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("empty pool should recommend the minimum gas price", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.BlockProvider = &blockProviderStub{
+			getBlockByNonceCalled: func(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+				return createBlockResponse(100, 100), nil
+			},
+		}
+		recommender, _ := NewGasPriceRecommender(args)
+
+		recommendation, err := recommender.GetGasPriceRecommendation(1)
+		require.Nil(t, err)
+		require.Equal(t, uint32(1), recommendation.ShardID)
+		require.Equal(t, uint64(testMinGasPrice), recommendation.MinGasPrice)
+		require.Equal(t, 0.2, recommendation.RecentBlocksFullness)
+		require.Equal(t, 0, recommendation.NumPendingTransactions)
+		expectedSuggestion := data.GasPriceSuggestion{GasPrice: testMinGasPrice, EstimatedBlocks: 1}
+		require.Equal(t, expectedSuggestion, recommendation.Low)
+		require.Equal(t, expectedSuggestion, recommendation.Medium)
+		require.Equal(t, expectedSuggestion, recommendation.High)
+	})
+	t.Run("congested pool should recommend higher gas prices", func(t *testing.T) {
+		t.Parallel()
+
+		requestedNonces := make([]uint64, 0)
+		args := createMockArgs()
+		args.PoolProvider = &poolProviderStub{
+			getTransactionsPoolGasForShardCalled: func(shardID uint32) (*data.TransactionsPool, error) {
+				return &data.TransactionsPool{
+					RegularTransactions: []data.WrappedTransaction{
+						createPoolTx(200, 600),
+						createPoolTx(100, 1000),
+						createPoolTx(300, 400),
+						createPoolTx(200, 500),
+					},
+				}, nil
+			},
+		}
+		args.BlockProvider = &blockProviderStub{
+			getBlockByNonceCalled: func(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+				require.True(t, options.WithTransactions)
+				requestedNonces = append(requestedNonces, nonce)
+				return createBlockResponse(500, 300), nil
+			},
+		}
+		recommender, _ := NewGasPriceRecommender(args)
+
+		recommendation, err := recommender.GetGasPriceRecommendation(0)
+		require.Nil(t, err)
+		require.Equal(t, []uint64{testLatestNonce, testLatestNonce - 1}, requestedNonces)
+		require.Equal(t, 0.8, recommendation.RecentBlocksFullness)
+		require.Equal(t, 4, recommendation.NumPendingTransactions)
+		require.Equal(t, uint64(2500), recommendation.PendingGas)
+		// 2500 gas pending at a throughput of 800 gas per block
+		require.Equal(t, data.GasPriceSuggestion{GasPrice: 100, EstimatedBlocks: 4}, recommendation.Low)
+		// outbidding the 1000 gas at the minimum price leaves 1500 gas ahead
+		require.Equal(t, data.GasPriceSuggestion{GasPrice: 101, EstimatedBlocks: 2}, recommendation.Medium)
+		// outbidding the 1100 gas at 200 leaves 400 gas ahead
+		require.Equal(t, data.GasPriceSuggestion{GasPrice: 201, EstimatedBlocks: 1}, recommendation.High)
+	})
+	t.Run("unavailable blocks should use the minimum throughput", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.PoolProvider = &poolProviderStub{
+			getTransactionsPoolGasForShardCalled: func(shardID uint32) (*data.TransactionsPool, error) {
+				return &data.TransactionsPool{
+					RegularTransactions: []data.WrappedTransaction{
+						createPoolTx(100, 1500),
+					},
+				}, nil
+			},
+		}
+		args.BlockProvider = &blockProviderStub{
+			getBlockByNonceCalled: func(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		recommender, _ := NewGasPriceRecommender(args)
+
+		recommendation, err := recommender.GetGasPriceRecommendation(0)
+		require.Nil(t, err)
+		require.Equal(t, float64(0), recommendation.RecentBlocksFullness)
+		require.Equal(t, data.GasPriceSuggestion{GasPrice: 100, EstimatedBlocks: 15}, recommendation.Low)
+		require.Equal(t, data.GasPriceSuggestion{GasPrice: 101, EstimatedBlocks: 1}, recommendation.High)
+	})
+	t.Run("recommendations should be cached per shard", func(t *testing.T) {
+		t.Parallel()
+
+		numPoolRequests := make(map[uint32]int)
+		args := createMockArgs()
+		args.PoolProvider = &poolProviderStub{
+			getTransactionsPoolGasForShardCalled: func(shardID uint32) (*data.TransactionsPool, error) {
+				numPoolRequests[shardID]++
+				return &data.TransactionsPool{}, nil
+			},
+		}
+		recommender, _ := NewGasPriceRecommender(args)
+
+		_, _ = recommender.GetGasPriceRecommendation(0)
+		_, _ = recommender.GetGasPriceRecommendation(0)
+		_, _ = recommender.GetGasPriceRecommendation(1)
+		require.Equal(t, map[uint32]int{0: 1, 1: 1}, numPoolRequests)
+	})
+	t.Run("a slow shard should not delay the other shards", func(t *testing.T) {
+		t.Parallel()
+
+		releaseShard0 := make(chan struct{})
+		args := createMockArgs()
+		args.PoolProvider = &poolProviderStub{
+			getTransactionsPoolGasForShardCalled: func(shardID uint32) (*data.TransactionsPool, error) {
+				if shardID == 0 {
+					<-releaseShard0
+				}
+				return &data.TransactionsPool{}, nil
+			},
+		}
+		recommender, _ := NewGasPriceRecommender(args)
+
+		shard0Done := make(chan struct{})
+		go func() {
+			_, _ = recommender.GetGasPriceRecommendation(0)
+			close(shard0Done)
+		}()
+
+		recommendation, err := recommender.GetGasPriceRecommendation(1)
+		require.Nil(t, err)
+		require.Equal(t, uint32(1), recommendation.ShardID)
+
+		close(releaseShard0)
+		<-shard0Done
+	})
+}
@@ -0,0 +1,27 @@
+package gasprice
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// TransactionsPoolProvider defines what a component which fetches the gas of the transactions from the pool of a shard
+// should do
+type TransactionsPoolProvider interface {
+	GetTransactionsPoolGasForShard(shardID uint32) (*data.TransactionsPool, error)
+}
+
+// NodeStatusProvider defines what a component which fetches the network status metrics should do
+type NodeStatusProvider interface {
+	GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error)
+}
+
+// NetworkConfigProvider defines what a component which provides the network config should do
+type NetworkConfigProvider interface {
+	GetNetworkConfig() (*data.NetworkConfig, error)
+}
+
+// BlockProvider defines what a component which fetches blocks should do
+type BlockProvider interface {
+	GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+}
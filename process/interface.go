@@ -1,6 +1,7 @@
 package process
 
 import (
+	"io"
 	"net/http"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -23,6 +24,7 @@ type Processor interface {
 	GetShardIDs() []uint32
 	ComputeShardId(addressBuff []byte) (uint32, error)
 	CallGetRestEndPoint(address string, path string, value interface{}) (int, error)
+	CallGetRestEndPointStream(address string, path string, handler func(body io.Reader) error) (int, error)
 	CallPostRestEndPoint(address string, path string, data interface{}, response interface{}) (int, error)
 	GetShardCoordinator() common.Coordinator
 	GetPubKeyConverter() core.PubkeyConverter
@@ -85,3 +87,62 @@ type StatusMetricsProvider interface {
 type HttpClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
+
+// AccountsHandler defines the accounts fetching actions needed by other processors
+type AccountsHandler interface {
+	GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+}
+
+// TransactionsPoolNonceHandler defines the nonce related actions on the transactions pool needed by other processors
+type TransactionsPoolNonceHandler interface {
+	GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error)
+	GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error)
+}
+
+// TransactionStatusProvider defines the transaction queries needed while waiting for the outcome of a transaction
+type TransactionStatusProvider interface {
+	GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error)
+	GetTransaction(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error)
+}
+
+// NextNonceProvider defines the component able to provide the next nonce of an address
+type NextNonceProvider interface {
+	GetNextNonce(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error)
+}
+
+// TransactionsPoolForSenderProvider defines the component able to provide the transactions of a sender from pool
+type TransactionsPoolForSenderProvider interface {
+	GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error)
+}
+
+// TransactionSimulator defines the component able to simulate a transaction
+type TransactionSimulator interface {
+	SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
+}
+
+// NetworkConfigHandler defines the component able to provide the network config
+type NetworkConfigHandler interface {
+	GetNetworkConfig() (*data.NetworkConfig, error)
+}
+
+// NetworkConfigMetricsProvider defines the component able to fetch the raw network config metrics from the observers
+type NetworkConfigMetricsProvider interface {
+	GetNetworkConfigMetrics() (*data.GenericAPIResponse, error)
+}
+
+// SentTransactionsCacher defines the cacher which remembers the recently sent transactions and idempotency keys
+type SentTransactionsCacher interface {
+	Put(key string, value interface{})
+	PutIfAbsent(key string, value interface{}) (interface{}, bool)
+	Remove(key string)
+}
+
+// TransactionHashComputer defines the component able to compute the hash of a transaction
+type TransactionHashComputer interface {
+	ComputeTransactionHash(tx *data.Transaction) (string, error)
+}
+
+// TrackedTransactionsProvider defines the component able to provide the lifecycle of a tracked transaction
+type TrackedTransactionsProvider interface {
+	GetTrackedTransaction(txHash string) (*data.TrackedTransaction, error)
+}
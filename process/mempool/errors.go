@@ -0,0 +1,24 @@
+package mempool
+
+import "errors"
+
+// ErrNilTransactionsPoolProvider signals that a nil transactions pool provider has been provided
+var ErrNilTransactionsPoolProvider = errors.New("nil transactions pool provider")
+
+// ErrNilShardIDsProvider signals that a nil shard IDs provider has been provided
+var ErrNilShardIDsProvider = errors.New("nil shard IDs provider")
+
+// ErrNilDataFieldDecoder signals that a nil data field decoder has been provided
+var ErrNilDataFieldDecoder = errors.New("nil data field decoder")
+
+// ErrInvalidSnapshotValidity signals that an invalid snapshot validity has been provided
+var ErrInvalidSnapshotValidity = errors.New("invalid snapshot validity")
+
+// ErrInvalidCursorValidity signals that an invalid cursor validity has been provided
+var ErrInvalidCursorValidity = errors.New("invalid cursor validity")
+
+// ErrInvalidMaxCachedTransactions signals that an invalid maximum number of cached transactions has been provided
+var ErrInvalidMaxCachedTransactions = errors.New("invalid maximum number of cached transactions")
+
+// ErrIncompletePoolSnapshot signals that the transactions pool of a shard could not be fetched for the snapshot
+var ErrIncompletePoolSnapshot = errors.New("cannot fetch the transactions pool of all shards")
@@ -0,0 +1,24 @@
+package mempool
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// TransactionsPoolProvider defines what a component which fetches the transactions pool of a shard should do
+type TransactionsPoolProvider interface {
+	GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error)
+}
+
+// ShardIDsProvider defines what a component which knows the shards of the network should do
+type ShardIDsProvider interface {
+	GetShardIDs() []uint32
+}
+
+// DataFieldDecoder defines what a component which decodes the data field of a transaction should do
+type DataFieldDecoder interface {
+	Decode(dataField []byte, sender string, receiver string) *data.DecodedTransactionData
+}
+
+type snapshotsCacher interface {
+	Get(key string) (interface{}, bool)
+	Put(key string, value interface{})
+	Remove(key string)
+}
@@ -0,0 +1,511 @@
+package mempool
+
+import (
+	"encoding/base64"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+)
+
+const (
+	poolFields = "hash,nonce,sender,receiver,value,gasprice,gaslimit,data"
+
+	hashField     = "hash"
+	nonceField    = "nonce"
+	senderField   = "sender"
+	receiverField = "receiver"
+	valueField    = "value"
+	gasPriceField = "gasPrice"
+	gasLimitField = "gasLimit"
+	dataField     = "data"
+
+	cursorSeparator = ":"
+	numTopSenders   = 10
+)
+
+var log = logger.GetOrCreate("process/mempool")
+
+// ArgsMempoolExplorer holds the arguments needed for creating a new mempool explorer
+type ArgsMempoolExplorer struct {
+	PoolProvider          TransactionsPoolProvider
+	ShardIDsProvider      ShardIDsProvider
+	DataFieldDecoder      DataFieldDecoder
+	SnapshotValidity      time.Duration
+	CursorValidity        time.Duration
+	MaxCachedTransactions int
+}
+
+type poolSnapshot struct {
+	id           uint64
+	timestamp    time.Time
+	transactions []*data.MempoolTransaction
+}
+
+type mempoolExplorer struct {
+	poolProvider     TransactionsPoolProvider
+	shardIDsProvider ShardIDsProvider
+	dataFieldDecoder DataFieldDecoder
+	snapshotValidity time.Duration
+	cursorValidity   time.Duration
+	maxCachedTxs     int
+	snapshots        snapshotsCacher
+
+	mutSnapshot     sync.Mutex
+	lastSnapshot    *poolSnapshot
+	lastSnapshotID  uint64
+	firstSeen       map[string]int64
+	cachedSnapshots []*poolSnapshot
+	numCachedTxs    int
+}
+
+// NewMempoolExplorer creates a new instance of mempoolExplorer
+func NewMempoolExplorer(args ArgsMempoolExplorer) (*mempoolExplorer, error) {
+	if args.PoolProvider == nil {
+		return nil, ErrNilTransactionsPoolProvider
+	}
+	if args.ShardIDsProvider == nil {
+		return nil, ErrNilShardIDsProvider
+	}
+	if args.DataFieldDecoder == nil {
+		return nil, ErrNilDataFieldDecoder
+	}
+	if args.SnapshotValidity <= 0 {
+		return nil, ErrInvalidSnapshotValidity
+	}
+	if args.CursorValidity < args.SnapshotValidity {
+		return nil, ErrInvalidCursorValidity
+	}
+	if args.MaxCachedTransactions <= 0 {
+		return nil, ErrInvalidMaxCachedTransactions
+	}
+
+	snapshots, err := cache.NewTimedMemoryCacher(args.CursorValidity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mempoolExplorer{
+		poolProvider:     args.PoolProvider,
+		shardIDsProvider: args.ShardIDsProvider,
+		dataFieldDecoder: args.DataFieldDecoder,
+		snapshotValidity: args.SnapshotValidity,
+		cursorValidity:   args.CursorValidity,
+		maxCachedTxs:     args.MaxCachedTransactions,
+		snapshots:        snapshots,
+		firstSeen:        make(map[string]int64),
+		cachedSnapshots:  make([]*poolSnapshot, 0),
+	}, nil
+}
+
+// GetMempoolPage returns the page of the pool transactions which match the provided options. The first page is
+// served from the latest snapshot, while the next ones are served from the snapshot referenced by the cursor. A cursor
+// can only be used with the filters and the sort order of the page it was returned with
+func (me *mempoolExplorer) GetMempoolPage(options common.MempoolQueryOptions) (*data.MempoolPage, error) {
+	queryHash := computeQueryHash(options)
+	snapshot, offset, err := me.getSnapshotForCursor(options.Cursor, queryHash)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := filterTransactions(snapshot, options)
+	sortTransactions(txs, options.SortBy, options.Order)
+
+	if offset > len(txs) {
+		return nil, apiErrors.ErrInvalidMempoolCursor
+	}
+
+	end := offset + options.Size
+	if options.Size <= 0 || end > len(txs) {
+		end = len(txs)
+	}
+
+	page := &data.MempoolPage{
+		Transactions:      txs[offset:end],
+		NumTransactions:   len(txs),
+		SnapshotTimestamp: snapshot.timestamp.Unix(),
+	}
+	if end < len(txs) {
+		page.NextCursor = encodeCursor(snapshot.id, end, queryHash)
+	}
+
+	return page, nil
+}
+
+// GetMempoolStatistics returns aggregate statistics of the latest transactions pool snapshot
+func (me *mempoolExplorer) GetMempoolStatistics() (*data.MempoolStatistics, error) {
+	snapshot, err := me.getLatestSnapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &data.MempoolStatistics{
+		NumTransactions:      len(snapshot.transactions),
+		TransactionsPerShard: make(map[uint32]int),
+		TransactionsPerType:  make(map[string]int),
+		SnapshotTimestamp:    snapshot.timestamp.Unix(),
+	}
+
+	gasPrices := make([]uint64, 0, len(snapshot.transactions))
+	txsPerSender := make(map[string]int)
+	for _, tx := range snapshot.transactions {
+		stats.TransactionsPerShard[tx.ShardID]++
+		stats.TransactionsPerType[tx.Type]++
+
+		// smart contract results and rewards are not paid by users, so they would only skew the statistics
+		if tx.Type != data.MempoolTxTypeRegular {
+			continue
+		}
+
+		gasPrices = append(gasPrices, tx.GasPrice)
+		txsPerSender[tx.Sender]++
+	}
+
+	stats.GasPricePercentiles = computeGasPricePercentiles(gasPrices)
+	stats.TopSenders = computeTopSenders(txsPerSender)
+
+	return stats, nil
+}
+
+func (me *mempoolExplorer) getSnapshotForCursor(cursor string, queryHash string) (*poolSnapshot, int, error) {
+	if len(cursor) == 0 {
+		snapshot, err := me.getLatestSnapshot()
+		return snapshot, 0, err
+	}
+
+	snapshotID, offset, cursorQueryHash, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, 0, apiErrors.ErrInvalidMempoolCursor
+	}
+	if cursorQueryHash != queryHash {
+		return nil, 0, apiErrors.ErrMempoolCursorQueryMismatch
+	}
+
+	cachedSnapshot, found := me.snapshots.Get(strconv.FormatUint(snapshotID, 10))
+	snapshot, ok := cachedSnapshot.(*poolSnapshot)
+	if !found || !ok {
+		return nil, 0, apiErrors.ErrInvalidMempoolCursor
+	}
+
+	return snapshot, offset, nil
+}
+
+func (me *mempoolExplorer) getLatestSnapshot() (*poolSnapshot, error) {
+	me.mutSnapshot.Lock()
+	defer me.mutSnapshot.Unlock()
+
+	if me.lastSnapshot != nil && time.Since(me.lastSnapshot.timestamp) < me.snapshotValidity {
+		return me.lastSnapshot, nil
+	}
+
+	txs, err := me.fetchTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	firstSeen := make(map[string]int64, len(txs))
+	for _, tx := range txs {
+		timestamp, wasSeen := me.firstSeen[tx.Hash]
+		if !wasSeen {
+			timestamp = now.Unix()
+		}
+
+		tx.FirstSeen = timestamp
+		firstSeen[tx.Hash] = timestamp
+	}
+	me.firstSeen = firstSeen
+
+	me.lastSnapshotID++
+	me.lastSnapshot = &poolSnapshot{
+		id:           me.lastSnapshotID,
+		timestamp:    now,
+		transactions: txs,
+	}
+	me.cacheSnapshot(me.lastSnapshot, now)
+
+	return me.lastSnapshot, nil
+}
+
+// cacheSnapshot keeps the snapshot for the cursors of its next pages. The oldest snapshots are dropped once they are no
+// longer valid for cursors or once the cached snapshots hold more than the maximum number of transactions, the latest
+// snapshot being always kept
+func (me *mempoolExplorer) cacheSnapshot(snapshot *poolSnapshot, now time.Time) {
+	me.snapshots.Put(strconv.FormatUint(snapshot.id, 10), snapshot)
+	me.cachedSnapshots = append(me.cachedSnapshots, snapshot)
+	me.numCachedTxs += len(snapshot.transactions)
+
+	for len(me.cachedSnapshots) > 1 {
+		oldest := me.cachedSnapshots[0]
+		isExpired := now.Sub(oldest.timestamp) > me.cursorValidity
+		if !isExpired && me.numCachedTxs <= me.maxCachedTxs {
+			return
+		}
+
+		me.snapshots.Remove(strconv.FormatUint(oldest.id, 10))
+		me.numCachedTxs -= len(oldest.transactions)
+		me.cachedSnapshots = me.cachedSnapshots[1:]
+	}
+}
+
+func (me *mempoolExplorer) fetchTransactions() ([]*data.MempoolTransaction, error) {
+	txs := make([]*data.MempoolTransaction, 0)
+	seenHashes := make(map[string]struct{})
+	for _, shardID := range me.shardIDsProvider.GetShardIDs() {
+		txPool, err := me.poolProvider.GetTransactionsPoolForShard(shardID, poolFields)
+		if err == apiErrors.ErrOperationNotAllowed {
+			return nil, err
+		}
+		if err != nil {
+			// a snapshot missing the transactions of a shard would be served as complete, so it is not created at all
+			return nil, fmt.Errorf("%w, shard %d: %v", ErrIncompletePoolSnapshot, shardID, err)
+		}
+
+		txs = me.appendTransactions(txs, seenHashes, txPool.RegularTransactions, data.MempoolTxTypeRegular, shardID)
+		txs = me.appendTransactions(txs, seenHashes, txPool.SmartContractResults, data.MempoolTxTypeSmartContractResult, shardID)
+		txs = me.appendTransactions(txs, seenHashes, txPool.Rewards, data.MempoolTxTypeReward, shardID)
+	}
+
+	return txs, nil
+}
+
+// appendTransactions converts and appends the provided pool transactions, skipping the ones already found in the
+// pool of another shard, such as the cross-shard transactions
+func (me *mempoolExplorer) appendTransactions(
+	txs []*data.MempoolTransaction,
+	seenHashes map[string]struct{},
+	wrappedTxs []data.WrappedTransaction,
+	txType string,
+	shardID uint32,
+) []*data.MempoolTransaction {
+	for _, wrappedTx := range wrappedTxs {
+		tx := me.convertTransaction(wrappedTx, txType, shardID)
+		_, isDuplicated := seenHashes[tx.Hash]
+		if isDuplicated {
+			continue
+		}
+
+		seenHashes[tx.Hash] = struct{}{}
+		txs = append(txs, tx)
+	}
+
+	return txs
+}
+
+func (me *mempoolExplorer) convertTransaction(wrappedTx data.WrappedTransaction, txType string, shardID uint32) *data.MempoolTransaction {
+	tx := &data.MempoolTransaction{
+		Type:     txType,
+		ShardID:  shardID,
+		Hash:     getStringField(wrappedTx, hashField),
+		Nonce:    wrappedTx.GetUint64Field(nonceField),
+		Sender:   getStringField(wrappedTx, senderField),
+		Receiver: getStringField(wrappedTx, receiverField),
+		Value:    getStringField(wrappedTx, valueField),
+		GasPrice: wrappedTx.GetUint64Field(gasPriceField),
+		GasLimit: wrappedTx.GetUint64Field(gasLimitField),
+		Data:     getBytesField(wrappedTx, dataField),
+	}
+
+	decoded := me.dataFieldDecoder.Decode(tx.Data, tx.Sender, tx.Receiver)
+	if decoded != nil {
+		tx.Operation = decoded.Operation
+		tx.Function = decoded.Function
+	}
+
+	return tx
+}
+
+func getStringField(wrappedTx data.WrappedTransaction, field string) string {
+	value, _ := wrappedTx.TxFields[field].(string)
+	return value
+}
+
+// getBytesField returns the bytes of a field which the observers marshal as base64
+func getBytesField(wrappedTx data.WrappedTransaction, field string) []byte {
+	value := getStringField(wrappedTx, field)
+	if len(value) == 0 {
+		return nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return []byte(value)
+	}
+
+	return decoded
+}
+
+func filterTransactions(snapshot *poolSnapshot, options common.MempoolQueryOptions) []*data.MempoolTransaction {
+	snapshotTimestamp := snapshot.timestamp.Unix()
+	minAge := int64(options.MinAge.Seconds())
+	maxAge := int64(options.MaxAge.Seconds())
+
+	txs := make([]*data.MempoolTransaction, 0, len(snapshot.transactions))
+	for _, tx := range snapshot.transactions {
+		if len(options.Receiver) > 0 && tx.Receiver != options.Receiver {
+			continue
+		}
+		if len(options.Function) > 0 && tx.Function != options.Function && tx.Operation != options.Function {
+			continue
+		}
+		if tx.GasPrice < options.MinGasPrice {
+			continue
+		}
+		if options.ShardID.HasValue && tx.ShardID != options.ShardID.Value {
+			continue
+		}
+
+		// the age is relative to the snapshot, so that it does not change between the pages of the same snapshot
+		age := snapshotTimestamp - tx.FirstSeen
+		if minAge > 0 && age < minAge {
+			continue
+		}
+		if maxAge > 0 && age > maxAge {
+			continue
+		}
+
+		txs = append(txs, tx)
+	}
+
+	return txs
+}
+
+func sortTransactions(txs []*data.MempoolTransaction, sortBy string, order string) {
+	getSortKey := func(tx *data.MempoolTransaction) uint64 {
+		return tx.GasPrice
+	}
+	if sortBy == common.MempoolSortByNonce {
+		getSortKey = func(tx *data.MempoolTransaction) uint64 {
+			return tx.Nonce
+		}
+	}
+
+	isAscending := order == common.OrderAscending
+	sort.Slice(txs, func(i, j int) bool {
+		keyI, keyJ := getSortKey(txs[i]), getSortKey(txs[j])
+		if keyI != keyJ {
+			return (keyI < keyJ) == isAscending
+		}
+
+		// the hash breaks the ties, so that the order is the same for all the pages of a snapshot
+		return txs[i].Hash < txs[j].Hash
+	})
+}
+
+func computeGasPricePercentiles(gasPrices []uint64) data.MempoolGasPricePercentiles {
+	if len(gasPrices) == 0 {
+		return data.MempoolGasPricePercentiles{}
+	}
+
+	sort.Slice(gasPrices, func(i, j int) bool {
+		return gasPrices[i] < gasPrices[j]
+	})
+
+	return data.MempoolGasPricePercentiles{
+		Min: gasPrices[0],
+		P25: getPercentile(gasPrices, 25),
+		P50: getPercentile(gasPrices, 50),
+		P75: getPercentile(gasPrices, 75),
+		P90: getPercentile(gasPrices, 90),
+		Max: gasPrices[len(gasPrices)-1],
+	}
+}
+
+// getPercentile returns the nearest-rank percentile of the provided sorted values
+func getPercentile(sortedValues []uint64, percentile float64) uint64 {
+	rank := int(math.Ceil(percentile / 100 * float64(len(sortedValues))))
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sortedValues[rank-1]
+}
+
+func computeTopSenders(txsPerSender map[string]int) []*data.MempoolSenderStatistics {
+	senders := make([]*data.MempoolSenderStatistics, 0, len(txsPerSender))
+	for address, numTxs := range txsPerSender {
+		senders = append(senders, &data.MempoolSenderStatistics{
+			Address:         address,
+			NumTransactions: numTxs,
+		})
+	}
+
+	sort.Slice(senders, func(i, j int) bool {
+		if senders[i].NumTransactions != senders[j].NumTransactions {
+			return senders[i].NumTransactions > senders[j].NumTransactions
+		}
+
+		return senders[i].Address < senders[j].Address
+	})
+
+	if len(senders) > numTopSenders {
+		senders = senders[:numTopSenders]
+	}
+
+	return senders
+}
+
+// computeQueryHash returns a hash of the filters and of the sort order, which define the transactions of a snapshot
+// and their positions. The page size is not included, as it does not change the positions
+func computeQueryHash(options common.MempoolQueryOptions) string {
+	query := fmt.Sprintf("%s|%s|%d|%v|%d|%d|%d|%s|%s",
+		options.Receiver,
+		options.Function,
+		options.MinGasPrice,
+		options.ShardID.HasValue,
+		options.ShardID.Value,
+		options.MinAge,
+		options.MaxAge,
+		options.SortBy,
+		options.Order,
+	)
+
+	hasher := fnv.New64a()
+	_, _ = hasher.Write([]byte(query))
+
+	return strconv.FormatUint(hasher.Sum64(), 16)
+}
+
+func encodeCursor(snapshotID uint64, offset int, queryHash string) string {
+	cursor := fmt.Sprintf("%d%s%d%s%s", snapshotID, cursorSeparator, offset, cursorSeparator, queryHash)
+	return base64.RawURLEncoding.EncodeToString([]byte(cursor))
+}
+
+func decodeCursor(cursor string) (uint64, int, string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, 0, "", err
+	}
+
+	parts := strings.Split(string(decoded), cursorSeparator)
+	if len(parts) != 3 {
+		return 0, 0, "", apiErrors.ErrInvalidMempoolCursor
+	}
+
+	snapshotID, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, "", err
+	}
+
+	offset, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return 0, 0, "", err
+	}
+
+	return snapshotID, int(offset), parts[2], nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (me *mempoolExplorer) IsInterfaceNil() bool {
+	return me == nil
+}
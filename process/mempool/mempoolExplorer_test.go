@@ -0,0 +1,463 @@
+package mempool
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type poolProviderStub struct {
+	getTransactionsPoolForShardCalled func(shardID uint32, fields string) (*data.TransactionsPool, error)
+}
+
+func (stub *poolProviderStub) GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+	if stub.getTransactionsPoolForShardCalled != nil {
+		return stub.getTransactionsPoolForShardCalled(shardID, fields)
+	}
+
+	return &data.TransactionsPool{}, nil
+}
+
+type dataFieldDecoderStub struct{}
+
+func (stub *dataFieldDecoderStub) Decode(dataField []byte, _ string, _ string) *data.DecodedTransactionData {
+	if len(dataField) == 0 {
+		return nil
+	}
+
+	return &data.DecodedTransactionData{
+		Operation: "scCall",
+		Function:  string(dataField),
+	}
+}
+
+func createWrappedTx(hash string, sender string, nonce uint64, gasPrice uint64, dataField string) data.WrappedTransaction {
+	return data.WrappedTransaction{
+		TxFields: map[string]interface{}{
+			"hash":     hash,
+			"sender":   sender,
+			"receiver": "erd1receiver",
+			"nonce":    float64(nonce),
+			"gasPrice": float64(gasPrice),
+			"gasLimit": float64(50000),
+			"data":     base64.StdEncoding.EncodeToString([]byte(dataField)),
+		},
+	}
+}
+
+func createMockArgs(pools map[uint32]*data.TransactionsPool) ArgsMempoolExplorer {
+	return ArgsMempoolExplorer{
+		PoolProvider: &poolProviderStub{
+			getTransactionsPoolForShardCalled: func(shardID uint32, fields string) (*data.TransactionsPool, error) {
+				pool, found := pools[shardID]
+				if !found {
+					return &data.TransactionsPool{}, nil
+				}
+
+				return pool, nil
+			},
+		},
+		ShardIDsProvider: &mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, 1, core.MetachainShardId}
+			},
+		},
+		DataFieldDecoder:      &dataFieldDecoderStub{},
+		SnapshotValidity:      time.Minute,
+		CursorValidity:        time.Minute,
+		MaxCachedTransactions: 1000,
+	}
+}
+
+func createTestPools() map[uint32]*data.TransactionsPool {
+	return map[uint32]*data.TransactionsPool{
+		0: {
+			RegularTransactions: []data.WrappedTransaction{
+				createWrappedTx("h1", "alice", 1, 1000000000, "swap"),
+				createWrappedTx("h2", "alice", 2, 2000000000, ""),
+				createWrappedTx("h3", "bob", 7, 1500000000, "stake"),
+			},
+			SmartContractResults: []data.WrappedTransaction{
+				createWrappedTx("h4", "contract", 0, 1000000000, "callBack"),
+			},
+		},
+		1: {
+			RegularTransactions: []data.WrappedTransaction{
+				createWrappedTx("h5", "carol", 3, 3000000000, "swap"),
+				// cross-shard transaction, already found in the pool of shard 0
+				createWrappedTx("h3", "bob", 7, 1500000000, "stake"),
+			},
+			Rewards: []data.WrappedTransaction{
+				createWrappedTx("h6", "", 0, 0, ""),
+			},
+		},
+	}
+}
+
+func getHashes(txs []*data.MempoolTransaction) []string {
+	hashes := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		hashes = append(hashes, tx.Hash)
+	}
+
+	return hashes
+}
+
+func TestNewMempoolExplorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pool provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(nil)
+		args.PoolProvider = nil
+		explorer, err := NewMempoolExplorer(args)
+		require.Nil(t, explorer)
+		require.Equal(t, ErrNilTransactionsPoolProvider, err)
+	})
+	t.Run("nil shard IDs provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(nil)
+		args.ShardIDsProvider = nil
+		explorer, err := NewMempoolExplorer(args)
+		require.Nil(t, explorer)
+		require.Equal(t, ErrNilShardIDsProvider, err)
+	})
+	t.Run("nil data field decoder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(nil)
+		args.DataFieldDecoder = nil
+		explorer, err := NewMempoolExplorer(args)
+		require.Nil(t, explorer)
+		require.Equal(t, ErrNilDataFieldDecoder, err)
+	})
+	t.Run("invalid snapshot validity should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(nil)
+		args.SnapshotValidity = 0
+		explorer, err := NewMempoolExplorer(args)
+		require.Nil(t, explorer)
+		require.Equal(t, ErrInvalidSnapshotValidity, err)
+	})
+	t.Run("cursor validity lower than snapshot validity should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(nil)
+		args.CursorValidity = time.Second
+		explorer, err := NewMempoolExplorer(args)
+		require.Nil(t, explorer)
+		require.Equal(t, ErrInvalidCursorValidity, err)
+	})
+	t.Run("invalid max cached transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(nil)
+		args.MaxCachedTransactions = 0
+		explorer, err := NewMempoolExplorer(args)
+		require.Nil(t, explorer)
+		require.Equal(t, ErrInvalidMaxCachedTransactions, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		explorer, err := NewMempoolExplorer(createMockArgs(nil))
+		require.Nil(t, err)
+		require.False(t, explorer.IsInterfaceNil())
+	})
+}
+
+func TestMempoolExplorer_GetMempoolPage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pool fetch not allowed should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(nil)
+		args.PoolProvider = &poolProviderStub{
+			getTransactionsPoolForShardCalled: func(shardID uint32, fields string) (*data.TransactionsPool, error) {
+				return nil, apiErrors.ErrOperationNotAllowed
+			},
+		}
+		explorer, _ := NewMempoolExplorer(args)
+
+		page, err := explorer.GetMempoolPage(common.MempoolQueryOptions{})
+		require.Nil(t, page)
+		require.Equal(t, apiErrors.ErrOperationNotAllowed, err)
+	})
+	t.Run("should merge the shards and sort by gas price", func(t *testing.T) {
+		t.Parallel()
+
+		explorer, _ := NewMempoolExplorer(createMockArgs(createTestPools()))
+
+		page, err := explorer.GetMempoolPage(common.MempoolQueryOptions{
+			SortBy: common.MempoolSortByGasPrice,
+			Order:  common.OrderDescending,
+		})
+		require.Nil(t, err)
+		require.Equal(t, 6, page.NumTransactions)
+		require.Empty(t, page.NextCursor)
+		require.Equal(t, []string{"h5", "h2", "h3", "h1", "h4", "h6"}, getHashes(page.Transactions))
+
+		tx := page.Transactions[0]
+		require.Equal(t, data.MempoolTxTypeRegular, tx.Type)
+		require.Equal(t, uint32(1), tx.ShardID)
+		require.Equal(t, uint64(3), tx.Nonce)
+		require.Equal(t, "carol", tx.Sender)
+		require.Equal(t, []byte("swap"), tx.Data)
+		require.Equal(t, "swap", tx.Function)
+		require.Equal(t, uint32(0), page.Transactions[2].ShardID)
+	})
+	t.Run("should apply the filters", func(t *testing.T) {
+		t.Parallel()
+
+		explorer, _ := NewMempoolExplorer(createMockArgs(createTestPools()))
+
+		page, err := explorer.GetMempoolPage(common.MempoolQueryOptions{
+			Function: "swap",
+			SortBy:   common.MempoolSortByNonce,
+			Order:    common.OrderAscending,
+		})
+		require.Nil(t, err)
+		require.Equal(t, []string{"h1", "h5"}, getHashes(page.Transactions))
+
+		page, err = explorer.GetMempoolPage(common.MempoolQueryOptions{
+			MinGasPrice: 1500000000,
+			ShardID:     core.OptionalUint32{Value: 0, HasValue: true},
+			Order:       common.OrderAscending,
+		})
+		require.Nil(t, err)
+		require.Equal(t, []string{"h3", "h2"}, getHashes(page.Transactions))
+
+		page, err = explorer.GetMempoolPage(common.MempoolQueryOptions{
+			MinAge: time.Hour,
+		})
+		require.Nil(t, err)
+		require.Empty(t, page.Transactions)
+	})
+	t.Run("pages should be served from the same snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		numFetches := 0
+		pools := createTestPools()
+		args := createMockArgs(pools)
+		args.SnapshotValidity = time.Millisecond
+		args.PoolProvider = &poolProviderStub{
+			getTransactionsPoolForShardCalled: func(shardID uint32, fields string) (*data.TransactionsPool, error) {
+				if shardID == 0 {
+					numFetches++
+				}
+				pool, found := pools[shardID]
+				if !found || numFetches > 1 {
+					return &data.TransactionsPool{}, nil
+				}
+
+				return pool, nil
+			},
+		}
+		explorer, _ := NewMempoolExplorer(args)
+
+		options := common.MempoolQueryOptions{
+			Size:   4,
+			SortBy: common.MempoolSortByGasPrice,
+			Order:  common.OrderDescending,
+		}
+		page, err := explorer.GetMempoolPage(options)
+		require.Nil(t, err)
+		require.Equal(t, []string{"h5", "h2", "h3", "h1"}, getHashes(page.Transactions))
+		require.NotEmpty(t, page.NextCursor)
+
+		time.Sleep(5 * time.Millisecond)
+
+		options.Cursor = page.NextCursor
+		page, err = explorer.GetMempoolPage(options)
+		require.Nil(t, err)
+		require.Equal(t, []string{"h4", "h6"}, getHashes(page.Transactions))
+		require.Empty(t, page.NextCursor)
+		require.Equal(t, 1, numFetches)
+
+		page, err = explorer.GetMempoolPage(common.MempoolQueryOptions{})
+		require.Nil(t, err)
+		require.Empty(t, page.Transactions)
+		require.Equal(t, 2, numFetches)
+	})
+	t.Run("invalid cursor should error", func(t *testing.T) {
+		t.Parallel()
+
+		explorer, _ := NewMempoolExplorer(createMockArgs(createTestPools()))
+
+		page, err := explorer.GetMempoolPage(common.MempoolQueryOptions{Cursor: "not a cursor"})
+		require.Nil(t, page)
+		require.Equal(t, apiErrors.ErrInvalidMempoolCursor, err)
+
+		options := common.MempoolQueryOptions{}
+		options.Cursor = encodeCursor(100, 0, computeQueryHash(options))
+		page, err = explorer.GetMempoolPage(options)
+		require.Nil(t, page)
+		require.Equal(t, apiErrors.ErrInvalidMempoolCursor, err)
+	})
+	t.Run("cursor used with other filters or sort order should error", func(t *testing.T) {
+		t.Parallel()
+
+		explorer, _ := NewMempoolExplorer(createMockArgs(createTestPools()))
+
+		options := common.MempoolQueryOptions{
+			Size:   2,
+			SortBy: common.MempoolSortByGasPrice,
+			Order:  common.OrderDescending,
+		}
+		page, err := explorer.GetMempoolPage(options)
+		require.Nil(t, err)
+		require.NotEmpty(t, page.NextCursor)
+
+		options.Cursor = page.NextCursor
+		options.Order = common.OrderAscending
+		page, err = explorer.GetMempoolPage(options)
+		require.Nil(t, page)
+		require.Equal(t, apiErrors.ErrMempoolCursorQueryMismatch, err)
+
+		options.Order = common.OrderDescending
+		options.Function = "swap"
+		page, err = explorer.GetMempoolPage(options)
+		require.Nil(t, page)
+		require.Equal(t, apiErrors.ErrMempoolCursorQueryMismatch, err)
+
+		options.Function = ""
+		options.Size = 3
+		page, err = explorer.GetMempoolPage(options)
+		require.Nil(t, err)
+		require.Equal(t, []string{"h3", "h1", "h4"}, getHashes(page.Transactions))
+	})
+	t.Run("oldest snapshots should be dropped over the max cached transactions", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(createTestPools())
+		args.SnapshotValidity = time.Millisecond
+		args.MaxCachedTransactions = 6
+		explorer, _ := NewMempoolExplorer(args)
+
+		options := common.MempoolQueryOptions{Size: 4}
+		firstPage, err := explorer.GetMempoolPage(options)
+		require.Nil(t, err)
+		require.NotEmpty(t, firstPage.NextCursor)
+
+		time.Sleep(5 * time.Millisecond)
+
+		secondPage, err := explorer.GetMempoolPage(options)
+		require.Nil(t, err)
+		require.NotEmpty(t, secondPage.NextCursor)
+
+		options.Cursor = firstPage.NextCursor
+		page, err := explorer.GetMempoolPage(options)
+		require.Nil(t, page)
+		require.Equal(t, apiErrors.ErrInvalidMempoolCursor, err)
+
+		options.Cursor = secondPage.NextCursor
+		page, err = explorer.GetMempoolPage(options)
+		require.Nil(t, err)
+		require.Len(t, page.Transactions, 2)
+	})
+}
+
+func TestMempoolExplorer_GetMempoolStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pool fetch error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(nil)
+		args.PoolProvider = &poolProviderStub{
+			getTransactionsPoolForShardCalled: func(shardID uint32, fields string) (*data.TransactionsPool, error) {
+				return nil, apiErrors.ErrOperationNotAllowed
+			},
+		}
+		explorer, _ := NewMempoolExplorer(args)
+
+		stats, err := explorer.GetMempoolStatistics()
+		require.Nil(t, stats)
+		require.Equal(t, apiErrors.ErrOperationNotAllowed, err)
+	})
+	t.Run("unavailable shard should error and the partial snapshot should not be cached", func(t *testing.T) {
+		t.Parallel()
+
+		pools := createTestPools()
+		isShardOffline := true
+		args := createMockArgs(nil)
+		args.PoolProvider = &poolProviderStub{
+			getTransactionsPoolForShardCalled: func(shardID uint32, fields string) (*data.TransactionsPool, error) {
+				if shardID == 1 && isShardOffline {
+					return nil, errors.New("observers offline")
+				}
+				pool, found := pools[shardID]
+				if !found {
+					return &data.TransactionsPool{}, nil
+				}
+
+				return pool, nil
+			},
+		}
+		explorer, _ := NewMempoolExplorer(args)
+
+		stats, err := explorer.GetMempoolStatistics()
+		require.Nil(t, stats)
+		require.True(t, errors.Is(err, ErrIncompletePoolSnapshot))
+
+		isShardOffline = false
+		stats, err = explorer.GetMempoolStatistics()
+		require.Nil(t, err)
+		require.Equal(t, 6, stats.NumTransactions)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		explorer, _ := NewMempoolExplorer(createMockArgs(createTestPools()))
+
+		stats, err := explorer.GetMempoolStatistics()
+		require.Nil(t, err)
+		require.Equal(t, 6, stats.NumTransactions)
+		require.Equal(t, map[uint32]int{0: 4, 1: 2}, stats.TransactionsPerShard)
+		require.Equal(t, map[string]int{
+			data.MempoolTxTypeRegular:             4,
+			data.MempoolTxTypeSmartContractResult: 1,
+			data.MempoolTxTypeReward:              1,
+		}, stats.TransactionsPerType)
+		require.Equal(t, data.MempoolGasPricePercentiles{
+			Min: 1000000000,
+			P25: 1000000000,
+			P50: 1500000000,
+			P75: 2000000000,
+			P90: 3000000000,
+			Max: 3000000000,
+		}, stats.GasPricePercentiles)
+		require.Equal(t, []*data.MempoolSenderStatistics{
+			{Address: "alice", NumTransactions: 2},
+			{Address: "bob", NumTransactions: 1},
+			{Address: "carol", NumTransactions: 1},
+		}, stats.TopSenders)
+	})
+}
+
+func TestComputeTopSenders_ShouldKeepTheFirstSenders(t *testing.T) {
+	t.Parallel()
+
+	txsPerSender := make(map[string]int)
+	for i := 0; i < numTopSenders+5; i++ {
+		txsPerSender[fmt.Sprintf("sender%02d", i)] = i
+	}
+
+	topSenders := computeTopSenders(txsPerSender)
+	require.Equal(t, numTopSenders, len(topSenders))
+	require.Equal(t, "sender14", topSenders[0].Address)
+	require.Equal(t, "sender05", topSenders[numTopSenders-1].Address)
+}
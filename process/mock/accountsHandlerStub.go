@@ -0,0 +1,20 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// AccountsHandlerStub -
+type AccountsHandlerStub struct {
+	GetAccountCalled func(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+}
+
+// GetAccount -
+func (stub *AccountsHandlerStub) GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+	if stub.GetAccountCalled != nil {
+		return stub.GetAccountCalled(address, options)
+	}
+
+	return &data.AccountModel{}, nil
+}
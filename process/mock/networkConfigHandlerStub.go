@@ -0,0 +1,17 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// NetworkConfigHandlerStub -
+type NetworkConfigHandlerStub struct {
+	GetNetworkConfigCalled func() (*data.NetworkConfig, error)
+}
+
+// GetNetworkConfig -
+func (stub *NetworkConfigHandlerStub) GetNetworkConfig() (*data.NetworkConfig, error) {
+	if stub.GetNetworkConfigCalled != nil {
+		return stub.GetNetworkConfigCalled()
+	}
+
+	return &data.NetworkConfig{}, nil
+}
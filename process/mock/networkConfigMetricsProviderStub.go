@@ -0,0 +1,17 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// NetworkConfigMetricsProviderStub -
+type NetworkConfigMetricsProviderStub struct {
+	GetNetworkConfigMetricsCalled func() (*data.GenericAPIResponse, error)
+}
+
+// GetNetworkConfigMetrics -
+func (stub *NetworkConfigMetricsProviderStub) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
+	if stub.GetNetworkConfigMetricsCalled != nil {
+		return stub.GetNetworkConfigMetricsCalled()
+	}
+
+	return &data.GenericAPIResponse{}, nil
+}
@@ -0,0 +1,20 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// NextNonceProviderStub -
+type NextNonceProviderStub struct {
+	GetNextNonceCalled func(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error)
+}
+
+// GetNextNonce -
+func (stub *NextNonceProviderStub) GetNextNonce(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error) {
+	if stub.GetNextNonceCalled != nil {
+		return stub.GetNextNonceCalled(address, options)
+	}
+
+	return &data.NextNonceResponseData{}, nil
+}
@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"io"
+
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/config"
@@ -22,6 +24,7 @@ type ProcessorStub struct {
 	GetShardIDsCalled                    func() []uint32
 	ComputeShardIdCalled                 func(addressBuff []byte) (uint32, error)
 	CallGetRestEndPointCalled            func(address string, path string, value interface{}) (int, error)
+	CallGetRestEndPointStreamCalled      func(address string, path string, handler func(body io.Reader) error) (int, error)
 	CallPostRestEndPointCalled           func(address string, path string, data interface{}, response interface{}) (int, error)
 	GetShardCoordinatorCalled            func() common.Coordinator
 	GetPubKeyConverterCalled             func() core.PubkeyConverter
@@ -101,6 +104,15 @@ func (ps *ProcessorStub) CallGetRestEndPoint(address string, path string, value
 	return 0, errNotImplemented
 }
 
+// CallGetRestEndPointStream will call the CallGetRestEndPointStreamCalled if not nil
+func (ps *ProcessorStub) CallGetRestEndPointStream(address string, path string, handler func(body io.Reader) error) (int, error) {
+	if ps.CallGetRestEndPointStreamCalled != nil {
+		return ps.CallGetRestEndPointStreamCalled(address, path, handler)
+	}
+
+	return 0, errNotImplemented
+}
+
 // CallPostRestEndPoint will call the CallPostRestEndPoint if not nil
 func (ps *ProcessorStub) CallPostRestEndPoint(address string, path string, data interface{}, response interface{}) (int, error) {
 	if ps.CallPostRestEndPointCalled != nil {
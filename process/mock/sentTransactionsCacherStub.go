@@ -0,0 +1,31 @@
+package mock
+
+// SentTransactionsCacherStub -
+type SentTransactionsCacherStub struct {
+	PutCalled         func(key string, value interface{})
+	PutIfAbsentCalled func(key string, value interface{}) (interface{}, bool)
+	RemoveCalled      func(key string)
+}
+
+// Put -
+func (stub *SentTransactionsCacherStub) Put(key string, value interface{}) {
+	if stub.PutCalled != nil {
+		stub.PutCalled(key, value)
+	}
+}
+
+// PutIfAbsent -
+func (stub *SentTransactionsCacherStub) PutIfAbsent(key string, value interface{}) (interface{}, bool) {
+	if stub.PutIfAbsentCalled != nil {
+		return stub.PutIfAbsentCalled(key, value)
+	}
+
+	return nil, false
+}
+
+// Remove -
+func (stub *SentTransactionsCacherStub) Remove(key string) {
+	if stub.RemoveCalled != nil {
+		stub.RemoveCalled(key)
+	}
+}
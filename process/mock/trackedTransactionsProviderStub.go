@@ -0,0 +1,17 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// TrackedTransactionsProviderStub -
+type TrackedTransactionsProviderStub struct {
+	GetTrackedTransactionCalled func(txHash string) (*data.TrackedTransaction, error)
+}
+
+// GetTrackedTransaction -
+func (stub *TrackedTransactionsProviderStub) GetTrackedTransaction(txHash string) (*data.TrackedTransaction, error) {
+	if stub.GetTrackedTransactionCalled != nil {
+		return stub.GetTrackedTransactionCalled(txHash)
+	}
+
+	return &data.TrackedTransaction{}, nil
+}
@@ -0,0 +1,17 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// TransactionHashComputerStub -
+type TransactionHashComputerStub struct {
+	ComputeTransactionHashCalled func(tx *data.Transaction) (string, error)
+}
+
+// ComputeTransactionHash -
+func (stub *TransactionHashComputerStub) ComputeTransactionHash(tx *data.Transaction) (string, error) {
+	if stub.ComputeTransactionHashCalled != nil {
+		return stub.ComputeTransactionHashCalled(tx)
+	}
+
+	return "", nil
+}
@@ -0,0 +1,17 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// TransactionSimulatorStub -
+type TransactionSimulatorStub struct {
+	SimulateTransactionCalled func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
+}
+
+// SimulateTransaction -
+func (stub *TransactionSimulatorStub) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+	if stub.SimulateTransactionCalled != nil {
+		return stub.SimulateTransactionCalled(tx, checkSignature)
+	}
+
+	return &data.GenericAPIResponse{}, nil
+}
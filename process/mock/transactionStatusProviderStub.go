@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// TransactionStatusProviderStub -
+type TransactionStatusProviderStub struct {
+	GetProcessedTransactionStatusCalled func(txHash string) (*data.ProcessStatusResponse, error)
+	GetTransactionCalled                func(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error)
+}
+
+// GetProcessedTransactionStatus -
+func (stub *TransactionStatusProviderStub) GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error) {
+	if stub.GetProcessedTransactionStatusCalled != nil {
+		return stub.GetProcessedTransactionStatusCalled(txHash)
+	}
+
+	return &data.ProcessStatusResponse{}, nil
+}
+
+// GetTransaction -
+func (stub *TransactionStatusProviderStub) GetTransaction(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error) {
+	if stub.GetTransactionCalled != nil {
+		return stub.GetTransactionCalled(txHash, withEvents)
+	}
+
+	return &transaction.ApiTransactionResult{}, nil
+}
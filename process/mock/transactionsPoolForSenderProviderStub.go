@@ -0,0 +1,17 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// TransactionsPoolForSenderProviderStub -
+type TransactionsPoolForSenderProviderStub struct {
+	GetTransactionsPoolForSenderCalled func(sender, fields string) (*data.TransactionsPoolForSender, error)
+}
+
+// GetTransactionsPoolForSender -
+func (stub *TransactionsPoolForSenderProviderStub) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
+	if stub.GetTransactionsPoolForSenderCalled != nil {
+		return stub.GetTransactionsPoolForSenderCalled(sender, fields)
+	}
+
+	return &data.TransactionsPoolForSender{}, nil
+}
@@ -0,0 +1,27 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// TransactionsPoolNonceHandlerStub -
+type TransactionsPoolNonceHandlerStub struct {
+	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*data.TransactionsPoolForSender, error)
+	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*data.TransactionsPoolNonceGaps, error)
+}
+
+// GetTransactionsPoolForSender -
+func (stub *TransactionsPoolNonceHandlerStub) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
+	if stub.GetTransactionsPoolForSenderCalled != nil {
+		return stub.GetTransactionsPoolForSenderCalled(sender, fields)
+	}
+
+	return &data.TransactionsPoolForSender{}, nil
+}
+
+// GetTransactionsPoolNonceGapsForSender -
+func (stub *TransactionsPoolNonceHandlerStub) GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
+	if stub.GetTransactionsPoolNonceGapsForSenderCalled != nil {
+		return stub.GetTransactionsPoolNonceGapsForSenderCalled(sender)
+	}
+
+	return &data.TransactionsPoolNonceGaps{}, nil
+}
@@ -0,0 +1,76 @@
+package process
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// NetworkConfigProvider fetches the network config from the observers and keeps it in memory for a configurable period
+type NetworkConfigProvider struct {
+	metricsProvider NetworkConfigMetricsProvider
+	cacheValidity   time.Duration
+
+	mutNetworkConfig     sync.Mutex
+	networkConfig        *data.NetworkConfig
+	lastNetworkConfigGet time.Time
+}
+
+// NewNetworkConfigProvider creates a new instance of NetworkConfigProvider
+func NewNetworkConfigProvider(
+	metricsProvider NetworkConfigMetricsProvider,
+	cacheValidity time.Duration,
+) (*NetworkConfigProvider, error) {
+	if metricsProvider == nil {
+		return nil, ErrNilNetworkConfigMetricsProvider
+	}
+	if cacheValidity <= 0 {
+		return nil, ErrInvalidCacheValidityDuration
+	}
+
+	return &NetworkConfigProvider{
+		metricsProvider: metricsProvider,
+		cacheValidity:   cacheValidity,
+	}, nil
+}
+
+// GetNetworkConfig returns the network config. The cached value is returned while it is still valid
+func (ncp *NetworkConfigProvider) GetNetworkConfig() (*data.NetworkConfig, error) {
+	ncp.mutNetworkConfig.Lock()
+	defer ncp.mutNetworkConfig.Unlock()
+
+	if ncp.networkConfig != nil && time.Since(ncp.lastNetworkConfigGet) < ncp.cacheValidity {
+		return ncp.networkConfig, nil
+	}
+
+	genericResponse, err := ncp.metricsProvider.GetNetworkConfigMetrics()
+	if err != nil {
+		return nil, err
+	}
+	if genericResponse == nil {
+		return nil, ErrNilNetworkConfig
+	}
+
+	networkConfigBytes, err := json.Marshal(&genericResponse.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	networkConfig := &data.NetworkConfig{}
+	err = json.Unmarshal(networkConfigBytes, networkConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	ncp.networkConfig = networkConfig
+	ncp.lastNetworkConfigGet = time.Now()
+
+	return networkConfig, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ncp *NetworkConfigProvider) IsInterfaceNil() bool {
+	return ncp == nil
+}
@@ -0,0 +1,104 @@
+package process_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createNetworkConfigMetricsProvider(numCalls *int) *mock.NetworkConfigMetricsProviderStub {
+	return &mock.NetworkConfigMetricsProviderStub{
+		GetNetworkConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+			*numCalls++
+
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"config": map[string]interface{}{
+						"erd_chain_id":       "T",
+						"erd_min_gas_price":  1000000000,
+						"erd_round_duration": 6000,
+					},
+				},
+			}, nil
+		},
+	}
+}
+
+func TestNewNetworkConfigProvider(t *testing.T) {
+	t.Parallel()
+
+	ncp, err := process.NewNetworkConfigProvider(nil, time.Second)
+	require.Nil(t, ncp)
+	require.Equal(t, process.ErrNilNetworkConfigMetricsProvider, err)
+
+	ncp, err = process.NewNetworkConfigProvider(&mock.NetworkConfigMetricsProviderStub{}, 0)
+	require.Nil(t, ncp)
+	require.Equal(t, process.ErrInvalidCacheValidityDuration, err)
+
+	ncp, err = process.NewNetworkConfigProvider(&mock.NetworkConfigMetricsProviderStub{}, time.Second)
+	require.NotNil(t, ncp)
+	require.NoError(t, err)
+}
+
+func TestNetworkConfigProvider_GetNetworkConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("metrics error should return it", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		ncp, _ := process.NewNetworkConfigProvider(&mock.NetworkConfigMetricsProviderStub{
+			GetNetworkConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}, time.Second)
+
+		networkConfig, err := ncp.GetNetworkConfig()
+		require.Nil(t, networkConfig)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("nil response should error", func(t *testing.T) {
+		t.Parallel()
+
+		ncp, _ := process.NewNetworkConfigProvider(&mock.NetworkConfigMetricsProviderStub{
+			GetNetworkConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+				return nil, nil
+			},
+		}, time.Second)
+
+		networkConfig, err := ncp.GetNetworkConfig()
+		require.Nil(t, networkConfig)
+		require.Equal(t, process.ErrNilNetworkConfig, err)
+	})
+	t.Run("should decode and cache the network config", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		ncp, _ := process.NewNetworkConfigProvider(createNetworkConfigMetricsProvider(&numCalls), time.Minute)
+
+		for i := 0; i < 3; i++ {
+			networkConfig, err := ncp.GetNetworkConfig()
+			require.NoError(t, err)
+			require.Equal(t, "T", networkConfig.Config.ChainID)
+			require.Equal(t, uint64(1000000000), networkConfig.Config.MinGasPrice)
+			require.Equal(t, uint64(6000), networkConfig.Config.RoundDuration)
+		}
+		require.Equal(t, 1, numCalls)
+	})
+	t.Run("expired cache should fetch again", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		ncp, _ := process.NewNetworkConfigProvider(createNetworkConfigMetricsProvider(&numCalls), time.Millisecond)
+
+		_, _ = ncp.GetNetworkConfig()
+		time.Sleep(5 * time.Millisecond)
+		_, _ = ncp.GetNetworkConfig()
+		require.Equal(t, 2, numCalls)
+	})
+}
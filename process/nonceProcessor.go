@@ -0,0 +1,279 @@
+package process
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const reservationTokenLength = 16
+
+type nonceReservation struct {
+	token     string
+	expiresAt time.Time
+}
+
+type reservationExpiry struct {
+	address   string
+	nonce     uint64
+	expiresAt time.Time
+}
+
+// NonceProcessor is able to recommend the nonce of the next transaction of an address
+type NonceProcessor struct {
+	accountsHandler           AccountsHandler
+	txPoolNonceHandler        TransactionsPoolNonceHandler
+	reservationDuration       time.Duration
+	maxReservationsPerAddress int
+
+	mutReservations sync.Mutex
+	reservations    map[string]map[uint64]*nonceReservation
+	expiryQueue     []*reservationExpiry
+}
+
+// NewNonceProcessor creates a new instance of NonceProcessor
+func NewNonceProcessor(
+	accountsHandler AccountsHandler,
+	txPoolNonceHandler TransactionsPoolNonceHandler,
+	reservationDuration time.Duration,
+	maxReservationsPerAddress int,
+) (*NonceProcessor, error) {
+	if accountsHandler == nil {
+		return nil, ErrNilAccountsHandler
+	}
+	if txPoolNonceHandler == nil {
+		return nil, ErrNilTransactionsPoolNonceHandler
+	}
+	if reservationDuration <= 0 {
+		return nil, ErrInvalidNonceReservationDuration
+	}
+	if maxReservationsPerAddress <= 0 {
+		return nil, ErrInvalidMaxNonceReservations
+	}
+
+	return &NonceProcessor{
+		accountsHandler:           accountsHandler,
+		txPoolNonceHandler:        txPoolNonceHandler,
+		reservationDuration:       reservationDuration,
+		maxReservationsPerAddress: maxReservationsPerAddress,
+		reservations:              make(map[string]map[uint64]*nonceReservation),
+	}, nil
+}
+
+// GetNextNonce merges the account nonce with the sender's transactions from pool and returns the recommended nonce.
+// The first nonce missing from the pool is recommended when there are gaps. Nonces reserved by other callers are
+// skipped, while the ones reserved with the provided token can be used again. If reserve is set, the returned nonce
+// will be reserved for a short period, under the provided token or under a newly generated one
+func (np *NonceProcessor) GetNextNonce(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error) {
+	account, err := np.accountsHandler.GetAccount(address, common.AccountQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	lastPoolNonce, hasPoolTxs, err := np.getLastPoolNonce(address)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceGaps, err := np.txPoolNonceHandler.GetTransactionsPoolNonceGapsForSender(address)
+	if err != nil {
+		return nil, err
+	}
+
+	accountNonce := account.Account.Nonce
+	nextNonce := accountNonce
+	if hasPoolTxs && lastPoolNonce >= accountNonce {
+		nextNonce = lastPoolNonce + 1
+	}
+
+	response := &data.NextNonceResponseData{
+		AccountNonce: accountNonce,
+		NonceGaps:    make([]data.NonceGap, 0),
+	}
+	if nonceGaps != nil {
+		response.NonceGaps = append(response.NonceGaps, nonceGaps.Gaps...)
+	}
+
+	err = np.computeNonceAndReserve(address, nextNonce, options, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// getLastPoolNonce returns the highest nonce of the sender's transactions from pool and whether the sender has any.
+// The pool transactions are fetched instead of the last pool nonce, as the observers report 0 for an empty pool as well
+func (np *NonceProcessor) getLastPoolNonce(address string) (uint64, bool, error) {
+	txPool, err := np.txPoolNonceHandler.GetTransactionsPoolForSender(address, poolNonceField)
+	if err != nil {
+		return 0, false, err
+	}
+
+	lastPoolNonce := uint64(0)
+	for _, wrappedTx := range txPool.Transactions {
+		nonce := wrappedTx.GetUint64Field(poolNonceField)
+		if nonce > lastPoolNonce {
+			lastPoolNonce = nonce
+		}
+	}
+
+	return lastPoolNonce, len(txPool.Transactions) > 0, nil
+}
+
+// ReleaseNonceReservation removes the reservation of the given nonce, if it was made with the provided token
+func (np *NonceProcessor) ReleaseNonceReservation(address string, nonce uint64, token string) error {
+	np.mutReservations.Lock()
+	defer np.mutReservations.Unlock()
+
+	reservedNonces := np.reservations[address]
+	reservation, found := reservedNonces[nonce]
+	if !found || !reservation.isOwnedBy(token) || time.Now().After(reservation.expiresAt) {
+		return apiErrors.ErrNonceReservationNotFound
+	}
+
+	delete(reservedNonces, nonce)
+	if len(reservedNonces) == 0 {
+		delete(np.reservations, address)
+	}
+
+	return nil
+}
+
+func (np *NonceProcessor) computeNonceAndReserve(
+	address string,
+	nextNonce uint64,
+	options common.NextNonceOptions,
+	response *data.NextNonceResponseData,
+) error {
+	np.mutReservations.Lock()
+	defer np.mutReservations.Unlock()
+
+	now := time.Now()
+	np.removeExpiredReservations(now)
+
+	reservedNonces := np.reservations[address]
+	for nonce := range reservedNonces {
+		if nonce < response.AccountNonce {
+			delete(reservedNonces, nonce)
+		}
+	}
+
+	response.Nonce = findFreeNonce(reservedNonces, response.AccountNonce, nextNonce, response.NonceGaps, options.ReservationToken)
+	if !options.Reserve {
+		return nil
+	}
+
+	_, isOwnReservation := reservedNonces[response.Nonce]
+	if !isOwnReservation && len(reservedNonces) >= np.maxReservationsPerAddress {
+		return apiErrors.ErrTooManyNonceReservations
+	}
+
+	token := options.ReservationToken
+	if len(token) == 0 {
+		var err error
+		token, err = generateReservationToken()
+		if err != nil {
+			return err
+		}
+	}
+
+	if reservedNonces == nil {
+		reservedNonces = make(map[uint64]*nonceReservation)
+		np.reservations[address] = reservedNonces
+	}
+	expiresAt := now.Add(np.reservationDuration)
+	reservedNonces[response.Nonce] = &nonceReservation{
+		token:     token,
+		expiresAt: expiresAt,
+	}
+	np.expiryQueue = append(np.expiryQueue, &reservationExpiry{
+		address:   address,
+		nonce:     response.Nonce,
+		expiresAt: expiresAt,
+	})
+
+	response.Reserved = true
+	response.ReservationExpiresAt = expiresAt.Unix()
+	response.ReservationToken = token
+
+	return nil
+}
+
+// removeExpiredReservations visits only the expired reservations: all of them last for the same duration, so they
+// expire in the order they were made
+func (np *NonceProcessor) removeExpiredReservations(now time.Time) {
+	for len(np.expiryQueue) > 0 {
+		expiry := np.expiryQueue[0]
+		if !now.After(expiry.expiresAt) {
+			return
+		}
+
+		np.expiryQueue[0] = nil
+		np.expiryQueue = np.expiryQueue[1:]
+
+		reservedNonces := np.reservations[expiry.address]
+		reservation, found := reservedNonces[expiry.nonce]
+		// a reservation made again for the same nonce has a later entry in the queue
+		if found && reservation.expiresAt.Equal(expiry.expiresAt) {
+			delete(reservedNonces, expiry.nonce)
+		}
+		if len(reservedNonces) == 0 {
+			delete(np.reservations, expiry.address)
+		}
+	}
+}
+
+// findFreeNonce returns the first nonce missing from the pool, or the one following the pool nonces if there are no
+// gaps, which is not reserved by another caller
+func findFreeNonce(
+	reservedNonces map[uint64]*nonceReservation,
+	accountNonce uint64,
+	nextNonce uint64,
+	nonceGaps []data.NonceGap,
+	token string,
+) uint64 {
+	isFree := func(nonce uint64) bool {
+		reservation, isReserved := reservedNonces[nonce]
+		return !isReserved || reservation.isOwnedBy(token)
+	}
+
+	for _, gap := range nonceGaps {
+		firstNonce := gap.From
+		if firstNonce < accountNonce {
+			firstNonce = accountNonce
+		}
+
+		for nonce := firstNonce; nonce <= gap.To && nonce < nextNonce; nonce++ {
+			if isFree(nonce) {
+				return nonce
+			}
+		}
+	}
+
+	nonce := nextNonce
+	for !isFree(nonce) {
+		nonce++
+	}
+
+	return nonce
+}
+
+func (reservation *nonceReservation) isOwnedBy(token string) bool {
+	return len(token) > 0 && reservation.token == token
+}
+
+func generateReservationToken() (string, error) {
+	buff := make([]byte, reservationTokenLength)
+	_, err := rand.Read(buff)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buff), nil
+}
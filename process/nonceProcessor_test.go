@@ -0,0 +1,268 @@
+package process_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createAccountsHandlerWithNonce(nonce uint64) *mock.AccountsHandlerStub {
+	return &mock.AccountsHandlerStub{
+		GetAccountCalled: func(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+			return &data.AccountModel{Account: data.Account{Address: address, Nonce: nonce}}, nil
+		},
+	}
+}
+
+func createTxPoolForSenderWithNonces(nonces ...uint64) *data.TransactionsPoolForSender {
+	txPool := &data.TransactionsPoolForSender{
+		Transactions: make([]data.WrappedTransaction, 0, len(nonces)),
+	}
+	for _, nonce := range nonces {
+		txPool.Transactions = append(txPool.Transactions, data.WrappedTransaction{
+			TxFields: map[string]interface{}{"nonce": float64(nonce)},
+		})
+	}
+
+	return txPool
+}
+
+func TestNewNonceProcessor(t *testing.T) {
+	t.Parallel()
+
+	np, err := process.NewNonceProcessor(nil, &mock.TransactionsPoolNonceHandlerStub{}, time.Second, 10)
+	require.Nil(t, np)
+	require.Equal(t, process.ErrNilAccountsHandler, err)
+
+	np, err = process.NewNonceProcessor(&mock.AccountsHandlerStub{}, nil, time.Second, 10)
+	require.Nil(t, np)
+	require.Equal(t, process.ErrNilTransactionsPoolNonceHandler, err)
+
+	np, err = process.NewNonceProcessor(&mock.AccountsHandlerStub{}, &mock.TransactionsPoolNonceHandlerStub{}, 0, 10)
+	require.Nil(t, np)
+	require.Equal(t, process.ErrInvalidNonceReservationDuration, err)
+
+	np, err = process.NewNonceProcessor(&mock.AccountsHandlerStub{}, &mock.TransactionsPoolNonceHandlerStub{}, time.Second, 0)
+	require.Nil(t, np)
+	require.Equal(t, process.ErrInvalidMaxNonceReservations, err)
+
+	np, err = process.NewNonceProcessor(&mock.AccountsHandlerStub{}, &mock.TransactionsPoolNonceHandlerStub{}, time.Second, 10)
+	require.NotNil(t, np)
+	require.NoError(t, err)
+}
+
+func TestNonceProcessor_GetNextNonce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("account error should return it", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		np, _ := process.NewNonceProcessor(
+			&mock.AccountsHandlerStub{
+				GetAccountCalled: func(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+					return nil, expectedErr
+				},
+			},
+			&mock.TransactionsPoolNonceHandlerStub{},
+			time.Second,
+			10,
+		)
+
+		response, err := np.GetNextNonce("addr", common.NextNonceOptions{})
+		require.Nil(t, response)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("pool error should return it", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		np, _ := process.NewNonceProcessor(
+			createAccountsHandlerWithNonce(5),
+			&mock.TransactionsPoolNonceHandlerStub{
+				GetTransactionsPoolForSenderCalled: func(sender, fields string) (*data.TransactionsPoolForSender, error) {
+					return nil, expectedErr
+				},
+			},
+			time.Second,
+			10,
+		)
+
+		response, err := np.GetNextNonce("addr", common.NextNonceOptions{})
+		require.Nil(t, response)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("empty pool should recommend the account nonce", func(t *testing.T) {
+		t.Parallel()
+
+		np, _ := process.NewNonceProcessor(createAccountsHandlerWithNonce(5), &mock.TransactionsPoolNonceHandlerStub{}, time.Second, 10)
+
+		response, err := np.GetNextNonce("addr", common.NextNonceOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(5), response.Nonce)
+		assert.Equal(t, uint64(5), response.AccountNonce)
+		assert.Empty(t, response.NonceGaps)
+		assert.False(t, response.Reserved)
+		assert.Zero(t, response.ReservationExpiresAt)
+	})
+	t.Run("pending transactions in pool without gaps should be taken into account", func(t *testing.T) {
+		t.Parallel()
+
+		np, _ := process.NewNonceProcessor(
+			createAccountsHandlerWithNonce(5),
+			&mock.TransactionsPoolNonceHandlerStub{
+				GetTransactionsPoolForSenderCalled: func(sender, fields string) (*data.TransactionsPoolForSender, error) {
+					return createTxPoolForSenderWithNonces(5, 6, 7, 8, 9), nil
+				},
+			},
+			time.Second,
+			10,
+		)
+
+		response, err := np.GetNextNonce("addr", common.NextNonceOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(10), response.Nonce)
+		assert.Empty(t, response.NonceGaps)
+	})
+	t.Run("transaction with nonce 0 in pool should be taken into account", func(t *testing.T) {
+		t.Parallel()
+
+		np, _ := process.NewNonceProcessor(
+			createAccountsHandlerWithNonce(0),
+			&mock.TransactionsPoolNonceHandlerStub{
+				GetTransactionsPoolForSenderCalled: func(sender, fields string) (*data.TransactionsPoolForSender, error) {
+					assert.Equal(t, "nonce", fields)
+					return createTxPoolForSenderWithNonces(0), nil
+				},
+			},
+			time.Second,
+			10,
+		)
+
+		response, err := np.GetNextNonce("addr", common.NextNonceOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), response.Nonce)
+	})
+	t.Run("first gap nonce should be recommended", func(t *testing.T) {
+		t.Parallel()
+
+		gaps := []data.NonceGap{{From: 6, To: 7}}
+		np, _ := process.NewNonceProcessor(
+			createAccountsHandlerWithNonce(5),
+			&mock.TransactionsPoolNonceHandlerStub{
+				GetTransactionsPoolForSenderCalled: func(sender, fields string) (*data.TransactionsPoolForSender, error) {
+					return createTxPoolForSenderWithNonces(5, 8, 9), nil
+				},
+				GetTransactionsPoolNonceGapsForSenderCalled: func(sender string) (*data.TransactionsPoolNonceGaps, error) {
+					return &data.TransactionsPoolNonceGaps{Gaps: gaps}, nil
+				},
+			},
+			time.Second,
+			10,
+		)
+
+		response, err := np.GetNextNonce("addr", common.NextNonceOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(6), response.Nonce)
+		assert.Equal(t, gaps, response.NonceGaps)
+
+		response, _ = np.GetNextNonce("addr", common.NextNonceOptions{Reserve: true})
+		assert.Equal(t, uint64(6), response.Nonce)
+		response, _ = np.GetNextNonce("addr", common.NextNonceOptions{Reserve: true})
+		assert.Equal(t, uint64(7), response.Nonce)
+		response, _ = np.GetNextNonce("addr", common.NextNonceOptions{})
+		assert.Equal(t, uint64(10), response.Nonce)
+	})
+	t.Run("reservations should not collide and should expire", func(t *testing.T) {
+		t.Parallel()
+
+		reservationDuration := 100 * time.Millisecond
+		np, _ := process.NewNonceProcessor(createAccountsHandlerWithNonce(5), &mock.TransactionsPoolNonceHandlerStub{}, reservationDuration, 10)
+
+		response, _ := np.GetNextNonce("addr", common.NextNonceOptions{Reserve: true})
+		assert.Equal(t, uint64(5), response.Nonce)
+		assert.True(t, response.Reserved)
+		assert.NotZero(t, response.ReservationExpiresAt)
+
+		response, _ = np.GetNextNonce("addr", common.NextNonceOptions{Reserve: true})
+		assert.Equal(t, uint64(6), response.Nonce)
+
+		response, _ = np.GetNextNonce("addr", common.NextNonceOptions{})
+		assert.Equal(t, uint64(7), response.Nonce)
+
+		response, _ = np.GetNextNonce("another addr", common.NextNonceOptions{})
+		assert.Equal(t, uint64(5), response.Nonce)
+
+		time.Sleep(2 * reservationDuration)
+
+		response, _ = np.GetNextNonce("addr", common.NextNonceOptions{})
+		assert.Equal(t, uint64(5), response.Nonce)
+	})
+	t.Run("reservation should be usable only with its token", func(t *testing.T) {
+		t.Parallel()
+
+		np, _ := process.NewNonceProcessor(createAccountsHandlerWithNonce(5), &mock.TransactionsPoolNonceHandlerStub{}, time.Second, 10)
+
+		response, _ := np.GetNextNonce("addr", common.NextNonceOptions{Reserve: true})
+		assert.Equal(t, uint64(5), response.Nonce)
+		token := response.ReservationToken
+		assert.NotEmpty(t, token)
+
+		response, _ = np.GetNextNonce("addr", common.NextNonceOptions{ReservationToken: "other token"})
+		assert.Equal(t, uint64(6), response.Nonce)
+
+		response, _ = np.GetNextNonce("addr", common.NextNonceOptions{Reserve: true, ReservationToken: token})
+		assert.Equal(t, uint64(5), response.Nonce)
+		assert.Equal(t, token, response.ReservationToken)
+	})
+	t.Run("too many reservations should error", func(t *testing.T) {
+		t.Parallel()
+
+		np, _ := process.NewNonceProcessor(createAccountsHandlerWithNonce(5), &mock.TransactionsPoolNonceHandlerStub{}, time.Second, 2)
+
+		_, err := np.GetNextNonce("addr", common.NextNonceOptions{Reserve: true})
+		require.NoError(t, err)
+		_, err = np.GetNextNonce("addr", common.NextNonceOptions{Reserve: true})
+		require.NoError(t, err)
+
+		response, err := np.GetNextNonce("addr", common.NextNonceOptions{Reserve: true})
+		require.Nil(t, response)
+		require.Equal(t, apiErrors.ErrTooManyNonceReservations, err)
+
+		response, err = np.GetNextNonce("another addr", common.NextNonceOptions{Reserve: true})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(5), response.Nonce)
+	})
+}
+
+func TestNonceProcessor_ReleaseNonceReservation(t *testing.T) {
+	t.Parallel()
+
+	np, _ := process.NewNonceProcessor(createAccountsHandlerWithNonce(5), &mock.TransactionsPoolNonceHandlerStub{}, time.Second, 10)
+
+	response, _ := np.GetNextNonce("addr", common.NextNonceOptions{Reserve: true})
+	token := response.ReservationToken
+
+	err := np.ReleaseNonceReservation("addr", 6, token)
+	assert.Equal(t, apiErrors.ErrNonceReservationNotFound, err)
+
+	err = np.ReleaseNonceReservation("addr", 5, "other token")
+	assert.Equal(t, apiErrors.ErrNonceReservationNotFound, err)
+
+	response, _ = np.GetNextNonce("addr", common.NextNonceOptions{})
+	assert.Equal(t, uint64(6), response.Nonce)
+
+	err = np.ReleaseNonceReservation("addr", 5, token)
+	assert.NoError(t, err)
+
+	response, _ = np.GetNextNonce("addr", common.NextNonceOptions{})
+	assert.Equal(t, uint64(5), response.Nonce)
+}
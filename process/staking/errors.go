@@ -0,0 +1,24 @@
+package staking
+
+import "errors"
+
+// ErrNilSCQueryService signals that a nil smart contract query service has been provided
+var ErrNilSCQueryService = errors.New("nil smart contract query service")
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrInvalidProvidersCacheValidity signals that an invalid staking providers cache validity has been provided
+var ErrInvalidProvidersCacheValidity = errors.New("invalid staking providers cache validity")
+
+// ErrInvalidDelegationsCacheValidity signals that an invalid delegations cache validity has been provided
+var ErrInvalidDelegationsCacheValidity = errors.New("invalid delegations cache validity")
+
+// ErrInvalidMaxConcurrentQueries signals that an invalid maximum number of concurrent queries has been provided
+var ErrInvalidMaxConcurrentQueries = errors.New("invalid maximum number of concurrent queries")
+
+// ErrStakingQueryFailed signals that a query of a staking system smart contract did not succeed
+var ErrStakingQueryFailed = errors.New("staking system smart contract query failed")
+
+// ErrInvalidStakingData signals that a staking system smart contract returned data which cannot be decoded
+var ErrInvalidStakingData = errors.New("invalid staking data")
@@ -0,0 +1,16 @@
+package staking
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// SCQueryService defines what a component which executes vm-queries should do
+type SCQueryService interface {
+	ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+}
+
+type timedCacher interface {
+	Get(key string) (interface{}, bool)
+	Put(key string, value interface{})
+}
@@ -0,0 +1,458 @@
+package staking
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+)
+
+const (
+	stakingContractAddress           = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqllls0lczs7"
+	validatorContractAddress         = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqplllst77y4l"
+	delegationManagerContractAddress = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqylllslmq6y6"
+
+	totalStakedTopUpStakedBlsKeysFunc = "getTotalStakedTopUpStakedBlsKeys"
+	unStakedTokensListFunc            = "getUnStakedTokensList"
+	blsKeyStatusFunc                  = "getBLSKeyStatus"
+	allContractAddressesFunc          = "getAllContractAddresses"
+	userActiveStakeFunc               = "getUserActiveStake"
+	userUnStakedValueFunc             = "getUserUnStakedValue"
+	userUnBondableFunc                = "getUserUnBondable"
+	claimableRewardsFunc              = "getClaimableRewards"
+	userUnDelegatedListFunc           = "getUserUnDelegatedList"
+
+	vmOutputOkCode        = "ok"
+	vmOutputUserErrorCode = "user error"
+
+	providersCacheKey        = "providers"
+	numDirectStakeFixedItems = 3
+)
+
+var log = logger.GetOrCreate("process/staking")
+
+// ArgsStakingPositionProcessor holds the arguments needed for creating a new staking position processor
+type ArgsStakingPositionProcessor struct {
+	SCQueryService           SCQueryService
+	PubKeyConverter          core.PubkeyConverter
+	ProvidersCacheValidity   time.Duration
+	DelegationsCacheValidity time.Duration
+	MaxConcurrentQueries     int
+}
+
+type stakingPositionProcessor struct {
+	scQueryService       SCQueryService
+	pubKeyConverter      core.PubkeyConverter
+	maxConcurrentQueries int
+	providers            timedCacher
+	delegations          timedCacher
+}
+
+// NewStakingPositionProcessor creates a new instance of stakingPositionProcessor
+func NewStakingPositionProcessor(args ArgsStakingPositionProcessor) (*stakingPositionProcessor, error) {
+	if args.SCQueryService == nil {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if args.ProvidersCacheValidity <= 0 {
+		return nil, ErrInvalidProvidersCacheValidity
+	}
+	if args.DelegationsCacheValidity <= 0 {
+		return nil, ErrInvalidDelegationsCacheValidity
+	}
+	if args.MaxConcurrentQueries <= 0 {
+		return nil, ErrInvalidMaxConcurrentQueries
+	}
+
+	providers, err := cache.NewTimedMemoryCacher(args.ProvidersCacheValidity)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, err := cache.NewTimedMemoryCacher(args.DelegationsCacheValidity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stakingPositionProcessor{
+		scQueryService:       args.SCQueryService,
+		pubKeyConverter:      args.PubKeyConverter,
+		maxConcurrentQueries: args.MaxConcurrentQueries,
+		providers:            providers,
+		delegations:          delegations,
+	}, nil
+}
+
+// GetStakingPosition returns the direct stake of the provided address, together with its delegations to each of the
+// staking providers. A provider whose delegation could not be fetched is returned with the error, so that the other
+// providers are still returned
+func (spp *stakingPositionProcessor) GetStakingPosition(address string) (*data.StakingPosition, error) {
+	addressBytes, err := spp.pubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, &apiErrors.ErrInvalidRequest{
+			Message: apiErrors.ErrInvalidAddress.Error(),
+			Reason:  err.Error(),
+		}
+	}
+
+	// only the queries are throttled, so that the goroutines waiting for other queries never hold a slot
+	throttler := make(chan struct{}, spp.maxConcurrentQueries)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	var directStake *data.DirectStakePosition
+	var delegations []*data.DelegationPosition
+	var directStakeErr, delegationsErr error
+	go func() {
+		defer wg.Done()
+		directStake, directStakeErr = spp.getDirectStake(throttler, addressBytes)
+	}()
+	go func() {
+		defer wg.Done()
+		delegations, delegationsErr = spp.getDelegations(throttler, addressBytes)
+	}()
+
+	wg.Wait()
+
+	if directStakeErr != nil {
+		return nil, fmt.Errorf("%w while trying to get the direct stake", directStakeErr)
+	}
+	if delegationsErr != nil {
+		return nil, fmt.Errorf("%w while trying to get the delegations", delegationsErr)
+	}
+
+	return &data.StakingPosition{
+		Address:     address,
+		DirectStake: directStake,
+		Delegations: delegations,
+	}, nil
+}
+
+// getDirectStake returns the stake registered by the address in the validator contract, or nil if the address did
+// not stake directly
+func (spp *stakingPositionProcessor) getDirectStake(throttler chan struct{}, addressBytes []byte) (*data.DirectStakePosition, error) {
+	vmOutput, err := spp.executeQuery(throttler, validatorContractAddress, totalStakedTopUpStakedBlsKeysFunc, addressBytes)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode == vmOutputUserErrorCode {
+		return nil, nil
+	}
+	err = checkReturnCode(vmOutput)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmOutput.ReturnData) < numDirectStakeFixedItems {
+		return nil, fmt.Errorf("%w: expected at least %d values from %s, got %d",
+			ErrInvalidStakingData, numDirectStakeFixedItems, totalStakedTopUpStakedBlsKeysFunc, len(vmOutput.ReturnData))
+	}
+
+	directStake := &data.DirectStakePosition{
+		TopUp:       decodeAmount(vmOutput.ReturnData[0]),
+		TotalStaked: decodeAmount(vmOutput.ReturnData[1]),
+		Nodes:       make([]*data.StakedNode, 0, len(vmOutput.ReturnData)-numDirectStakeFixedItems),
+	}
+	for _, blsKey := range vmOutput.ReturnData[numDirectStakeFixedItems:] {
+		directStake.Nodes = append(directStake.Nodes, &data.StakedNode{BLSKey: hex.EncodeToString(blsKey)})
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(directStake.Nodes) + 1)
+
+	var unstakedErr error
+	go func() {
+		defer wg.Done()
+		directStake.Unstaked, unstakedErr = spp.getUnstakedAmounts(throttler, validatorContractAddress, unStakedTokensListFunc, addressBytes)
+	}()
+	for i, blsKey := range vmOutput.ReturnData[numDirectStakeFixedItems:] {
+		go func(node *data.StakedNode, blsKey []byte) {
+			defer wg.Done()
+
+			var errStatus error
+			node.Status, errStatus = spp.getNodeStatus(throttler, blsKey)
+			if errStatus != nil {
+				node.Error = errStatus.Error()
+			}
+		}(directStake.Nodes[i], blsKey)
+	}
+
+	wg.Wait()
+
+	if unstakedErr != nil {
+		return nil, unstakedErr
+	}
+
+	return directStake, nil
+}
+
+func (spp *stakingPositionProcessor) getNodeStatus(throttler chan struct{}, blsKey []byte) (string, error) {
+	vmOutput, err := spp.executeQueryAs(throttler, validatorContractAddress, stakingContractAddress, blsKeyStatusFunc, blsKey)
+	if err != nil {
+		return "", err
+	}
+	err = checkReturnCode(vmOutput)
+	if err != nil {
+		return "", err
+	}
+	if len(vmOutput.ReturnData) == 0 {
+		return "", fmt.Errorf("%w: no status returned by %s", ErrInvalidStakingData, blsKeyStatusFunc)
+	}
+
+	return string(vmOutput.ReturnData[0]), nil
+}
+
+// getDelegations returns the delegations of the address, sorted by provider. Since the providers do not index their
+// delegators, all of them are queried and the ones the address did not delegate to are left out. The delegations are
+// cached for a short period, unless fetching any of them failed
+func (spp *stakingPositionProcessor) getDelegations(throttler chan struct{}, addressBytes []byte) ([]*data.DelegationPosition, error) {
+	delegationsCacheKey := hex.EncodeToString(addressBytes)
+	cachedDelegations, found := spp.delegations.Get(delegationsCacheKey)
+	if found {
+		return cachedDelegations.([]*data.DelegationPosition), nil
+	}
+
+	providers, err := spp.getProviders(throttler)
+	if err != nil {
+		return nil, err
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(providers))
+
+	var mut sync.Mutex
+	delegations := make([]*data.DelegationPosition, 0)
+	for _, provider := range providers {
+		go func(provider string) {
+			defer wg.Done()
+
+			delegation, isDelegator := spp.getDelegation(throttler, provider, addressBytes)
+			if !isDelegator {
+				return
+			}
+
+			mut.Lock()
+			delegations = append(delegations, delegation)
+			mut.Unlock()
+		}(provider)
+	}
+
+	wg.Wait()
+
+	sort.Slice(delegations, func(i, j int) bool {
+		return delegations[i].Provider < delegations[j].Provider
+	})
+
+	if !hasDelegationErrors(delegations) {
+		spp.delegations.Put(delegationsCacheKey, delegations)
+	}
+
+	return delegations, nil
+}
+
+func hasDelegationErrors(delegations []*data.DelegationPosition) bool {
+	for _, delegation := range delegations {
+		if len(delegation.Error) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (spp *stakingPositionProcessor) getProviders(throttler chan struct{}) ([]string, error) {
+	cachedProviders, found := spp.providers.Get(providersCacheKey)
+	if found {
+		return cachedProviders.([]string), nil
+	}
+
+	vmOutput, err := spp.executeQueryAs(throttler, delegationManagerContractAddress, delegationManagerContractAddress, allContractAddressesFunc)
+	if err != nil {
+		return nil, err
+	}
+	err = checkReturnCode(vmOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := make([]string, 0, len(vmOutput.ReturnData))
+	for _, providerBytes := range vmOutput.ReturnData {
+		provider, errEncode := spp.pubKeyConverter.Encode(providerBytes)
+		if errEncode != nil {
+			return nil, fmt.Errorf("%w: %s for a provider address", ErrInvalidStakingData, errEncode.Error())
+		}
+
+		providers = append(providers, provider)
+	}
+
+	spp.providers.Put(providersCacheKey, providers)
+
+	return providers, nil
+}
+
+// getDelegation returns the delegation of the address to the provider and false if the address is not one of its
+// delegators. The errors are set on the returned delegation
+func (spp *stakingPositionProcessor) getDelegation(throttler chan struct{}, provider string, addressBytes []byte) (*data.DelegationPosition, bool) {
+	delegation := &data.DelegationPosition{
+		Provider: provider,
+	}
+
+	vmOutput, err := spp.executeQuery(throttler, provider, userActiveStakeFunc, addressBytes)
+	if err != nil {
+		delegation.Error = err.Error()
+		return delegation, true
+	}
+	if vmOutput.ReturnCode == vmOutputUserErrorCode {
+		return nil, false
+	}
+	err = checkReturnCode(vmOutput)
+	if err != nil {
+		delegation.Error = err.Error()
+		return delegation, true
+	}
+	delegation.ActiveStake = decodeFirstAmount(vmOutput.ReturnData)
+
+	amountsQueries := map[string]*string{
+		userUnStakedValueFunc: &delegation.UnstakedAmount,
+		userUnBondableFunc:    &delegation.UnbondableAmount,
+		claimableRewardsFunc:  &delegation.ClaimableRewards,
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(amountsQueries) + 1)
+
+	var mut sync.Mutex
+	var queriesErr error
+	setError := func(funcName string, err error) {
+		mut.Lock()
+		defer mut.Unlock()
+
+		log.Debug("cannot get delegation", "provider", provider, "function", funcName, "error", err.Error())
+		queriesErr = fmt.Errorf("%w while calling %s", err, funcName)
+	}
+
+	for funcName, amount := range amountsQueries {
+		go func(funcName string, amount *string) {
+			defer wg.Done()
+
+			var errQuery error
+			*amount, errQuery = spp.getAmount(throttler, provider, funcName, addressBytes)
+			if errQuery != nil {
+				setError(funcName, errQuery)
+			}
+		}(funcName, amount)
+	}
+	go func() {
+		defer wg.Done()
+
+		var errQuery error
+		delegation.Undelegations, errQuery = spp.getUnstakedAmounts(throttler, provider, userUnDelegatedListFunc, addressBytes)
+		if errQuery != nil {
+			setError(userUnDelegatedListFunc, errQuery)
+		}
+	}()
+
+	wg.Wait()
+
+	if queriesErr != nil {
+		delegation.Error = queriesErr.Error()
+	}
+
+	return delegation, true
+}
+
+func (spp *stakingPositionProcessor) getAmount(throttler chan struct{}, scAddress string, funcName string, addressBytes []byte) (string, error) {
+	vmOutput, err := spp.executeQuery(throttler, scAddress, funcName, addressBytes)
+	if err != nil {
+		return "", err
+	}
+	err = checkReturnCode(vmOutput)
+	if err != nil {
+		return "", err
+	}
+
+	return decodeFirstAmount(vmOutput.ReturnData), nil
+}
+
+// getUnstakedAmounts decodes the lists of unstaked amounts, returned as pairs of amount and remaining epochs
+func (spp *stakingPositionProcessor) getUnstakedAmounts(throttler chan struct{}, scAddress string, funcName string, addressBytes []byte) ([]*data.UnstakedAmount, error) {
+	vmOutput, err := spp.executeQuery(throttler, scAddress, funcName, addressBytes)
+	if err != nil {
+		return nil, err
+	}
+	err = checkReturnCode(vmOutput)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmOutput.ReturnData)%2 != 0 {
+		return nil, fmt.Errorf("%w: odd number of values returned by %s", ErrInvalidStakingData, funcName)
+	}
+
+	unstakedAmounts := make([]*data.UnstakedAmount, 0, len(vmOutput.ReturnData)/2)
+	for i := 0; i < len(vmOutput.ReturnData); i += 2 {
+		unstakedAmounts = append(unstakedAmounts, &data.UnstakedAmount{
+			Amount:          decodeAmount(vmOutput.ReturnData[i]),
+			RemainingEpochs: big.NewInt(0).SetBytes(vmOutput.ReturnData[i+1]).Uint64(),
+		})
+	}
+
+	return unstakedAmounts, nil
+}
+
+// executeQuery executes a view function of a system smart contract, which can only be called by the contract itself
+func (spp *stakingPositionProcessor) executeQuery(throttler chan struct{}, scAddress string, funcName string, arguments ...[]byte) (*vm.VMOutputApi, error) {
+	return spp.executeQueryAs(throttler, scAddress, scAddress, funcName, arguments...)
+}
+
+func (spp *stakingPositionProcessor) executeQueryAs(throttler chan struct{}, caller string, scAddress string, funcName string, arguments ...[]byte) (*vm.VMOutputApi, error) {
+	throttler <- struct{}{}
+	defer func() {
+		<-throttler
+	}()
+
+	vmOutput, _, err := spp.scQueryService.ExecuteQuery(&data.SCQuery{
+		ScAddress:  scAddress,
+		FuncName:   funcName,
+		CallerAddr: caller,
+		Arguments:  arguments,
+	})
+
+	return vmOutput, err
+}
+
+func checkReturnCode(vmOutput *vm.VMOutputApi) error {
+	if vmOutput.ReturnCode == vmOutputOkCode {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s %s", ErrStakingQueryFailed, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+}
+
+func decodeFirstAmount(returnData [][]byte) string {
+	if len(returnData) == 0 {
+		return "0"
+	}
+
+	return decodeAmount(returnData[0])
+}
+
+func decodeAmount(value []byte) string {
+	return big.NewInt(0).SetBytes(value).String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (spp *stakingPositionProcessor) IsInterfaceNil() bool {
+	return spp == nil
+}
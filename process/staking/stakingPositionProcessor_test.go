@@ -0,0 +1,329 @@
+package staking
+
+import (
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAddress           = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	testProviderDelegator = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq0llllsqkarq6"
+	testProviderOther     = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqlllllskf06ky"
+	testProviderFailing   = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqhllllsajxzat"
+)
+
+var expectedErr = errors.New("expected error")
+
+func okOutput(returnData ...[]byte) *vm.VMOutputApi {
+	return &vm.VMOutputApi{ReturnCode: vmOutputOkCode, ReturnData: returnData}
+}
+
+func userErrorOutput(message string) *vm.VMOutputApi {
+	return &vm.VMOutputApi{ReturnCode: vmOutputUserErrorCode, ReturnMessage: message}
+}
+
+func bigBytes(value int64) []byte {
+	return big.NewInt(value).Bytes()
+}
+
+// createSystemContractsStub simulates an address which staked two nodes directly and delegated to a single provider
+func createSystemContractsStub(t *testing.T, numProvidersQueries *uint32) *mock.SCQueryServiceStub {
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+
+	return &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+			switch query.ScAddress {
+			case delegationManagerContractAddress:
+				require.Equal(t, allContractAddressesFunc, query.FuncName)
+				require.Equal(t, delegationManagerContractAddress, query.CallerAddr)
+				atomic.AddUint32(numProvidersQueries, 1)
+
+				var providers [][]byte
+				for _, provider := range []string{testProviderDelegator, testProviderOther, testProviderFailing} {
+					providerBytes, _ := converter.Decode(provider)
+					providers = append(providers, providerBytes)
+				}
+				return okOutput(providers...), data.BlockInfo{}, nil
+			case validatorContractAddress:
+				require.Equal(t, validatorContractAddress, query.CallerAddr)
+				switch query.FuncName {
+				case totalStakedTopUpStakedBlsKeysFunc:
+					return okOutput(bigBytes(100), bigBytes(5100), bigBytes(2), []byte{0xaa}, []byte{0xbb}), data.BlockInfo{}, nil
+				case unStakedTokensListFunc:
+					return okOutput(bigBytes(2500), bigBytes(3)), data.BlockInfo{}, nil
+				}
+			case stakingContractAddress:
+				require.Equal(t, blsKeyStatusFunc, query.FuncName)
+				require.Equal(t, validatorContractAddress, query.CallerAddr)
+				if query.Arguments[0][0] == 0xbb {
+					return nil, data.BlockInfo{}, expectedErr
+				}
+				return okOutput([]byte("staked")), data.BlockInfo{}, nil
+			case testProviderOther:
+				return userErrorOutput("view function works only for existing delegators"), data.BlockInfo{}, nil
+			case testProviderFailing:
+				if query.FuncName == userActiveStakeFunc {
+					return okOutput(bigBytes(10)), data.BlockInfo{}, nil
+				}
+				if query.FuncName == claimableRewardsFunc {
+					return &vm.VMOutputApi{ReturnCode: "execution failed"}, data.BlockInfo{}, nil
+				}
+				return okOutput(), data.BlockInfo{}, nil
+			case testProviderDelegator:
+				require.Equal(t, testProviderDelegator, query.CallerAddr)
+				switch query.FuncName {
+				case userActiveStakeFunc:
+					return okOutput(bigBytes(1000)), data.BlockInfo{}, nil
+				case userUnStakedValueFunc:
+					return okOutput(bigBytes(300)), data.BlockInfo{}, nil
+				case userUnBondableFunc:
+					return okOutput(), data.BlockInfo{}, nil
+				case claimableRewardsFunc:
+					return okOutput(bigBytes(7)), data.BlockInfo{}, nil
+				case userUnDelegatedListFunc:
+					return okOutput(bigBytes(300), bigBytes(10)), data.BlockInfo{}, nil
+				}
+			}
+
+			return nil, data.BlockInfo{}, errors.New("unexpected query")
+		},
+	}
+}
+
+func createMockArgs() ArgsStakingPositionProcessor {
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+
+	return ArgsStakingPositionProcessor{
+		SCQueryService:           &mock.SCQueryServiceStub{},
+		PubKeyConverter:          converter,
+		ProvidersCacheValidity:   time.Minute,
+		DelegationsCacheValidity: time.Minute,
+		MaxConcurrentQueries:     2,
+	}
+}
+
+func TestNewStakingPositionProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil sc query service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.SCQueryService = nil
+		spp, err := NewStakingPositionProcessor(args)
+		require.Nil(t, spp)
+		require.Equal(t, ErrNilSCQueryService, err)
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.PubKeyConverter = nil
+		spp, err := NewStakingPositionProcessor(args)
+		require.Nil(t, spp)
+		require.Equal(t, ErrNilPubKeyConverter, err)
+	})
+	t.Run("invalid providers cache validity should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.ProvidersCacheValidity = 0
+		spp, err := NewStakingPositionProcessor(args)
+		require.Nil(t, spp)
+		require.Equal(t, ErrInvalidProvidersCacheValidity, err)
+	})
+	t.Run("invalid delegations cache validity should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.DelegationsCacheValidity = 0
+		spp, err := NewStakingPositionProcessor(args)
+		require.Nil(t, spp)
+		require.Equal(t, ErrInvalidDelegationsCacheValidity, err)
+	})
+	t.Run("invalid max concurrent queries should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.MaxConcurrentQueries = 0
+		spp, err := NewStakingPositionProcessor(args)
+		require.Nil(t, spp)
+		require.Equal(t, ErrInvalidMaxConcurrentQueries, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		spp, err := NewStakingPositionProcessor(createMockArgs())
+		require.Nil(t, err)
+		require.False(t, spp.IsInterfaceNil())
+	})
+}
+
+func TestSystemContractsAddresses(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	for _, address := range []string{stakingContractAddress, validatorContractAddress, delegationManagerContractAddress} {
+		_, err := converter.Decode(address)
+		require.Nil(t, err, address)
+	}
+}
+
+func TestStakingPositionProcessor_GetStakingPosition(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		spp, _ := NewStakingPositionProcessor(createMockArgs())
+
+		position, err := spp.GetStakingPosition("erd1invalid")
+		require.Nil(t, position)
+		_, isInvalidRequest := err.(*apiErrors.ErrInvalidRequest)
+		require.True(t, isInvalidRequest)
+	})
+	t.Run("providers query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.SCQueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				if query.ScAddress == delegationManagerContractAddress {
+					return nil, data.BlockInfo{}, expectedErr
+				}
+				return userErrorOutput("not registered"), data.BlockInfo{}, nil
+			},
+		}
+		spp, _ := NewStakingPositionProcessor(args)
+
+		position, err := spp.GetStakingPosition(testAddress)
+		require.Nil(t, position)
+		require.ErrorIs(t, err, expectedErr)
+	})
+	t.Run("address without stake should return an empty position", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.SCQueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				if query.ScAddress == delegationManagerContractAddress {
+					return okOutput(), data.BlockInfo{}, nil
+				}
+				return userErrorOutput("caller not registered in staking/validator sc"), data.BlockInfo{}, nil
+			},
+		}
+		spp, _ := NewStakingPositionProcessor(args)
+
+		position, err := spp.GetStakingPosition(testAddress)
+		require.Nil(t, err)
+		require.Equal(t, &data.StakingPosition{
+			Address:     testAddress,
+			Delegations: make([]*data.DelegationPosition, 0),
+		}, position)
+	})
+	t.Run("should aggregate the direct stake and the delegations", func(t *testing.T) {
+		t.Parallel()
+
+		numProvidersQueries := uint32(0)
+		args := createMockArgs()
+		args.SCQueryService = createSystemContractsStub(t, &numProvidersQueries)
+		spp, _ := NewStakingPositionProcessor(args)
+
+		position, err := spp.GetStakingPosition(testAddress)
+		require.Nil(t, err)
+		require.Equal(t, &data.DirectStakePosition{
+			TotalStaked: "5100",
+			TopUp:       "100",
+			Unstaked:    []*data.UnstakedAmount{{Amount: "2500", RemainingEpochs: 3}},
+			Nodes: []*data.StakedNode{
+				{BLSKey: "aa", Status: "staked"},
+				{BLSKey: "bb", Error: expectedErr.Error()},
+			},
+		}, position.DirectStake)
+
+		require.Len(t, position.Delegations, 2)
+		require.Equal(t, &data.DelegationPosition{
+			Provider:         testProviderDelegator,
+			ActiveStake:      "1000",
+			UnstakedAmount:   "300",
+			UnbondableAmount: "0",
+			ClaimableRewards: "7",
+			Undelegations:    []*data.UnstakedAmount{{Amount: "300", RemainingEpochs: 10}},
+		}, position.Delegations[0])
+
+		failingDelegation := position.Delegations[1]
+		require.Equal(t, testProviderFailing, failingDelegation.Provider)
+		require.Equal(t, "10", failingDelegation.ActiveStake)
+		require.Contains(t, failingDelegation.Error, ErrStakingQueryFailed.Error())
+		require.Contains(t, failingDelegation.Error, claimableRewardsFunc)
+
+		_, _ = spp.GetStakingPosition(testAddress)
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numProvidersQueries))
+	})
+	t.Run("delegations without errors should be cached", func(t *testing.T) {
+		t.Parallel()
+
+		numProvidersQueries := uint32(0)
+		numDelegationQueries := uint32(0)
+		systemContractsStub := createSystemContractsStub(t, &numProvidersQueries)
+		args := createMockArgs()
+		args.SCQueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				switch query.ScAddress {
+				case testProviderFailing:
+					atomic.AddUint32(&numDelegationQueries, 1)
+					return userErrorOutput("view function works only for existing delegators"), data.BlockInfo{}, nil
+				case testProviderDelegator, testProviderOther:
+					atomic.AddUint32(&numDelegationQueries, 1)
+				}
+				return systemContractsStub.ExecuteQuery(query)
+			},
+		}
+		spp, _ := NewStakingPositionProcessor(args)
+
+		position, err := spp.GetStakingPosition(testAddress)
+		require.Nil(t, err)
+		require.Len(t, position.Delegations, 1)
+		numQueriesAfterFirstCall := atomic.LoadUint32(&numDelegationQueries)
+		require.NotZero(t, numQueriesAfterFirstCall)
+
+		position, err = spp.GetStakingPosition(testAddress)
+		require.Nil(t, err)
+		require.Len(t, position.Delegations, 1)
+		require.Equal(t, numQueriesAfterFirstCall, atomic.LoadUint32(&numDelegationQueries))
+	})
+	t.Run("delegations with errors should not be cached", func(t *testing.T) {
+		t.Parallel()
+
+		numProvidersQueries := uint32(0)
+		numDelegationQueries := uint32(0)
+		systemContractsStub := createSystemContractsStub(t, &numProvidersQueries)
+		args := createMockArgs()
+		args.SCQueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				if query.ScAddress == testProviderDelegator {
+					atomic.AddUint32(&numDelegationQueries, 1)
+				}
+				return systemContractsStub.ExecuteQuery(query)
+			},
+		}
+		spp, _ := NewStakingPositionProcessor(args)
+
+		_, _ = spp.GetStakingPosition(testAddress)
+		numQueriesAfterFirstCall := atomic.LoadUint32(&numDelegationQueries)
+		require.NotZero(t, numQueriesAfterFirstCall)
+
+		_, _ = spp.GetStakingPosition(testAddress)
+		require.Equal(t, 2*numQueriesAfterFirstCall, atomic.LoadUint32(&numDelegationQueries))
+	})
+}
@@ -0,0 +1,24 @@
+package tokens
+
+import "errors"
+
+// ErrNilESDTSupplyProvider signals that a nil ESDT supply provider has been provided
+var ErrNilESDTSupplyProvider = errors.New("nil ESDT supply provider")
+
+// ErrNilSCQueryService signals that a nil smart contract query service has been provided
+var ErrNilSCQueryService = errors.New("nil smart contract query service")
+
+// ErrNilNodeStatusProvider signals that a nil node status provider has been provided
+var ErrNilNodeStatusProvider = errors.New("nil node status provider")
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrNilNetworkMetrics signals that nil network metrics have been received
+var ErrNilNetworkMetrics = errors.New("nil network metrics")
+
+// ErrESDTQueryFailed signals that a query of the ESDT system smart contract did not succeed
+var ErrESDTQueryFailed = errors.New("ESDT system smart contract query failed")
+
+// ErrInvalidTokenProperties signals that the properties of a token could not be decoded
+var ErrInvalidTokenProperties = errors.New("invalid token properties")
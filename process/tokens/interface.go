@@ -0,0 +1,21 @@
+package tokens
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ESDTSupplyProvider defines what a component which fetches the supply of a token should do
+type ESDTSupplyProvider interface {
+	GetESDTSupply(token string) (*data.ESDTSupplyResponse, error)
+}
+
+// SCQueryService defines what a component which executes vm-queries should do
+type SCQueryService interface {
+	ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+}
+
+// NodeStatusProvider defines what a component which fetches the network metrics should do
+type NodeStatusProvider interface {
+	GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error)
+}
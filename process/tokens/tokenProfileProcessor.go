@@ -0,0 +1,392 @@
+package tokens
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+)
+
+const (
+	tokenPropertiesFunc = "getTokenProperties"
+	specialRolesFunc    = "getSpecialRoles"
+
+	vmOutputOkCode        = "ok"
+	vmOutputUserErrorCode = "user error"
+
+	identifierSeparator     = "-"
+	propertySeparator       = "-"
+	addressRolesSeparator   = ":"
+	rolesSeparator          = ","
+	numFixedTokenProperties = 5
+
+	maxCachedCollections = 10000
+)
+
+var log = logger.GetOrCreate("process/tokens")
+
+// ArgsTokenProfileProcessor holds the arguments needed for creating a new token profile processor
+type ArgsTokenProfileProcessor struct {
+	ESDTSupplyProvider ESDTSupplyProvider
+	SCQueryService     SCQueryService
+	NodeStatusProvider NodeStatusProvider
+	PubKeyConverter    core.PubkeyConverter
+}
+
+type tokenIdentifier struct {
+	identifier string
+	collection string
+	ticker     string
+	nonce      uint64
+}
+
+// collectionProperties holds the details of a collection set when it was issued, which can only change through
+// transactions of its owner and are therefore cached for the rest of the epoch
+type collectionProperties struct {
+	name       string
+	tokenType  string
+	owner      string
+	decimals   uint32
+	properties data.ESDTTokenProperties
+}
+
+type tokenProfileProcessor struct {
+	esdtSupplyProvider   ESDTSupplyProvider
+	scQueryService       SCQueryService
+	nodeStatusProvider   NodeStatusProvider
+	pubKeyConverter      core.PubkeyConverter
+	maxCachedCollections int
+
+	mutProperties     sync.RWMutex
+	properties        map[string]*collectionProperties
+	cachedCollections []string
+	propertiesEpoch   uint32
+}
+
+// NewTokenProfileProcessor creates a new instance of tokenProfileProcessor
+func NewTokenProfileProcessor(args ArgsTokenProfileProcessor) (*tokenProfileProcessor, error) {
+	if args.ESDTSupplyProvider == nil {
+		return nil, ErrNilESDTSupplyProvider
+	}
+	if args.SCQueryService == nil {
+		return nil, ErrNilSCQueryService
+	}
+	if args.NodeStatusProvider == nil {
+		return nil, ErrNilNodeStatusProvider
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	return &tokenProfileProcessor{
+		esdtSupplyProvider:   args.ESDTSupplyProvider,
+		scQueryService:       args.SCQueryService,
+		nodeStatusProvider:   args.NodeStatusProvider,
+		pubKeyConverter:      args.PubKeyConverter,
+		maxCachedCollections: maxCachedCollections,
+		properties:           make(map[string]*collectionProperties),
+	}, nil
+}
+
+// GetTokenProfile returns the supply, the properties and the special roles of the provided fungible token, collection
+// or collection's token. The properties are cached until the end of the epoch they were fetched in, while the supply
+// and the roles are fetched on each call
+func (tpp *tokenProfileProcessor) GetTokenProfile(identifier string) (*data.ESDTTokenProfile, error) {
+	tokenID, err := parseTokenIdentifier(identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	epoch, err := tpp.getCurrentEpoch()
+	if err != nil {
+		return nil, err
+	}
+
+	properties, isCached := tpp.getCachedProperties(tokenID.collection, epoch)
+
+	var wg sync.WaitGroup
+	var rolesOutput [][]byte
+	var supplyResponse *data.ESDTSupplyResponse
+	var propertiesErr, rolesErr, supplyErr error
+	if !isCached {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			properties, propertiesErr = tpp.fetchProperties(tokenID.collection)
+		}()
+	}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		rolesOutput, rolesErr = tpp.queryESDTContract(specialRolesFunc, tokenID.collection)
+	}()
+	go func() {
+		defer wg.Done()
+		supplyResponse, supplyErr = tpp.esdtSupplyProvider.GetESDTSupply(tokenID.identifier)
+	}()
+
+	wg.Wait()
+
+	if propertiesErr != nil {
+		return nil, propertiesErr
+	}
+	if rolesErr != nil {
+		return nil, rolesErr
+	}
+	if supplyErr != nil {
+		return nil, supplyErr
+	}
+
+	roles, err := decodeSpecialRoles(rolesOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isCached {
+		tpp.cacheProperties(tokenID.collection, properties, epoch)
+	}
+
+	return &data.ESDTTokenProfile{
+		Identifier: tokenID.identifier,
+		Collection: tokenID.collection,
+		Nonce:      tokenID.nonce,
+		Ticker:     tokenID.ticker,
+		Name:       properties.name,
+		Type:       properties.tokenType,
+		Owner:      properties.owner,
+		Decimals:   properties.decimals,
+		Supply:     supplyResponse.Data,
+		Properties: properties.properties,
+		Roles:      roles,
+		Epoch:      epoch,
+	}, nil
+}
+
+func (tpp *tokenProfileProcessor) getCachedProperties(collection string, epoch uint32) (*collectionProperties, bool) {
+	tpp.mutProperties.RLock()
+	defer tpp.mutProperties.RUnlock()
+
+	if tpp.propertiesEpoch != epoch {
+		return nil, false
+	}
+
+	properties, found := tpp.properties[collection]
+	return properties, found
+}
+
+func (tpp *tokenProfileProcessor) cacheProperties(collection string, properties *collectionProperties, epoch uint32) {
+	tpp.mutProperties.Lock()
+	defer tpp.mutProperties.Unlock()
+
+	// the properties of the previous epochs are dropped at once, while the ones fetched during an epoch change are not kept
+	if epoch > tpp.propertiesEpoch {
+		tpp.properties = make(map[string]*collectionProperties)
+		tpp.cachedCollections = nil
+		tpp.propertiesEpoch = epoch
+	}
+	if epoch != tpp.propertiesEpoch {
+		return
+	}
+
+	_, found := tpp.properties[collection]
+	if found {
+		return
+	}
+
+	// the collections cached first are evicted first
+	if len(tpp.cachedCollections) >= tpp.maxCachedCollections {
+		delete(tpp.properties, tpp.cachedCollections[0])
+		tpp.cachedCollections[0] = ""
+		tpp.cachedCollections = tpp.cachedCollections[1:]
+	}
+	tpp.properties[collection] = properties
+	tpp.cachedCollections = append(tpp.cachedCollections, collection)
+}
+
+func (tpp *tokenProfileProcessor) fetchProperties(collection string) (*collectionProperties, error) {
+	propertiesOutput, err := tpp.queryESDTContract(tokenPropertiesFunc, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	return tpp.decodeTokenProperties(propertiesOutput)
+}
+
+func (tpp *tokenProfileProcessor) queryESDTContract(funcName string, collection string) ([][]byte, error) {
+	vmOutput, _, err := tpp.scQueryService.ExecuteQuery(&data.SCQuery{
+		ScAddress: process.ESDTContractAddress,
+		FuncName:  funcName,
+		Arguments: [][]byte{[]byte(collection)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch vmOutput.ReturnCode {
+	case vmOutputOkCode:
+		return vmOutput.ReturnData, nil
+	case vmOutputUserErrorCode:
+		// the only user error of the queried functions, when called with one argument, is the missing token
+		log.Trace("token not found", "collection", collection, "function", funcName, "message", vmOutput.ReturnMessage)
+		return nil, apiErrors.ErrTokenNotFound
+	default:
+		return nil, fmt.Errorf("%w: %s %s", ErrESDTQueryFailed, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+}
+
+// decodeTokenProperties decodes the output of getTokenProperties: the name, the type, the owner, the minted and the
+// burnt values, followed by the properties formatted as Name-value
+func (tpp *tokenProfileProcessor) decodeTokenProperties(output [][]byte) (*collectionProperties, error) {
+	if len(output) < numFixedTokenProperties {
+		return nil, fmt.Errorf("%w: expected at least %d values, got %d", ErrInvalidTokenProperties, numFixedTokenProperties, len(output))
+	}
+
+	owner, err := tpp.pubKeyConverter.Encode(output[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s for the owner", ErrInvalidTokenProperties, err.Error())
+	}
+
+	decoded := &collectionProperties{
+		name:      string(output[0]),
+		tokenType: string(output[1]),
+		owner:     owner,
+	}
+	for _, property := range output[numFixedTokenProperties:] {
+		name, value, found := strings.Cut(string(property), propertySeparator)
+		if !found {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidTokenProperties, property)
+		}
+
+		err = setTokenProperty(decoded, name, value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s for %s", ErrInvalidTokenProperties, err.Error(), name)
+		}
+	}
+
+	return decoded, nil
+}
+
+func setTokenProperty(decoded *collectionProperties, name string, value string) error {
+	properties := &decoded.properties
+	flags := map[string]*bool{
+		"IsPaused":                 &properties.IsPaused,
+		"CanUpgrade":               &properties.CanUpgrade,
+		"CanMint":                  &properties.CanMint,
+		"CanBurn":                  &properties.CanBurn,
+		"CanChangeOwner":           &properties.CanChangeOwner,
+		"CanPause":                 &properties.CanPause,
+		"CanFreeze":                &properties.CanFreeze,
+		"CanWipe":                  &properties.CanWipe,
+		"CanAddSpecialRoles":       &properties.CanAddSpecialRoles,
+		"CanTransferNFTCreateRole": &properties.CanTransferNFTCreateRole,
+		"NFTCreateStopped":         &properties.NFTCreateStopped,
+	}
+
+	flag, isFlag := flags[name]
+	if isFlag {
+		var err error
+		*flag, err = strconv.ParseBool(value)
+		return err
+	}
+
+	switch name {
+	case "NumDecimals":
+		decimals, err := strconv.ParseUint(value, 10, 32)
+		decoded.decimals = uint32(decimals)
+		return err
+	case "NumWiped":
+		var err error
+		properties.NumWiped, err = strconv.ParseUint(value, 10, 64)
+		return err
+	default:
+		// properties added by newer versions of the protocol are ignored
+		return nil
+	}
+}
+
+// decodeSpecialRoles decodes the output of getSpecialRoles: one value for each address, formatted as address:role1,role2
+func decodeSpecialRoles(output [][]byte) ([]*data.ESDTAddressRoles, error) {
+	addressesRoles := make([]*data.ESDTAddressRoles, 0, len(output))
+	for _, addressRoles := range output {
+		address, roles, found := strings.Cut(string(addressRoles), addressRolesSeparator)
+		if !found {
+			return nil, fmt.Errorf("%w: invalid special roles %s", ErrInvalidTokenProperties, addressRoles)
+		}
+
+		addressesRoles = append(addressesRoles, &data.ESDTAddressRoles{
+			Address: address,
+			Roles:   strings.Split(roles, rolesSeparator),
+		})
+	}
+
+	return addressesRoles, nil
+}
+
+func (tpp *tokenProfileProcessor) getCurrentEpoch() (uint32, error) {
+	response, err := tpp.nodeStatusProvider.GetNetworkStatusMetrics(core.MetachainShardId)
+	if err != nil {
+		return 0, err
+	}
+	if response == nil {
+		return 0, ErrNilNetworkMetrics
+	}
+
+	metricsBytes, err := json.Marshal(&response.Data)
+	if err != nil {
+		return 0, err
+	}
+
+	networkStatus := &data.NetworkStatus{}
+	err = json.Unmarshal(metricsBytes, networkStatus)
+	if err != nil {
+		return 0, err
+	}
+
+	return networkStatus.Status.EpochNumber, nil
+}
+
+// parseTokenIdentifier splits identifiers such as TKN-abcdef or NFT-abcdef-0a into the ticker, the collection and the
+// hex encoded nonce
+func parseTokenIdentifier(identifier string) (*tokenIdentifier, error) {
+	parts := strings.Split(identifier, identifierSeparator)
+	if len(parts) < 2 || len(parts) > 3 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return nil, newInvalidTokenIdentifierError("expected the ticker and the random sequence, optionally followed by the nonce")
+	}
+
+	tokenID := &tokenIdentifier{
+		identifier: identifier,
+		collection: parts[0] + identifierSeparator + parts[1],
+		ticker:     parts[0],
+	}
+	if len(parts) == 2 {
+		return tokenID, nil
+	}
+
+	nonce, err := strconv.ParseUint(parts[2], 16, 64)
+	if err != nil || nonce == 0 {
+		return nil, newInvalidTokenIdentifierError("the nonce should be a positive hex encoded number")
+	}
+	tokenID.nonce = nonce
+
+	return tokenID, nil
+}
+
+func newInvalidTokenIdentifierError(reason string) error {
+	return &apiErrors.ErrInvalidRequest{
+		Message: apiErrors.ErrInvalidTokenIdentifier.Error(),
+		Reason:  reason,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tpp *tokenProfileProcessor) IsInterfaceNil() bool {
+	return tpp == nil
+}
@@ -0,0 +1,342 @@
+package tokens
+
+import (
+	"encoding/hex"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testOwnerHex = "0139472eff6886771a982f3083da5d421f24c29181e63888228dc81ca60d69e1"
+	testRoles    = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th:ESDTRoleNFTCreate,ESDTRoleNFTBurn"
+)
+
+var expectedErr = errors.New("expected error")
+
+type esdtSupplyProviderStub struct {
+	getESDTSupplyCalled func(token string) (*data.ESDTSupplyResponse, error)
+}
+
+func (stub *esdtSupplyProviderStub) GetESDTSupply(token string) (*data.ESDTSupplyResponse, error) {
+	if stub.getESDTSupplyCalled != nil {
+		return stub.getESDTSupplyCalled(token)
+	}
+
+	return &data.ESDTSupplyResponse{
+		Data: data.ESDTSupply{Supply: "1000", Minted: "1500", Burned: "500"},
+	}, nil
+}
+
+type nodeStatusProviderStub struct {
+	epoch uint32
+}
+
+func (stub *nodeStatusProviderStub) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+	if shardID != core.MetachainShardId {
+		return nil, expectedErr
+	}
+
+	return &data.GenericAPIResponse{
+		Data: map[string]interface{}{
+			"status": map[string]interface{}{
+				"erd_epoch_number": atomic.LoadUint32(&stub.epoch),
+			},
+		},
+	}, nil
+}
+
+func createTokenPropertiesOutput(properties ...string) [][]byte {
+	owner, _ := hex.DecodeString(testOwnerHex)
+	output := [][]byte{[]byte("Collection"), []byte("NonFungibleESDT"), owner, []byte("0"), []byte("0")}
+	for _, property := range properties {
+		output = append(output, []byte(property))
+	}
+
+	return output
+}
+
+func createESDTQueryService(numQueries *uint32) *mock.SCQueryServiceStub {
+	return &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+			atomic.AddUint32(numQueries, 1)
+			switch query.FuncName {
+			case tokenPropertiesFunc:
+				output := createTokenPropertiesOutput("NumDecimals-0", "IsPaused-false", "CanUpgrade-true", "CanWipe-true", "NumWiped-2", "CanCreateMultiShard-true")
+				return &vm.VMOutputApi{ReturnCode: vmOutputOkCode, ReturnData: output}, data.BlockInfo{}, nil
+			case specialRolesFunc:
+				return &vm.VMOutputApi{ReturnCode: vmOutputOkCode, ReturnData: [][]byte{[]byte(testRoles)}}, data.BlockInfo{}, nil
+			default:
+				return nil, data.BlockInfo{}, expectedErr
+			}
+		},
+	}
+}
+
+func createMockArgs() ArgsTokenProfileProcessor {
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	numQueries := uint32(0)
+
+	return ArgsTokenProfileProcessor{
+		ESDTSupplyProvider: &esdtSupplyProviderStub{},
+		SCQueryService:     createESDTQueryService(&numQueries),
+		NodeStatusProvider: &nodeStatusProviderStub{epoch: 1},
+		PubKeyConverter:    converter,
+	}
+}
+
+func TestNewTokenProfileProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil ESDT supply provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.ESDTSupplyProvider = nil
+		tpp, err := NewTokenProfileProcessor(args)
+		require.Nil(t, tpp)
+		require.Equal(t, ErrNilESDTSupplyProvider, err)
+	})
+	t.Run("nil sc query service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.SCQueryService = nil
+		tpp, err := NewTokenProfileProcessor(args)
+		require.Nil(t, tpp)
+		require.Equal(t, ErrNilSCQueryService, err)
+	})
+	t.Run("nil node status provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.NodeStatusProvider = nil
+		tpp, err := NewTokenProfileProcessor(args)
+		require.Nil(t, tpp)
+		require.Equal(t, ErrNilNodeStatusProvider, err)
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.PubKeyConverter = nil
+		tpp, err := NewTokenProfileProcessor(args)
+		require.Nil(t, tpp)
+		require.Equal(t, ErrNilPubKeyConverter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tpp, err := NewTokenProfileProcessor(createMockArgs())
+		require.Nil(t, err)
+		require.False(t, tpp.IsInterfaceNil())
+	})
+}
+
+func TestParseTokenIdentifier(t *testing.T) {
+	t.Parallel()
+
+	invalidIdentifiers := []string{"", "TKN", "TKN-", "-abcdef", "NFT-abcdef-", "NFT-abcdef-00", "NFT-abcdef-zz", "NFT-abcdef-01-02"}
+	for _, identifier := range invalidIdentifiers {
+		tokenID, err := parseTokenIdentifier(identifier)
+		require.Nil(t, tokenID, identifier)
+		_, isInvalidRequest := err.(*apiErrors.ErrInvalidRequest)
+		require.True(t, isInvalidRequest, identifier)
+	}
+
+	tokenID, err := parseTokenIdentifier("TKN-abcdef")
+	require.Nil(t, err)
+	require.Equal(t, &tokenIdentifier{identifier: "TKN-abcdef", collection: "TKN-abcdef", ticker: "TKN"}, tokenID)
+
+	tokenID, err = parseTokenIdentifier("NFT-abcdef-0a")
+	require.Nil(t, err)
+	require.Equal(t, &tokenIdentifier{identifier: "NFT-abcdef-0a", collection: "NFT-abcdef", ticker: "NFT", nonce: 10}, tokenID)
+}
+
+func TestTokenProfileProcessor_GetTokenProfile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid identifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		tpp, _ := NewTokenProfileProcessor(createMockArgs())
+
+		profile, err := tpp.GetTokenProfile("TKN")
+		require.Nil(t, profile)
+		_, isInvalidRequest := err.(*apiErrors.ErrInvalidRequest)
+		require.True(t, isInvalidRequest)
+	})
+	t.Run("token not registered should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.SCQueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				return &vm.VMOutputApi{ReturnCode: vmOutputUserErrorCode, ReturnMessage: "no ticker with given name"}, data.BlockInfo{}, nil
+			},
+		}
+		tpp, _ := NewTokenProfileProcessor(args)
+
+		profile, err := tpp.GetTokenProfile("TKN-abcdef")
+		require.Nil(t, profile)
+		require.Equal(t, apiErrors.ErrTokenNotFound, err)
+	})
+	t.Run("failed query should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.SCQueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				return &vm.VMOutputApi{ReturnCode: "out of gas"}, data.BlockInfo{}, nil
+			},
+		}
+		tpp, _ := NewTokenProfileProcessor(args)
+
+		profile, err := tpp.GetTokenProfile("TKN-abcdef")
+		require.Nil(t, profile)
+		require.ErrorIs(t, err, ErrESDTQueryFailed)
+	})
+	t.Run("supply error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.ESDTSupplyProvider = &esdtSupplyProviderStub{
+			getESDTSupplyCalled: func(token string) (*data.ESDTSupplyResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		tpp, _ := NewTokenProfileProcessor(args)
+
+		profile, err := tpp.GetTokenProfile("TKN-abcdef")
+		require.Nil(t, profile)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("invalid properties should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.SCQueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				output := createTokenPropertiesOutput("IsPaused-maybe")
+				return &vm.VMOutputApi{ReturnCode: vmOutputOkCode, ReturnData: output}, data.BlockInfo{}, nil
+			},
+		}
+		tpp, _ := NewTokenProfileProcessor(args)
+
+		profile, err := tpp.GetTokenProfile("TKN-abcdef")
+		require.Nil(t, profile)
+		require.ErrorIs(t, err, ErrInvalidTokenProperties)
+	})
+	t.Run("should aggregate the collection's details and the token's supply", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.SCQueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				require.Equal(t, process.ESDTContractAddress, query.ScAddress)
+				require.Equal(t, [][]byte{[]byte("NFT-abcdef")}, query.Arguments)
+
+				numQueries := uint32(0)
+				return createESDTQueryService(&numQueries).ExecuteQuery(query)
+			},
+		}
+		args.ESDTSupplyProvider = &esdtSupplyProviderStub{
+			getESDTSupplyCalled: func(token string) (*data.ESDTSupplyResponse, error) {
+				require.Equal(t, "NFT-abcdef-0a", token)
+				return &data.ESDTSupplyResponse{Data: data.ESDTSupply{Supply: "1", Minted: "1", Burned: "0"}}, nil
+			},
+		}
+		tpp, _ := NewTokenProfileProcessor(args)
+
+		ownerBytes, _ := hex.DecodeString(testOwnerHex)
+		expectedOwner, _ := args.PubKeyConverter.Encode(ownerBytes)
+		expectedProfile := &data.ESDTTokenProfile{
+			Identifier: "NFT-abcdef-0a",
+			Collection: "NFT-abcdef",
+			Nonce:      10,
+			Ticker:     "NFT",
+			Name:       "Collection",
+			Type:       "NonFungibleESDT",
+			Owner:      expectedOwner,
+			Supply:     data.ESDTSupply{Supply: "1", Minted: "1", Burned: "0"},
+			Properties: data.ESDTTokenProperties{
+				CanUpgrade: true,
+				CanWipe:    true,
+				NumWiped:   2,
+			},
+			Roles: []*data.ESDTAddressRoles{
+				{
+					Address: "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th",
+					Roles:   []string{"ESDTRoleNFTCreate", "ESDTRoleNFTBurn"},
+				},
+			},
+			Epoch: 1,
+		}
+
+		profile, err := tpp.GetTokenProfile("NFT-abcdef-0a")
+		require.Nil(t, err)
+		require.Equal(t, expectedProfile, profile)
+	})
+	t.Run("should cache the properties until the epoch changes", func(t *testing.T) {
+		t.Parallel()
+
+		numQueries := uint32(0)
+		numSupplyCalls := uint32(0)
+		nodeStatusProvider := &nodeStatusProviderStub{epoch: 1}
+		args := createMockArgs()
+		args.SCQueryService = createESDTQueryService(&numQueries)
+		args.ESDTSupplyProvider = &esdtSupplyProviderStub{
+			getESDTSupplyCalled: func(token string) (*data.ESDTSupplyResponse, error) {
+				atomic.AddUint32(&numSupplyCalls, 1)
+				return &data.ESDTSupplyResponse{}, nil
+			},
+		}
+		args.NodeStatusProvider = nodeStatusProvider
+		tpp, _ := NewTokenProfileProcessor(args)
+
+		_, _ = tpp.GetTokenProfile("TKN-abcdef")
+		profile, err := tpp.GetTokenProfile("TKN-abcdef")
+		require.Nil(t, err)
+		require.Equal(t, uint32(1), profile.Epoch)
+		require.Equal(t, "Collection", profile.Name)
+		// the properties are queried once, while the roles and the supply are fetched on each call
+		require.Equal(t, uint32(3), atomic.LoadUint32(&numQueries))
+		require.Equal(t, uint32(2), atomic.LoadUint32(&numSupplyCalls))
+
+		atomic.StoreUint32(&nodeStatusProvider.epoch, 2)
+		profile, err = tpp.GetTokenProfile("TKN-abcdef")
+		require.Nil(t, err)
+		require.Equal(t, uint32(2), profile.Epoch)
+		require.Equal(t, uint32(5), atomic.LoadUint32(&numQueries))
+	})
+	t.Run("should evict the collections cached first", func(t *testing.T) {
+		t.Parallel()
+
+		numQueries := uint32(0)
+		args := createMockArgs()
+		args.SCQueryService = createESDTQueryService(&numQueries)
+		tpp, _ := NewTokenProfileProcessor(args)
+		tpp.maxCachedCollections = 2
+
+		_, _ = tpp.GetTokenProfile("AAA-abcdef")
+		_, _ = tpp.GetTokenProfile("BBB-abcdef")
+		_, _ = tpp.GetTokenProfile("CCC-abcdef")
+		require.Len(t, tpp.properties, 2)
+		require.Equal(t, []string{"BBB-abcdef", "CCC-abcdef"}, tpp.cachedCollections)
+
+		atomic.StoreUint32(&numQueries, 0)
+		_, _ = tpp.GetTokenProfile("CCC-abcdef")
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numQueries))
+		_, _ = tpp.GetTokenProfile("AAA-abcdef")
+		require.Equal(t, uint32(3), atomic.LoadUint32(&numQueries))
+	})
+}
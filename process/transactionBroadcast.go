@@ -0,0 +1,171 @@
+package process
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// maxWaitForRemainingBroadcastResponses bounds the time spent collecting the responses of the other observers, after
+// the first one accepted the transaction
+const maxWaitForRemainingBroadcastResponses = 2 * time.Second
+
+type broadcastResult struct {
+	observer      string
+	statusCode    int
+	txHash        string
+	err           error
+	observerError string
+}
+
+func (result *broadcastResult) isAccepted() bool {
+	return result.statusCode == http.StatusOK && result.err == nil
+}
+
+// isObserverUnavailable returns true if the observer was down or didn't respond in time, as opposed to rejecting the transaction
+func (result *broadcastResult) isObserverUnavailable() bool {
+	return result.statusCode == http.StatusNotFound || result.statusCode == http.StatusRequestTimeout
+}
+
+// BroadcastTransaction sends the transaction to the first numObservers observers of the sender's shard at the same time,
+// and also to the ones of the destination shard for cross-shard transactions. Once an observer accepts the transaction,
+// the responses of the other observers are collected until all of them arrive or a short timeout expires
+func (tp *TransactionProcessor) BroadcastTransaction(tx *data.Transaction, numObservers int) (int, *data.TransactionBroadcastResponseData, error) {
+	err := tp.checkTransactionFields(tx)
+	if err != nil {
+		return http.StatusBadRequest, nil, err
+	}
+
+	observers, err := tp.getBroadcastObservers(tx, numObservers)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	results := make(chan *broadcastResult, len(observers))
+	response := &data.TransactionBroadcastResponseData{
+		SentTo:     make([]string, 0, len(observers)),
+		AcceptedBy: make([]string, 0),
+	}
+	for _, observer := range observers {
+		response.SentTo = append(response.SentTo, observer.Address)
+		go tp.sendTransactionToObserver(tx, observer.Address, results)
+	}
+
+	var rejection *broadcastResult
+	lastObserverError := ""
+	for numResults := 1; numResults <= len(observers); numResults++ {
+		result := <-results
+		if result.isAccepted() {
+			response.TxHash = result.txHash
+			response.FirstAcceptedBy = result.observer
+			response.AcceptedBy = append(response.AcceptedBy, result.observer)
+			collectRemainingBroadcastResults(results, len(observers)-numResults, response)
+
+			return http.StatusOK, response, nil
+		}
+
+		lastObserverError = result.observerError
+		if result.isObserverUnavailable() {
+			log.Debug("observer unavailable while broadcasting transaction", "observer", result.observer, "error", result.err)
+			continue
+		}
+
+		log.Debug("observer rejected broadcast transaction", "observer", result.observer, "error", result.err)
+		if rejection == nil {
+			rejection = result
+		}
+	}
+
+	// if the request was bad, return the error message
+	if rejection != nil {
+		return rejection.statusCode, nil, rejection.err
+	}
+
+	return http.StatusInternalServerError, nil, WrapObserversError(lastObserverError)
+}
+
+func (tp *TransactionProcessor) getBroadcastObservers(tx *data.Transaction, numObservers int) ([]*data.NodeData, error) {
+	senderShardID, err := tp.computeShardID(tx.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	observers, err := tp.getFirstObservers(senderShardID, numObservers)
+	if err != nil {
+		return nil, err
+	}
+
+	receiverShardID, err := tp.computeShardID(tx.Receiver)
+	if err != nil || receiverShardID == senderShardID {
+		return observers, nil
+	}
+
+	// the observers of the destination shard relay the transaction to the sender's shard, so they are only
+	// an additional propagation path
+	destinationObservers, err := tp.getFirstObservers(receiverShardID, numObservers)
+	if err != nil {
+		log.Debug("cannot get destination shard observers for broadcast", "shard", receiverShardID, "error", err)
+		return observers, nil
+	}
+
+	return append(observers, destinationObservers...), nil
+}
+
+func (tp *TransactionProcessor) computeShardID(address string) (uint32, error) {
+	addressBuff, err := tp.pubKeyConverter.Decode(address)
+	if err != nil {
+		return 0, err
+	}
+
+	return tp.proc.ComputeShardId(addressBuff)
+}
+
+func (tp *TransactionProcessor) getFirstObservers(shardID uint32, numObservers int) ([]*data.NodeData, error) {
+	observers, err := tp.proc.GetObservers(shardID, data.AvailabilityRecent)
+	if err != nil {
+		return nil, err
+	}
+	if len(observers) > numObservers {
+		observers = observers[:numObservers]
+	}
+
+	return observers, nil
+}
+
+func (tp *TransactionProcessor) sendTransactionToObserver(tx *data.Transaction, observer string, results chan<- *broadcastResult) {
+	txResponse := data.ResponseTransaction{}
+	statusCode, err := tp.proc.CallPostRestEndPoint(observer, TransactionSendPath, tx, &txResponse)
+	results <- &broadcastResult{
+		observer:      observer,
+		statusCode:    statusCode,
+		txHash:        txResponse.Data.TxHash,
+		err:           err,
+		observerError: txResponse.Error,
+	}
+}
+
+// collectRemainingBroadcastResults adds the observers which accepted the transaction after the first one, waiting for
+// their responses at most maxWaitForRemainingBroadcastResponses
+func collectRemainingBroadcastResults(results <-chan *broadcastResult, numRemaining int, response *data.TransactionBroadcastResponseData) {
+	timer := time.NewTimer(maxWaitForRemainingBroadcastResponses)
+	defer timer.Stop()
+
+	for i := 0; i < numRemaining; i++ {
+		select {
+		case result := <-results:
+			if result.isAccepted() {
+				response.AcceptedBy = append(response.AcceptedBy, result.observer)
+				continue
+			}
+
+			log.Debug("observer did not accept broadcast transaction", "hash", response.TxHash,
+				"observer", result.observer, "error", result.err)
+		case <-timer.C:
+			log.Debug("broadcast responses not received in time", "hash", response.TxHash, "num missing", numRemaining-i)
+			return
+		}
+	}
+
+	log.Debug("transaction broadcast completed", "hash", response.TxHash, "accepted by", response.AcceptedBy)
+}
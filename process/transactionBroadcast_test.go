@@ -0,0 +1,162 @@
+package process_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createTransactionProcessorForBroadcast(
+	callPostRestEndPoint func(address string, path string, value interface{}, response interface{}) (int, error),
+) *process.TransactionProcessor {
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				// the first byte of the address is its shard
+				return uint32(addressBuff[0]), nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				observers := make([]*data.NodeData, 0, 3)
+				for i := 0; i < 3; i++ {
+					observers = append(observers, &data.NodeData{Address: fmt.Sprintf("observer%d-%d", shardId, i), ShardId: shardId})
+				}
+
+				return observers, nil
+			},
+			CallPostRestEndPointCalled: callPostRestEndPoint,
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		false,
+	)
+
+	return tp
+}
+
+func TestTransactionProcessor_BroadcastTransaction(t *testing.T) {
+	t.Parallel()
+
+	senderShard0 := hex.EncodeToString([]byte{0, 1})
+	receiverShard0 := hex.EncodeToString([]byte{0, 2})
+	receiverShard1 := hex.EncodeToString([]byte{1, 3})
+
+	t.Run("invalid transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTransactionProcessorForBroadcast(nil)
+		statusCode, response, err := tp.BroadcastTransaction(&data.Transaction{Sender: senderShard0, Receiver: receiverShard0}, 2)
+		require.Nil(t, response)
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+	})
+	t.Run("intra-shard transaction should be sent to the sender's shard only", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTransactionProcessorForBroadcast(func(address string, path string, value interface{}, response interface{}) (int, error) {
+			response.(*data.ResponseTransaction).Data.TxHash = "txHash"
+			return http.StatusOK, nil
+		})
+
+		tx := &data.Transaction{Sender: senderShard0, Receiver: receiverShard0, ChainID: "T", Version: 1}
+		statusCode, response, err := tp.BroadcastTransaction(tx, 2)
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, "txHash", response.TxHash)
+		require.Equal(t, []string{"observer0-0", "observer0-1"}, response.SentTo)
+		require.Contains(t, response.SentTo, response.FirstAcceptedBy)
+		require.Equal(t, response.FirstAcceptedBy, response.AcceptedBy[0])
+
+		// the responses of all the observers are waited for
+		acceptedBy := append([]string{}, response.AcceptedBy...)
+		sort.Strings(acceptedBy)
+		require.Equal(t, []string{"observer0-0", "observer0-1"}, acceptedBy)
+	})
+	t.Run("cross-shard transaction should also be sent to the destination shard", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTransactionProcessorForBroadcast(func(address string, path string, value interface{}, response interface{}) (int, error) {
+			if address != "observer1-1" {
+				return http.StatusRequestTimeout, errors.New("timeout")
+			}
+
+			response.(*data.ResponseTransaction).Data.TxHash = "txHash"
+			return http.StatusOK, nil
+		})
+
+		tx := &data.Transaction{Sender: senderShard0, Receiver: receiverShard1, ChainID: "T", Version: 1}
+		statusCode, response, err := tp.BroadcastTransaction(tx, 2)
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, "txHash", response.TxHash)
+		require.Equal(t, "observer1-1", response.FirstAcceptedBy)
+		require.Equal(t, []string{"observer1-1"}, response.AcceptedBy)
+
+		sentTo := append([]string{}, response.SentTo...)
+		sort.Strings(sentTo)
+		require.Equal(t, []string{"observer0-0", "observer0-1", "observer1-0", "observer1-1"}, sentTo)
+	})
+	t.Run("slow observers should not be waited for indefinitely", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTransactionProcessorForBroadcast(func(address string, path string, value interface{}, response interface{}) (int, error) {
+			if address != "observer0-0" {
+				time.Sleep(10 * time.Second)
+			}
+
+			response.(*data.ResponseTransaction).Data.TxHash = "txHash"
+			return http.StatusOK, nil
+		})
+
+		tx := &data.Transaction{Sender: senderShard0, Receiver: receiverShard0, ChainID: "T", Version: 1}
+		start := time.Now()
+		statusCode, response, err := tp.BroadcastTransaction(tx, 2)
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Less(t, time.Since(start), 5*time.Second)
+		require.Equal(t, "observer0-0", response.FirstAcceptedBy)
+		require.Equal(t, []string{"observer0-0"}, response.AcceptedBy)
+	})
+	t.Run("rejected transaction should return the rejection", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("invalid nonce")
+		tp := createTransactionProcessorForBroadcast(func(address string, path string, value interface{}, response interface{}) (int, error) {
+			if address == "observer0-0" {
+				return http.StatusNotFound, errors.New("not found")
+			}
+
+			return http.StatusBadRequest, expectedErr
+		})
+
+		tx := &data.Transaction{Sender: senderShard0, Receiver: receiverShard0, ChainID: "T", Version: 1}
+		statusCode, response, err := tp.BroadcastTransaction(tx, 3)
+		require.Nil(t, response)
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+	})
+	t.Run("unavailable observers should error", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTransactionProcessorForBroadcast(func(address string, path string, value interface{}, response interface{}) (int, error) {
+			return http.StatusNotFound, errors.New("not found")
+		})
+
+		tx := &data.Transaction{Sender: senderShard0, Receiver: receiverShard0, ChainID: "T", Version: 1}
+		statusCode, response, err := tp.BroadcastTransaction(tx, 3)
+		require.Nil(t, response)
+		require.ErrorIs(t, err, process.ErrSendingRequest)
+		require.Equal(t, http.StatusInternalServerError, statusCode)
+	})
+}
@@ -0,0 +1,204 @@
+package process
+
+import (
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// GetTransactionExecutionGraph returns the execution of a transaction as a tree, where each smart contract result
+// is attached to the transaction or smart contract result which generated it
+func (tp *TransactionProcessor) GetTransactionExecutionGraph(txHash string) (*data.TransactionExecutionGraph, error) {
+	const withResults = true
+	tx, err := tp.getTxFromObservers(txHash, requestTypeFullHistoryNodes, withResults)
+	if err != nil {
+		return nil, err
+	}
+
+	root := tp.createExecutionNodeFromTransaction(tx)
+	nodes := map[string]*data.TransactionExecutionNode{
+		root.Hash: root,
+	}
+
+	scrs := make([]*transaction.ApiSmartContractResult, 0, len(tx.SmartContractResults))
+	for _, scr := range tx.SmartContractResults {
+		if scr == nil {
+			continue
+		}
+
+		scrs = append(scrs, scr)
+		nodes[scr.Hash] = tp.createExecutionNodeFromSCR(scr)
+	}
+
+	// the smart contract results are received in no particular order, so they are sorted for a stable output
+	sort.SliceStable(scrs, func(i, j int) bool {
+		if scrs[i].Nonce != scrs[j].Nonce {
+			return scrs[i].Nonce < scrs[j].Nonce
+		}
+		return scrs[i].Hash < scrs[j].Hash
+	})
+
+	for _, scr := range scrs {
+		parent, found := nodes[scr.PrevTxHash]
+		if !found || scr.PrevTxHash == scr.Hash {
+			parent = root
+		}
+
+		parent.Children = append(parent.Children, nodes[scr.Hash])
+	}
+
+	graph := &data.TransactionExecutionGraph{
+		Status: string(tx.Status),
+		Root:   root,
+	}
+
+	failurePoint := findFailurePoint(root)
+	if failurePoint != nil {
+		graph.Status = string(transaction.TxStatusFail)
+		graph.FailurePoint = failurePoint.Hash
+		graph.FailureReason = failurePoint.FailureReason
+	}
+
+	return graph, nil
+}
+
+func (tp *TransactionProcessor) createExecutionNodeFromTransaction(tx *transaction.ApiTransactionResult) *data.TransactionExecutionNode {
+	node := &data.TransactionExecutionNode{
+		Hash:           tx.Hash,
+		Type:           data.ExecutionNodeTypeTransaction,
+		Sender:         tx.Sender,
+		Receiver:       tx.Receiver,
+		SourceShard:    tx.SourceShard,
+		ExecutionShard: tx.DestinationShard,
+		Value:          tx.Value,
+		TokenTransfers: createExecutionTokenTransfers(tx.Tokens, tx.ESDTValues),
+		Operation:      tx.Operation,
+		Function:       tx.Function,
+		ReturnMessage:  tx.ReturnMessage,
+		Events:         getLogEvents(tx.Logs),
+	}
+	if len(node.Value) == 0 {
+		node.Value = "0"
+	}
+
+	node.Failed, node.FailureReason = checkIfFailed([]*transaction.ApiLogs{tx.Logs})
+	if tx.Status == transaction.TxStatusInvalid || tx.Status == transaction.TxStatusFail {
+		node.Failed = true
+	}
+	if node.Failed && len(node.FailureReason) == 0 {
+		node.FailureReason = tx.ReturnMessage
+	}
+
+	return node
+}
+
+func (tp *TransactionProcessor) createExecutionNodeFromSCR(scr *transaction.ApiSmartContractResult) *data.TransactionExecutionNode {
+	node := &data.TransactionExecutionNode{
+		Hash:           scr.Hash,
+		Type:           data.ExecutionNodeTypeSmartContractResult,
+		CallType:       scr.CallType.ToString(),
+		Sender:         scr.SndAddr,
+		Receiver:       scr.RcvAddr,
+		Value:          "0",
+		TokenTransfers: createExecutionTokenTransfers(scr.Tokens, scr.ESDTValues),
+		Operation:      scr.Operation,
+		Function:       scr.Function,
+		ReturnMessage:  scr.ReturnMessage,
+		Events:         getLogEvents(scr.Logs),
+	}
+	if scr.Value != nil {
+		node.Value = scr.Value.String()
+	}
+
+	node.SourceShard = tp.getShardOrDefault(scr.SndAddr)
+	node.ExecutionShard = tp.getShardOrDefault(scr.RcvAddr)
+
+	node.Failed, node.FailureReason = checkIfFailed([]*transaction.ApiLogs{scr.Logs})
+	hasReturnMessageWithZeroValue := len(scr.ReturnMessage) > 0 && isZeroValue(node.Value)
+	if !node.Failed && hasReturnMessageWithZeroValue && !isRefundScr(scr.ReturnMessage) {
+		node.Failed = true
+		node.FailureReason = scr.ReturnMessage
+	}
+
+	return node
+}
+
+func (tp *TransactionProcessor) getShardOrDefault(address string) uint32 {
+	shardID, err := tp.getShardByAddress(address)
+	if err != nil {
+		log.Debug("cannot compute shard ID for execution graph", "address", address, "error", err)
+		return core.AllShardId
+	}
+
+	return shardID
+}
+
+func createExecutionTokenTransfers(tokens []string, values []string) []*data.ExecutionTokenTransfer {
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	transfers := make([]*data.ExecutionTokenTransfer, 0, len(tokens))
+	for i, token := range tokens {
+		transfer := &data.ExecutionTokenTransfer{
+			Token: token,
+		}
+		if i < len(values) {
+			transfer.Value = values[i]
+		}
+
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers
+}
+
+func getLogEvents(logs *transaction.ApiLogs) []*transaction.Events {
+	if logs == nil {
+		return nil
+	}
+
+	return logs.Events
+}
+
+// findFailurePoint returns the deepest node which has signalled an error, as the error is then propagated back by the
+// smart contract results and callbacks which follow it. If no node has signalled an error, the first failed node is returned
+func findFailurePoint(root *data.TransactionExecutionNode) *data.TransactionExecutionNode {
+	var firstFailed *data.TransactionExecutionNode
+	var deepestSignalled *data.TransactionExecutionNode
+	deepestSignalledDepth := -1
+
+	var visit func(node *data.TransactionExecutionNode, depth int)
+	visit = func(node *data.TransactionExecutionNode, depth int) {
+		if node.Failed && firstFailed == nil {
+			firstFailed = node
+		}
+		if node.Failed && hasErrorEvent(node.Events) && depth > deepestSignalledDepth {
+			deepestSignalled = node
+			deepestSignalledDepth = depth
+		}
+
+		for _, child := range node.Children {
+			visit(child, depth+1)
+		}
+	}
+	visit(root, 0)
+
+	if deepestSignalled != nil {
+		return deepestSignalled
+	}
+
+	return firstFailed
+}
+
+func hasErrorEvent(events []*transaction.Events) bool {
+	for _, event := range events {
+		if event.Identifier == internalVMErrorsEventIdentifier || event.Identifier == core.SignalErrorOperation {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,209 @@
+package process_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createTransactionProcessorForExecutionGraph(tx *transaction.ApiTransactionResult) *process.TransactionProcessor {
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0}
+			},
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				// the addresses starting with 0x01 are in shard 1, all the others in shard 0
+				return uint32(addressBuff[0]), nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				txResponse, ok := value.(*data.GetTransactionResponse)
+				if !ok {
+					return http.StatusOK, nil
+				}
+				if tx == nil {
+					return http.StatusNotFound, nil
+				}
+
+				txResponse.Data.Transaction = *tx
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		false,
+	)
+
+	return tp
+}
+
+func TestTransactionProcessor_GetTransactionExecutionGraph(t *testing.T) {
+	t.Parallel()
+
+	sender := hex.EncodeToString([]byte{0, 1})
+	contract := hex.EncodeToString([]byte{0, 2})
+	otherContract := hex.EncodeToString([]byte{1, 3})
+
+	t.Run("transaction not found should error", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTransactionProcessorForExecutionGraph(nil)
+		graph, err := tp.GetTransactionExecutionGraph("txHash")
+		require.Nil(t, graph)
+		require.Equal(t, errors.ErrTransactionNotFound, err)
+	})
+	t.Run("move balance should return a single node", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTransactionProcessorForExecutionGraph(&transaction.ApiTransactionResult{
+			Hash:     "txHash",
+			Sender:   sender,
+			Receiver: contract,
+			Value:    "1000",
+			Status:   transaction.TxStatusSuccess,
+		})
+		graph, err := tp.GetTransactionExecutionGraph("txHash")
+		require.Nil(t, err)
+		require.Equal(t, string(transaction.TxStatusSuccess), graph.Status)
+		require.Empty(t, graph.FailurePoint)
+		require.Equal(t, &data.TransactionExecutionNode{
+			Hash:     "txHash",
+			Type:     data.ExecutionNodeTypeTransaction,
+			Sender:   sender,
+			Receiver: contract,
+			Value:    "1000",
+		}, graph.Root)
+	})
+	t.Run("failed async call should return the failure point", func(t *testing.T) {
+		t.Parallel()
+
+		signalErrorEvent := &transaction.Events{
+			Address:    otherContract,
+			Identifier: core.SignalErrorOperation,
+			Data:       []byte("insufficient funds"),
+		}
+		tx := &transaction.ApiTransactionResult{
+			Hash:       "txHash",
+			Sender:     sender,
+			Receiver:   contract,
+			Value:      "0",
+			Tokens:     []string{"TKN-123456"},
+			ESDTValues: []string{"100"},
+			Function:   "swap",
+			Status:     transaction.TxStatusSuccess,
+			SmartContractResults: []*transaction.ApiSmartContractResult{
+				{
+					Hash:          "refund",
+					Nonce:         3,
+					Value:         big.NewInt(50),
+					SndAddr:       contract,
+					RcvAddr:       sender,
+					PrevTxHash:    "callback",
+					ReturnMessage: "gas refund for relayer",
+				},
+				{
+					Hash:          "callback",
+					Nonce:         2,
+					Value:         big.NewInt(0),
+					SndAddr:       otherContract,
+					RcvAddr:       contract,
+					PrevTxHash:    "asyncCall",
+					CallType:      vm.AsynchronousCallBack,
+					ReturnMessage: "insufficient funds",
+				},
+				{
+					Hash:       "asyncCall",
+					Nonce:      1,
+					Value:      big.NewInt(0),
+					SndAddr:    contract,
+					RcvAddr:    otherContract,
+					PrevTxHash: "txHash",
+					CallType:   vm.AsynchronousCall,
+					Function:   "deposit",
+					Logs: &transaction.ApiLogs{
+						Events: []*transaction.Events{signalErrorEvent},
+					},
+				},
+			},
+		}
+
+		tp := createTransactionProcessorForExecutionGraph(tx)
+		graph, err := tp.GetTransactionExecutionGraph("txHash")
+		require.Nil(t, err)
+		require.Equal(t, string(transaction.TxStatusFail), graph.Status)
+		require.Equal(t, "asyncCall", graph.FailurePoint)
+		require.Equal(t, "insufficient funds", graph.FailureReason)
+
+		root := graph.Root
+		require.Equal(t, []*data.ExecutionTokenTransfer{{Token: "TKN-123456", Value: "100"}}, root.TokenTransfers)
+		require.False(t, root.Failed)
+		require.Len(t, root.Children, 1)
+
+		asyncCall := root.Children[0]
+		require.Equal(t, "asyncCall", asyncCall.Hash)
+		require.Equal(t, vm.AsynchronousCallStr, asyncCall.CallType)
+		require.Equal(t, uint32(0), asyncCall.SourceShard)
+		require.Equal(t, uint32(1), asyncCall.ExecutionShard)
+		require.Equal(t, []*transaction.Events{signalErrorEvent}, asyncCall.Events)
+		require.True(t, asyncCall.Failed)
+		require.Len(t, asyncCall.Children, 1)
+
+		callback := asyncCall.Children[0]
+		require.Equal(t, "callback", callback.Hash)
+		require.Equal(t, vm.AsynchronousCallBackStr, callback.CallType)
+		require.Equal(t, uint32(1), callback.SourceShard)
+		require.Equal(t, uint32(0), callback.ExecutionShard)
+		require.True(t, callback.Failed)
+		require.Len(t, callback.Children, 1)
+
+		refund := callback.Children[0]
+		require.Equal(t, "refund", refund.Hash)
+		require.Equal(t, "50", refund.Value)
+		require.False(t, refund.Failed)
+	})
+	t.Run("smart contract result with unknown parent should be attached to the transaction", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.ApiTransactionResult{
+			Hash:     "txHash",
+			Sender:   sender,
+			Receiver: contract,
+			Status:   transaction.TxStatusFail,
+			SmartContractResults: []*transaction.ApiSmartContractResult{
+				{
+					Hash:          "scr",
+					SndAddr:       contract,
+					RcvAddr:       sender,
+					PrevTxHash:    "missing",
+					ReturnMessage: "function not found",
+				},
+			},
+		}
+
+		tp := createTransactionProcessorForExecutionGraph(tx)
+		graph, err := tp.GetTransactionExecutionGraph("txHash")
+		require.Nil(t, err)
+		require.Len(t, graph.Root.Children, 1)
+		require.Equal(t, "scr", graph.Root.Children[0].Hash)
+		require.Equal(t, "0", graph.Root.Children[0].Value)
+		// no error events, so the first failed node is the failure point
+		require.Equal(t, "txHash", graph.FailurePoint)
+		require.Equal(t, string(transaction.TxStatusFail), graph.Status)
+	})
+}
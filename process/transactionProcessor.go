@@ -47,6 +47,7 @@ const (
 	relayedV2TransactionDescriptor  = "RelayedTxV2"
 	relayedV3TransactionDescriptor  = "RelayedTxV3"
 	emptyDataStr                    = ""
+	poolGasFields                   = "gasprice,gaslimit"
 )
 
 type requestType int
@@ -291,6 +292,7 @@ func (tp *TransactionProcessor) SendMultipleTransactions(txs []*data.Transaction
 
 	totalTxsSent := uint64(0)
 	txsToSend := make([]*data.Transaction, 0)
+	txsToSendIndices := make([]int, 0)
 	for i := 0; i < len(txs); i++ {
 		currentTx := txs[i]
 		err := tp.checkTransactionFields(currentTx)
@@ -302,6 +304,7 @@ func (tp *TransactionProcessor) SendMultipleTransactions(txs []*data.Transaction
 			continue
 		}
 		txsToSend = append(txsToSend, currentTx)
+		txsToSendIndices = append(txsToSendIndices, i)
 	}
 	if len(txsToSend) == 0 {
 		return data.MultipleTransactionsResponseData{}, ErrNoValidTransactionToSend
@@ -327,7 +330,7 @@ func (tp *TransactionProcessor) SendMultipleTransactions(txs []*data.Transaction
 				totalTxsSent += txResponse.Data.NumOfTxs
 
 				for key, hash := range txResponse.Data.TxsHashes {
-					txsHashes[groupOfTxs[key].Index] = hash
+					txsHashes[txsToSendIndices[groupOfTxs[key].Index]] = hash
 				}
 
 				break
@@ -1254,6 +1257,12 @@ func (tp *TransactionProcessor) GetTransactionsPoolForShard(shardID uint32, fiel
 	return txPool, nil
 }
 
+// GetTransactionsPoolGasForShard returns only the gas price and the gas limit of the transactions from the pool of the
+// shard. As no other transaction field is exposed, it is not restricted by the entire pool fetch flag
+func (tp *TransactionProcessor) GetTransactionsPoolGasForShard(shardID uint32) (*data.TransactionsPool, error) {
+	return tp.getTxPoolForShard(shardID, poolGasFields)
+}
+
 // GetTransactionsPoolForSender should return transactions for sender from observer's pool
 func (tp *TransactionProcessor) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
 	txPool, err := tp.getTxPoolForSender(sender, fields)
@@ -1507,6 +1507,29 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 		assert.Nil(t, txs)
 		assert.Equal(t, apiErrors.ErrOperationNotAllowed, err)
 	})
+	t.Run("GetTransactionsPoolGasForShard, flag not enabled should work", func(t *testing.T) {
+		t.Parallel()
+
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer0", ShardId: shardId}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+				require.Equal(t, process.TransactionsPoolPath+"?fields=gasprice,gaslimit", path)
+				response := value.(*data.TransactionsPoolApiResponse)
+				response.Data.Transactions = data.TransactionsPool{
+					RegularTransactions: []data.WrappedTransaction{{TxFields: map[string]interface{}{"gasPrice": float64(1000000000)}}},
+				}
+
+				return http.StatusOK, nil
+			},
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false)
+		require.NotNil(t, tp)
+
+		txs, err := tp.GetTransactionsPoolGasForShard(1)
+		require.NoError(t, err)
+		require.Len(t, txs.RegularTransactions, 1)
+	})
 	t.Run("GetTransactionsPoolForShard, no txs in pool", func(t *testing.T) {
 		t.Parallel()
 
@@ -0,0 +1,124 @@
+package process
+
+import (
+	"context"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	defaultRoundDuration        = 6 * time.Second
+	maxWaitPollIntervalInRounds = 4
+)
+
+// TransactionWaiter polls the observers until a sent transaction reaches the requested state
+type TransactionWaiter struct {
+	txStatusProvider      TransactionStatusProvider
+	networkConfigProvider NetworkConfigHandler
+}
+
+// NewTransactionWaiter creates a new instance of TransactionWaiter
+func NewTransactionWaiter(
+	txStatusProvider TransactionStatusProvider,
+	networkConfigProvider NetworkConfigHandler,
+) (*TransactionWaiter, error) {
+	if txStatusProvider == nil {
+		return nil, ErrNilTransactionStatusProvider
+	}
+	if networkConfigProvider == nil {
+		return nil, ErrNilNetworkConfigProvider
+	}
+
+	return &TransactionWaiter{
+		txStatusProvider:      txStatusProvider,
+		networkConfigProvider: networkConfigProvider,
+	}, nil
+}
+
+// WaitForTransaction refreshes the response with the latest known status of the transaction until it reaches the
+// requested state or the timeout expires. The polling interval starts at one round and doubles after each attempt.
+// It stops early and returns the context's error if the context is done
+func (tw *TransactionWaiter) WaitForTransaction(
+	ctx context.Context,
+	response *data.TransactionSendAndWaitResponseData,
+	options common.TransactionSendOptions,
+) error {
+	roundDuration := tw.getRoundDuration()
+	maxPollInterval := roundDuration * maxWaitPollIntervalInRounds
+	pollInterval := roundDuration
+	deadline := time.Now().Add(options.Timeout)
+	for {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			response.TimedOut = true
+			return nil
+		}
+
+		if pollInterval > remaining {
+			pollInterval = remaining
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+		}
+
+		if tw.isTransactionInWaitedState(response, options.WaitFor) {
+			return nil
+		}
+
+		pollInterval *= 2
+		if pollInterval > maxPollInterval {
+			pollInterval = maxPollInterval
+		}
+	}
+}
+
+// isTransactionInWaitedState refreshes the response with the latest known status and returns true if the wait target was reached
+func (tw *TransactionWaiter) isTransactionInWaitedState(response *data.TransactionSendAndWaitResponseData, waitFor string) bool {
+	processStatus, err := tw.txStatusProvider.GetProcessedTransactionStatus(response.TxHash)
+	if err != nil {
+		log.Trace("cannot get the processed status while waiting for transaction", "hash", response.TxHash, "error", err)
+		return false
+	}
+
+	response.Status = processStatus.Status
+	response.Reason = processStatus.Reason
+	isExecuted := processStatus.Status == string(transaction.TxStatusSuccess) ||
+		processStatus.Status == string(transaction.TxStatusFail)
+	if !isExecuted {
+		return false
+	}
+
+	tx, err := tw.txStatusProvider.GetTransaction(response.TxHash, true)
+	if err != nil {
+		log.Trace("cannot get the transaction while waiting for it", "hash", response.TxHash, "error", err)
+		return false
+	}
+	response.Transaction = tx
+
+	if waitFor != common.TransactionWaitForCompleted {
+		return true
+	}
+
+	// invalid transactions are never notarized at destination
+	return tx.NotarizedAtDestinationInMetaNonce > 0 || tx.Status == transaction.TxStatusInvalid
+}
+
+func (tw *TransactionWaiter) getRoundDuration() time.Duration {
+	networkCfg, err := tw.networkConfigProvider.GetNetworkConfig()
+	if err != nil || networkCfg.Config.RoundDuration == 0 {
+		log.Debug("cannot get the round duration from network config, using default", "error", err)
+		return defaultRoundDuration
+	}
+
+	return time.Duration(networkCfg.Config.RoundDuration) * time.Millisecond
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tw *TransactionWaiter) IsInterfaceNil() bool {
+	return tw == nil
+}
@@ -0,0 +1,170 @@
+package process_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNetworkConfigHandlerWithRoundDuration(roundDurationInMilliseconds uint64) *mock.NetworkConfigHandlerStub {
+	return &mock.NetworkConfigHandlerStub{
+		GetNetworkConfigCalled: func() (*data.NetworkConfig, error) {
+			networkConfig := &data.NetworkConfig{}
+			networkConfig.Config.RoundDuration = roundDurationInMilliseconds
+			return networkConfig, nil
+		},
+	}
+}
+
+func TestNewTransactionWaiter(t *testing.T) {
+	t.Parallel()
+
+	tw, err := process.NewTransactionWaiter(nil, &mock.NetworkConfigHandlerStub{})
+	require.Nil(t, tw)
+	require.Equal(t, process.ErrNilTransactionStatusProvider, err)
+
+	tw, err = process.NewTransactionWaiter(&mock.TransactionStatusProviderStub{}, nil)
+	require.Nil(t, tw)
+	require.Equal(t, process.ErrNilNetworkConfigProvider, err)
+
+	tw, err = process.NewTransactionWaiter(&mock.TransactionStatusProviderStub{}, &mock.NetworkConfigHandlerStub{})
+	require.NotNil(t, tw)
+	require.NoError(t, err)
+	require.False(t, tw.IsInterfaceNil())
+}
+
+func TestTransactionWaiter_WaitForTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("executed should return once the outcome is known", func(t *testing.T) {
+		t.Parallel()
+
+		numStatusCalls := 0
+		tw, _ := process.NewTransactionWaiter(
+			&mock.TransactionStatusProviderStub{
+				GetProcessedTransactionStatusCalled: func(txHash string) (*data.ProcessStatusResponse, error) {
+					numStatusCalls++
+					if numStatusCalls < 3 {
+						return &data.ProcessStatusResponse{Status: string(transaction.TxStatusPending)}, nil
+					}
+
+					return &data.ProcessStatusResponse{Status: string(transaction.TxStatusFail), Reason: "reason"}, nil
+				},
+				GetTransactionCalled: func(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error) {
+					assert.True(t, withEvents)
+					return &transaction.ApiTransactionResult{Hash: txHash, Status: transaction.TxStatusSuccess}, nil
+				},
+			},
+			createNetworkConfigHandlerWithRoundDuration(1),
+		)
+
+		response := &data.TransactionSendAndWaitResponseData{TxHash: "hash"}
+		err := tw.WaitForTransaction(context.Background(), response, common.TransactionSendOptions{
+			WaitFor: common.TransactionWaitForExecuted,
+			Timeout: time.Minute,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, 3, numStatusCalls)
+		assert.Equal(t, string(transaction.TxStatusFail), response.Status)
+		assert.Equal(t, "reason", response.Reason)
+		assert.False(t, response.TimedOut)
+		assert.Equal(t, "hash", response.Transaction.Hash)
+	})
+	t.Run("completed should wait for the notarization at destination", func(t *testing.T) {
+		t.Parallel()
+
+		numGetTransactionCalls := 0
+		tw, _ := process.NewTransactionWaiter(
+			&mock.TransactionStatusProviderStub{
+				GetProcessedTransactionStatusCalled: func(txHash string) (*data.ProcessStatusResponse, error) {
+					return &data.ProcessStatusResponse{Status: string(transaction.TxStatusSuccess)}, nil
+				},
+				GetTransactionCalled: func(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error) {
+					numGetTransactionCalls++
+					tx := &transaction.ApiTransactionResult{Hash: txHash, Status: transaction.TxStatusSuccess}
+					if numGetTransactionCalls > 1 {
+						tx.NotarizedAtDestinationInMetaNonce = 10
+					}
+
+					return tx, nil
+				},
+			},
+			createNetworkConfigHandlerWithRoundDuration(1),
+		)
+
+		response := &data.TransactionSendAndWaitResponseData{TxHash: "hash"}
+		err := tw.WaitForTransaction(context.Background(), response, common.TransactionSendOptions{
+			WaitFor: common.TransactionWaitForCompleted,
+			Timeout: time.Minute,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, 2, numGetTransactionCalls)
+		assert.False(t, response.TimedOut)
+		assert.Equal(t, uint64(10), response.Transaction.NotarizedAtDestinationInMetaNonce)
+	})
+	t.Run("timeout should return the last known status", func(t *testing.T) {
+		t.Parallel()
+
+		tw, _ := process.NewTransactionWaiter(
+			&mock.TransactionStatusProviderStub{
+				GetProcessedTransactionStatusCalled: func(txHash string) (*data.ProcessStatusResponse, error) {
+					return &data.ProcessStatusResponse{Status: "received"}, nil
+				},
+				GetTransactionCalled: func(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error) {
+					require.Fail(t, "should have not been called")
+					return nil, nil
+				},
+			},
+			createNetworkConfigHandlerWithRoundDuration(1),
+		)
+
+		response := &data.TransactionSendAndWaitResponseData{TxHash: "hash"}
+		err := tw.WaitForTransaction(context.Background(), response, common.TransactionSendOptions{
+			WaitFor: common.TransactionWaitForExecuted,
+			Timeout: 20 * time.Millisecond,
+		})
+		require.Nil(t, err)
+		assert.True(t, response.TimedOut)
+		assert.Equal(t, "received", response.Status)
+		assert.Nil(t, response.Transaction)
+	})
+	t.Run("done context should stop the wait", func(t *testing.T) {
+		t.Parallel()
+
+		numStatusCalls := uint32(0)
+		tw, _ := process.NewTransactionWaiter(
+			&mock.TransactionStatusProviderStub{
+				GetProcessedTransactionStatusCalled: func(txHash string) (*data.ProcessStatusResponse, error) {
+					atomic.AddUint32(&numStatusCalls, 1)
+					return &data.ProcessStatusResponse{Status: "received"}, nil
+				},
+			},
+			createNetworkConfigHandlerWithRoundDuration(60000),
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			cancel()
+		}()
+
+		start := time.Now()
+		response := &data.TransactionSendAndWaitResponseData{TxHash: "hash"}
+		err := tw.WaitForTransaction(ctx, response, common.TransactionSendOptions{
+			WaitFor: common.TransactionWaitForExecuted,
+			Timeout: time.Minute,
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.Less(t, time.Since(start), time.Second)
+		assert.Zero(t, atomic.LoadUint32(&numStatusCalls))
+	})
+}
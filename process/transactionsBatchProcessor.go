@@ -0,0 +1,304 @@
+package process
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	poolNonceField                  = "nonce"
+	bundleLimitationStateNotChained = "the observers simulate each transaction against the current state, so the effects " +
+		"of the previous transactions of the bundle are not applied"
+	bundleLimitationNonceReplaced = "simulated with the nonce of the sender's first transaction of the bundle and without " +
+		"checking the signature, as the observers only accept the current nonce of the account"
+)
+
+// TransactionsBatchProcessor handles the batches of transactions which have to be processed in order: the ordered
+// sending of each sender's transactions and the simulation of bundles
+type TransactionsBatchProcessor struct {
+	nonceProvider  NextNonceProvider
+	txPoolProvider TransactionsPoolForSenderProvider
+	txSimulator    TransactionSimulator
+}
+
+// NewTransactionsBatchProcessor creates a new instance of TransactionsBatchProcessor
+func NewTransactionsBatchProcessor(
+	nonceProvider NextNonceProvider,
+	txPoolProvider TransactionsPoolForSenderProvider,
+	txSimulator TransactionSimulator,
+) (*TransactionsBatchProcessor, error) {
+	if nonceProvider == nil {
+		return nil, ErrNilNextNonceProvider
+	}
+	if txPoolProvider == nil {
+		return nil, ErrNilTransactionsPoolForSenderProvider
+	}
+	if txSimulator == nil {
+		return nil, ErrNilTransactionSimulator
+	}
+
+	return &TransactionsBatchProcessor{
+		nonceProvider:  nonceProvider,
+		txPoolProvider: txPoolProvider,
+		txSimulator:    txSimulator,
+	}, nil
+}
+
+// SendOrderedTransactions sends the transactions of each sender one by one, in nonce order, after checking the nonces
+// against the account and the transactions pool. A transaction may either continue the sender's nonces or replace a
+// transaction of the sender which is already in pool. The outcome of each transaction is reported
+func (tbp *TransactionsBatchProcessor) SendOrderedTransactions(
+	txs []*data.Transaction,
+	stopOnRejection bool,
+	sendHandler func(tx *data.Transaction) (int, string, error),
+) (*data.OrderedTransactionsResponseData, error) {
+	if sendHandler == nil {
+		return nil, ErrNilTransactionSendHandler
+	}
+
+	results := make([]*data.OrderedTransactionResult, len(txs))
+	senders := make([]string, 0)
+	txsIndexesBySender := make(map[string][]int)
+	for idx, tx := range txs {
+		results[idx] = &data.OrderedTransactionResult{
+			Index:  idx,
+			Sender: tx.Sender,
+			Nonce:  tx.Nonce,
+		}
+
+		_, found := txsIndexesBySender[tx.Sender]
+		if !found {
+			senders = append(senders, tx.Sender)
+		}
+		txsIndexesBySender[tx.Sender] = append(txsIndexesBySender[tx.Sender], idx)
+	}
+
+	response := &data.OrderedTransactionsResponseData{
+		Transactions: results,
+	}
+	for _, sender := range senders {
+		response.NumOfSentTxs += tbp.sendOrderedTransactionsOfSender(sender, txs, txsIndexesBySender[sender], results, stopOnRejection, sendHandler)
+	}
+
+	return response, nil
+}
+
+func (tbp *TransactionsBatchProcessor) sendOrderedTransactionsOfSender(
+	sender string,
+	txs []*data.Transaction,
+	indexes []int,
+	results []*data.OrderedTransactionResult,
+	stopOnRejection bool,
+	sendHandler func(tx *data.Transaction) (int, string, error),
+) uint64 {
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return txs[indexes[i]].Nonce < txs[indexes[j]].Nonce
+	})
+
+	nextNonce, err := tbp.nonceProvider.GetNextNonce(sender, common.NextNonceOptions{})
+	if err != nil {
+		for _, idx := range indexes {
+			setOrderedTxRejected(results[idx], fmt.Sprintf("cannot get the nonce of the sender: %s", err.Error()))
+		}
+		return 0
+	}
+
+	poolNonces, err := tbp.getPoolNonces(sender)
+	if err != nil {
+		for _, idx := range indexes {
+			setOrderedTxRejected(results[idx], fmt.Sprintf("cannot get the transactions pool of the sender: %s", err.Error()))
+		}
+		return 0
+	}
+
+	numSent := uint64(0)
+	expectedNonce := nextNonce.Nonce
+	isChainStopped := false
+	for i, idx := range indexes {
+		result := results[idx]
+		if isChainStopped {
+			result.Status = data.OrderedTxStatusSkipped
+			result.Reason = "a previous transaction of the sender was rejected"
+			continue
+		}
+
+		tx := txs[idx]
+		isDuplicated := i > 0 && txs[indexes[i-1]].Nonce == tx.Nonce
+		_, isInPool := poolNonces[tx.Nonce]
+		reason := checkOrderedTxNonce(tx.Nonce, nextNonce.AccountNonce, expectedNonce, isDuplicated, isInPool)
+		if len(reason) == 0 {
+			var txHash string
+			_, txHash, err = sendHandler(tx)
+			if err == nil {
+				result.Status = data.OrderedTxStatusAccepted
+				result.TxHash = txHash
+				numSent++
+				if tx.Nonce >= expectedNonce {
+					expectedNonce = tx.Nonce + 1
+				}
+				continue
+			}
+
+			reason = err.Error()
+		}
+
+		setOrderedTxRejected(result, reason)
+		isChainStopped = stopOnRejection
+	}
+
+	return numSent
+}
+
+// getPoolNonces returns the nonces of the sender's transactions which are in pool, so that their replacements are
+// not mistaken for nonce gaps
+func (tbp *TransactionsBatchProcessor) getPoolNonces(sender string) (map[uint64]struct{}, error) {
+	txPool, err := tbp.txPoolProvider.GetTransactionsPoolForSender(sender, poolNonceField)
+	if err != nil {
+		return nil, err
+	}
+
+	poolNonces := make(map[uint64]struct{}, len(txPool.Transactions))
+	for _, wrappedTx := range txPool.Transactions {
+		poolNonces[wrappedTx.GetUint64Field(poolNonceField)] = struct{}{}
+	}
+
+	return poolNonces, nil
+}
+
+func checkOrderedTxNonce(nonce uint64, accountNonce uint64, expectedNonce uint64, isDuplicated bool, isInPool bool) string {
+	if isDuplicated {
+		return "duplicated nonce in batch"
+	}
+	if nonce < accountNonce {
+		return fmt.Sprintf("nonce too low, the account nonce is %d", accountNonce)
+	}
+	if nonce > expectedNonce && !isInPool {
+		return fmt.Sprintf("nonce gap, expected nonce %d", expectedNonce)
+	}
+
+	return ""
+}
+
+func setOrderedTxRejected(result *data.OrderedTransactionResult, reason string) {
+	result.Status = data.OrderedTxStatusRejected
+	result.Reason = reason
+}
+
+// SimulateTransactionsBundle simulates the transactions one by one, in the provided order, and stops at the first failure.
+// The observers cannot chain the state between simulations, so the transactions which depend on the previous ones are
+// reported as such. The nonces of each sender must be consecutive
+func (tbp *TransactionsBatchProcessor) SimulateTransactionsBundle(txs []*data.Transaction, checkSignature bool) (*data.BundleSimulationResponseData, error) {
+	results := make([]*data.BundleSimulationTransactionResult, len(txs))
+	for idx, tx := range txs {
+		results[idx] = &data.BundleSimulationTransactionResult{
+			Index:  idx,
+			Sender: tx.Sender,
+			Nonce:  tx.Nonce,
+			Status: data.BundleTxStatusSkipped,
+		}
+	}
+
+	response := &data.BundleSimulationResponseData{
+		Transactions: results,
+	}
+	touchedAddresses := make(map[string]struct{})
+	firstNonces := make(map[string]uint64)
+	lastNonces := make(map[string]uint64)
+	for idx, tx := range txs {
+		result := results[idx]
+		txToSimulate := tx
+		checkTxSignature := checkSignature
+
+		firstNonce, isKnownSender := firstNonces[tx.Sender]
+		if isKnownSender {
+			expectedNonce := lastNonces[tx.Sender] + 1
+			if tx.Nonce != expectedNonce {
+				result.Status = data.BundleTxStatusFail
+				result.FailReason = fmt.Sprintf("nonce gap, expected nonce %d", expectedNonce)
+				break
+			}
+
+			txCopy := *tx
+			txCopy.Nonce = firstNonce
+			txToSimulate = &txCopy
+			checkTxSignature = false
+			result.Limitation = bundleLimitationNonceReplaced
+		}
+
+		_, isSenderTouched := touchedAddresses[tx.Sender]
+		_, isReceiverTouched := touchedAddresses[tx.Receiver]
+		if (isSenderTouched || isReceiverTouched) && len(result.Limitation) == 0 {
+			result.Limitation = bundleLimitationStateNotChained
+		}
+		if len(result.Limitation) > 0 {
+			response.Limitation = bundleLimitationStateNotChained
+		}
+
+		response.NumSimulated++
+		simulation, err := tbp.txSimulator.SimulateTransaction(txToSimulate, checkTxSignature)
+		if err != nil {
+			result.Status = data.BundleTxStatusFail
+			result.FailReason = err.Error()
+			break
+		}
+
+		result.Result = simulation.Data
+		failReason, failed := getSimulationFailure(simulation)
+		if failed {
+			result.Status = data.BundleTxStatusFail
+			result.FailReason = failReason
+			break
+		}
+
+		result.Status = data.BundleTxStatusSuccess
+		touchedAddresses[tx.Sender] = struct{}{}
+		touchedAddresses[tx.Receiver] = struct{}{}
+		if !isKnownSender {
+			firstNonces[tx.Sender] = tx.Nonce
+		}
+		lastNonces[tx.Sender] = tx.Nonce
+	}
+
+	return response, nil
+}
+
+func getSimulationFailure(simulation *data.GenericAPIResponse) (string, bool) {
+	if len(simulation.Error) > 0 {
+		return simulation.Error, true
+	}
+
+	switch simulationData := simulation.Data.(type) {
+	case data.TransactionSimulationResponseData:
+		return getSimulationResultsFailure(simulationData.Result)
+	case data.TransactionSimulationResponseDataCrossShard:
+		shards := make([]string, 0, len(simulationData.Result))
+		for shard := range simulationData.Result {
+			shards = append(shards, shard)
+		}
+		sort.Strings(shards)
+
+		for _, shard := range shards {
+			failReason, failed := getSimulationResultsFailure(simulationData.Result[shard])
+			if failed {
+				return failReason, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func getSimulationResultsFailure(results data.TransactionSimulationResults) (string, bool) {
+	failed := results.Status == transaction.TxStatusFail ||
+		results.Status == transaction.TxStatusInvalid ||
+		len(results.FailReason) > 0
+	if failed && len(results.FailReason) == 0 {
+		return string(results.Status), true
+	}
+
+	return results.FailReason, failed
+}
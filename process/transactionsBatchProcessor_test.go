@@ -0,0 +1,360 @@
+package process_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTransactionsBatchProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil next nonce provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		tbp, err := process.NewTransactionsBatchProcessor(nil, &mock.TransactionsPoolForSenderProviderStub{}, &mock.TransactionSimulatorStub{})
+		require.Nil(t, tbp)
+		require.Equal(t, process.ErrNilNextNonceProvider, err)
+	})
+	t.Run("nil transactions pool for sender provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		tbp, err := process.NewTransactionsBatchProcessor(&mock.NextNonceProviderStub{}, nil, &mock.TransactionSimulatorStub{})
+		require.Nil(t, tbp)
+		require.Equal(t, process.ErrNilTransactionsPoolForSenderProvider, err)
+	})
+	t.Run("nil transaction simulator should error", func(t *testing.T) {
+		t.Parallel()
+
+		tbp, err := process.NewTransactionsBatchProcessor(&mock.NextNonceProviderStub{}, &mock.TransactionsPoolForSenderProviderStub{}, nil)
+		require.Nil(t, tbp)
+		require.Equal(t, process.ErrNilTransactionSimulator, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tbp, err := process.NewTransactionsBatchProcessor(&mock.NextNonceProviderStub{}, &mock.TransactionsPoolForSenderProviderStub{}, &mock.TransactionSimulatorStub{})
+		require.Nil(t, err)
+		require.NotNil(t, tbp)
+	})
+}
+
+func TestTransactionsBatchProcessor_SendOrderedTransactions(t *testing.T) {
+	t.Parallel()
+
+	nonceProvider := &mock.NextNonceProviderStub{
+		GetNextNonceCalled: func(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error) {
+			assert.False(t, options.Reserve)
+			switch address {
+			case "alice":
+				return &data.NextNonceResponseData{AccountNonce: 5, Nonce: 6}, nil
+			case "bob":
+				return &data.NextNonceResponseData{AccountNonce: 0, Nonce: 0}, nil
+			default:
+				return nil, errors.New("unknown sender")
+			}
+		},
+	}
+
+	t.Run("nil send handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		tbp, _ := process.NewTransactionsBatchProcessor(nonceProvider, &mock.TransactionsPoolForSenderProviderStub{}, &mock.TransactionSimulatorStub{})
+
+		response, err := tbp.SendOrderedTransactions([]*data.Transaction{{Sender: "alice", Nonce: 6}}, false, nil)
+		require.Nil(t, response)
+		require.Equal(t, process.ErrNilTransactionSendHandler, err)
+	})
+	t.Run("should sort by nonce and detect gaps and duplicates", func(t *testing.T) {
+		t.Parallel()
+
+		sentNonces := make([]string, 0)
+		sendHandler := func(tx *data.Transaction) (int, string, error) {
+			sentNonces = append(sentNonces, fmt.Sprintf("%s-%d", tx.Sender, tx.Nonce))
+			return http.StatusOK, fmt.Sprintf("hash-%s-%d", tx.Sender, tx.Nonce), nil
+		}
+		tbp, _ := process.NewTransactionsBatchProcessor(nonceProvider, &mock.TransactionsPoolForSenderProviderStub{}, &mock.TransactionSimulatorStub{})
+
+		txs := []*data.Transaction{
+			{Sender: "alice", Nonce: 7},
+			{Sender: "bob", Nonce: 0},
+			{Sender: "alice", Nonce: 6},
+			{Sender: "alice", Nonce: 9},
+			{Sender: "bob", Nonce: 0},
+			{Sender: "alice", Nonce: 4},
+			{Sender: "carol", Nonce: 1},
+		}
+		response, err := tbp.SendOrderedTransactions(txs, false, sendHandler)
+		require.Nil(t, err)
+
+		assert.Equal(t, []string{"alice-6", "alice-7", "bob-0"}, sentNonces)
+		assert.Equal(t, uint64(3), response.NumOfSentTxs)
+		require.Equal(t, len(txs), len(response.Transactions))
+
+		expectedStatuses := []string{
+			data.OrderedTxStatusAccepted,
+			data.OrderedTxStatusAccepted,
+			data.OrderedTxStatusAccepted,
+			data.OrderedTxStatusRejected,
+			data.OrderedTxStatusRejected,
+			data.OrderedTxStatusRejected,
+			data.OrderedTxStatusRejected,
+		}
+		for idx, result := range response.Transactions {
+			assert.Equal(t, idx, result.Index)
+			assert.Equal(t, expectedStatuses[idx], result.Status, "index %d", idx)
+		}
+		assert.Equal(t, "hash-alice-7", response.Transactions[0].TxHash)
+		assert.Equal(t, "nonce gap, expected nonce 8", response.Transactions[3].Reason)
+		assert.Equal(t, "duplicated nonce in batch", response.Transactions[4].Reason)
+		assert.Equal(t, "nonce too low, the account nonce is 5", response.Transactions[5].Reason)
+		assert.True(t, strings.Contains(response.Transactions[6].Reason, "unknown sender"))
+	})
+	t.Run("stop on rejection should skip the rest of the sender's transactions", func(t *testing.T) {
+		t.Parallel()
+
+		sendHandler := func(tx *data.Transaction) (int, string, error) {
+			if tx.Nonce == 7 {
+				return http.StatusBadRequest, "", errors.New("insufficient funds")
+			}
+
+			return http.StatusOK, "hash", nil
+		}
+		tbp, _ := process.NewTransactionsBatchProcessor(nonceProvider, &mock.TransactionsPoolForSenderProviderStub{}, &mock.TransactionSimulatorStub{})
+
+		txs := []*data.Transaction{
+			{Sender: "alice", Nonce: 8},
+			{Sender: "alice", Nonce: 7},
+			{Sender: "alice", Nonce: 6},
+		}
+		response, err := tbp.SendOrderedTransactions(txs, true, sendHandler)
+		require.Nil(t, err)
+
+		assert.Equal(t, uint64(1), response.NumOfSentTxs)
+		assert.Equal(t, data.OrderedTxStatusSkipped, response.Transactions[0].Status)
+		assert.Equal(t, data.OrderedTxStatusRejected, response.Transactions[1].Status)
+		assert.Equal(t, "insufficient funds", response.Transactions[1].Reason)
+		assert.Equal(t, data.OrderedTxStatusAccepted, response.Transactions[2].Status)
+	})
+	t.Run("replacement of a transaction from pool should not be reported as nonce gap", func(t *testing.T) {
+		t.Parallel()
+
+		// the pool holds the nonces 0 and 2, so the recommended nonce is the gap at 1
+		nonceProviderWithGap := &mock.NextNonceProviderStub{
+			GetNextNonceCalled: func(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error) {
+				return &data.NextNonceResponseData{AccountNonce: 0, Nonce: 1}, nil
+			},
+		}
+		txPoolProvider := &mock.TransactionsPoolForSenderProviderStub{
+			GetTransactionsPoolForSenderCalled: func(sender, fields string) (*data.TransactionsPoolForSender, error) {
+				assert.Equal(t, "nonce", fields)
+				return &data.TransactionsPoolForSender{
+					Transactions: []data.WrappedTransaction{
+						{TxFields: map[string]interface{}{"nonce": float64(0)}},
+						{TxFields: map[string]interface{}{"nonce": float64(2)}},
+					},
+				}, nil
+			},
+		}
+		sentNonces := make([]uint64, 0)
+		sendHandler := func(tx *data.Transaction) (int, string, error) {
+			sentNonces = append(sentNonces, tx.Nonce)
+			return http.StatusOK, "hash", nil
+		}
+		tbp, _ := process.NewTransactionsBatchProcessor(nonceProviderWithGap, txPoolProvider, &mock.TransactionSimulatorStub{})
+
+		txs := []*data.Transaction{
+			{Sender: "alice", Nonce: 2},
+			{Sender: "alice", Nonce: 0},
+			{Sender: "alice", Nonce: 4},
+		}
+		response, err := tbp.SendOrderedTransactions(txs, false, sendHandler)
+		require.Nil(t, err)
+
+		assert.Equal(t, []uint64{0, 2}, sentNonces)
+		assert.Equal(t, data.OrderedTxStatusAccepted, response.Transactions[0].Status)
+		assert.Equal(t, data.OrderedTxStatusAccepted, response.Transactions[1].Status)
+		assert.Equal(t, data.OrderedTxStatusRejected, response.Transactions[2].Status)
+		assert.Equal(t, "nonce gap, expected nonce 3", response.Transactions[2].Reason)
+	})
+	t.Run("transactions pool error should reject the sender's transactions", func(t *testing.T) {
+		t.Parallel()
+
+		txPoolProvider := &mock.TransactionsPoolForSenderProviderStub{
+			GetTransactionsPoolForSenderCalled: func(sender, fields string) (*data.TransactionsPoolForSender, error) {
+				return nil, errors.New("pool error")
+			},
+		}
+		tbp, _ := process.NewTransactionsBatchProcessor(nonceProvider, txPoolProvider, &mock.TransactionSimulatorStub{})
+
+		response, err := tbp.SendOrderedTransactions([]*data.Transaction{{Sender: "alice", Nonce: 6}}, false, func(tx *data.Transaction) (int, string, error) {
+			require.Fail(t, "should have not been called")
+			return http.StatusOK, "", nil
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, uint64(0), response.NumOfSentTxs)
+		assert.Equal(t, data.OrderedTxStatusRejected, response.Transactions[0].Status)
+		assert.Equal(t, "cannot get the transactions pool of the sender: pool error", response.Transactions[0].Reason)
+	})
+}
+
+func TestTransactionsBatchProcessor_SimulateTransactionsBundle(t *testing.T) {
+	t.Parallel()
+
+	successfulSimulation := func(tx *data.Transaction) *data.GenericAPIResponse {
+		return &data.GenericAPIResponse{
+			Data: data.TransactionSimulationResponseData{
+				Result: data.TransactionSimulationResults{
+					Status: transaction.TxStatusSuccess,
+					Hash:   fmt.Sprintf("hash-%s-%d", tx.Sender, tx.Nonce),
+				},
+			},
+		}
+	}
+
+	t.Run("should simulate in order and report the dependent transactions", func(t *testing.T) {
+		t.Parallel()
+
+		simulated := make([]string, 0)
+		txSimulator := &mock.TransactionSimulatorStub{
+			SimulateTransactionCalled: func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+				simulated = append(simulated, fmt.Sprintf("%s-%d-%v", tx.Sender, tx.Nonce, checkSignature))
+				return successfulSimulation(tx), nil
+			},
+		}
+		tbp, _ := process.NewTransactionsBatchProcessor(&mock.NextNonceProviderStub{}, &mock.TransactionsPoolForSenderProviderStub{}, txSimulator)
+
+		txs := []*data.Transaction{
+			{Sender: "alice", Receiver: "token", Nonce: 5},
+			{Sender: "bob", Receiver: "carol", Nonce: 1},
+			{Sender: "alice", Receiver: "dex", Nonce: 6},
+			{Sender: "dave", Receiver: "bob", Nonce: 3},
+		}
+		response, err := tbp.SimulateTransactionsBundle(txs, true)
+		require.Nil(t, err)
+
+		require.Equal(t, []string{"alice-5-true", "bob-1-true", "alice-5-false", "dave-3-true"}, simulated)
+		require.Equal(t, 4, response.NumSimulated)
+		require.False(t, response.StateChained)
+		require.NotEmpty(t, response.Limitation)
+		for _, result := range response.Transactions {
+			require.Equal(t, data.BundleTxStatusSuccess, result.Status)
+		}
+		require.Empty(t, response.Transactions[0].Limitation)
+		require.Empty(t, response.Transactions[1].Limitation)
+		require.NotEmpty(t, response.Transactions[2].Limitation)
+		require.NotEmpty(t, response.Transactions[3].Limitation)
+		require.Equal(t, uint64(6), response.Transactions[2].Nonce)
+		require.Equal(t, successfulSimulation(txs[1]).Data, response.Transactions[1].Result)
+	})
+	t.Run("independent transactions should not report limitations", func(t *testing.T) {
+		t.Parallel()
+
+		txSimulator := &mock.TransactionSimulatorStub{
+			SimulateTransactionCalled: func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+				return successfulSimulation(tx), nil
+			},
+		}
+		tbp, _ := process.NewTransactionsBatchProcessor(&mock.NextNonceProviderStub{}, &mock.TransactionsPoolForSenderProviderStub{}, txSimulator)
+
+		txs := []*data.Transaction{
+			{Sender: "alice", Receiver: "bob", Nonce: 5},
+			{Sender: "carol", Receiver: "dave", Nonce: 1},
+		}
+		response, err := tbp.SimulateTransactionsBundle(txs, true)
+		require.Nil(t, err)
+		require.Equal(t, 2, response.NumSimulated)
+		require.Empty(t, response.Limitation)
+	})
+	t.Run("should stop at the first failure", func(t *testing.T) {
+		t.Parallel()
+
+		txSimulator := &mock.TransactionSimulatorStub{
+			SimulateTransactionCalled: func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+				if tx.Sender != "bob" {
+					return successfulSimulation(tx), nil
+				}
+
+				return &data.GenericAPIResponse{
+					Data: data.TransactionSimulationResponseDataCrossShard{
+						Result: map[string]data.TransactionSimulationResults{
+							"senderShard":   {Status: transaction.TxStatusSuccess},
+							"receiverShard": {Status: transaction.TxStatusFail, FailReason: "insufficient funds"},
+						},
+					},
+				}, nil
+			},
+		}
+		tbp, _ := process.NewTransactionsBatchProcessor(&mock.NextNonceProviderStub{}, &mock.TransactionsPoolForSenderProviderStub{}, txSimulator)
+
+		txs := []*data.Transaction{
+			{Sender: "alice", Receiver: "token", Nonce: 5},
+			{Sender: "bob", Receiver: "dex", Nonce: 1},
+			{Sender: "carol", Receiver: "dex", Nonce: 2},
+		}
+		response, err := tbp.SimulateTransactionsBundle(txs, true)
+		require.Nil(t, err)
+		require.Equal(t, 2, response.NumSimulated)
+		require.Equal(t, data.BundleTxStatusSuccess, response.Transactions[0].Status)
+		require.Equal(t, data.BundleTxStatusFail, response.Transactions[1].Status)
+		require.Equal(t, "insufficient funds", response.Transactions[1].FailReason)
+		require.Equal(t, data.BundleTxStatusSkipped, response.Transactions[2].Status)
+		require.Nil(t, response.Transactions[2].Result)
+	})
+	t.Run("simulation error should stop the bundle", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		txSimulator := &mock.TransactionSimulatorStub{
+			SimulateTransactionCalled: func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		tbp, _ := process.NewTransactionsBatchProcessor(&mock.NextNonceProviderStub{}, &mock.TransactionsPoolForSenderProviderStub{}, txSimulator)
+
+		txs := []*data.Transaction{
+			{Sender: "alice", Nonce: 5},
+			{Sender: "bob", Nonce: 1},
+		}
+		response, err := tbp.SimulateTransactionsBundle(txs, false)
+		require.Nil(t, err)
+		require.Equal(t, 1, response.NumSimulated)
+		require.Equal(t, data.BundleTxStatusFail, response.Transactions[0].Status)
+		require.Equal(t, expectedErr.Error(), response.Transactions[0].FailReason)
+		require.Equal(t, data.BundleTxStatusSkipped, response.Transactions[1].Status)
+	})
+	t.Run("nonce gap should fail without simulating", func(t *testing.T) {
+		t.Parallel()
+
+		numSimulated := 0
+		txSimulator := &mock.TransactionSimulatorStub{
+			SimulateTransactionCalled: func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+				numSimulated++
+				return successfulSimulation(tx), nil
+			},
+		}
+		tbp, _ := process.NewTransactionsBatchProcessor(&mock.NextNonceProviderStub{}, &mock.TransactionsPoolForSenderProviderStub{}, txSimulator)
+
+		txs := []*data.Transaction{
+			{Sender: "alice", Nonce: 5},
+			{Sender: "alice", Nonce: 7},
+		}
+		response, err := tbp.SimulateTransactionsBundle(txs, true)
+		require.Nil(t, err)
+		require.Equal(t, 1, numSimulated)
+		require.Equal(t, 1, response.NumSimulated)
+		require.Equal(t, data.BundleTxStatusFail, response.Transactions[1].Status)
+		require.Equal(t, "nonce gap, expected nonce 6", response.Transactions[1].FailReason)
+	})
+}
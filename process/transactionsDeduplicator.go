@@ -0,0 +1,199 @@
+package process
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const idempotencyKeyPrefix = "idempotency-key:"
+
+type idempotentResponse struct {
+	fingerprint  string
+	isInProgress bool
+	response     data.MultipleTransactionsResponseData
+}
+
+// sendInProgressMarker is stored under the hash of a transaction while it is being sent, so that a concurrent request
+// with the same transaction is not sent as well
+type sendInProgressMarker struct{}
+
+// TransactionsDeduplicator prevents the transactions recently sent and the requests carrying an already used
+// idempotency key from being sent again
+type TransactionsDeduplicator struct {
+	sentTxsCache       SentTransactionsCacher
+	txHashComputer     TransactionHashComputer
+	trackedTxsProvider TrackedTransactionsProvider
+}
+
+// NewTransactionsDeduplicator creates a new instance of TransactionsDeduplicator
+func NewTransactionsDeduplicator(
+	sentTxsCache SentTransactionsCacher,
+	txHashComputer TransactionHashComputer,
+	trackedTxsProvider TrackedTransactionsProvider,
+) (*TransactionsDeduplicator, error) {
+	if sentTxsCache == nil {
+		return nil, ErrNilSentTransactionsCacher
+	}
+	if txHashComputer == nil {
+		return nil, ErrNilTransactionHashComputer
+	}
+	if trackedTxsProvider == nil {
+		return nil, ErrNilTrackedTransactionsProvider
+	}
+
+	return &TransactionsDeduplicator{
+		sentTxsCache:       sentTxsCache,
+		txHashComputer:     txHashComputer,
+		trackedTxsProvider: trackedTxsProvider,
+	}, nil
+}
+
+// SendTransaction sends the transaction through the provided handler. A transaction recently sent is not sent again,
+// the original hash being returned instead, unless the tracker abandoned it. A transaction which is still being sent
+// is rejected with a conflict
+func (td *TransactionsDeduplicator) SendTransaction(
+	tx *data.Transaction,
+	sendHandler func(tx *data.Transaction) (int, string, error),
+) (int, string, error) {
+	if sendHandler == nil {
+		return http.StatusInternalServerError, "", ErrNilTransactionSendHandler
+	}
+
+	computedTxHash, err := td.txHashComputer.ComputeTransactionHash(tx)
+	isMarkedInProgress := false
+	if err == nil {
+		cachedValue, isDuplicated := td.sentTxsCache.PutIfAbsent(computedTxHash, &sendInProgressMarker{})
+		if isDuplicated {
+			_, isInProgress := cachedValue.(*sendInProgressMarker)
+			if isInProgress {
+				return http.StatusConflict, "", apiErrors.ErrTransactionSendInProgress
+			}
+
+			sentTxHash, ok := cachedValue.(string)
+			if ok && !td.isAbandonedTransaction(sentTxHash) {
+				log.Debug("transaction already sent, returning the original response", "hash", sentTxHash)
+				return http.StatusOK, sentTxHash, nil
+			}
+
+			log.Debug("transaction abandoned by the tracker, sending it again", "hash", computedTxHash)
+			td.sentTxsCache.Put(computedTxHash, &sendInProgressMarker{})
+		}
+		isMarkedInProgress = true
+	}
+
+	statusCode, txHash, err := sendHandler(tx)
+	if err != nil {
+		if isMarkedInProgress {
+			td.sentTxsCache.Remove(computedTxHash)
+		}
+		return statusCode, txHash, err
+	}
+
+	td.sentTxsCache.Put(txHash, txHash)
+	if isMarkedInProgress && computedTxHash != txHash {
+		td.sentTxsCache.Put(computedTxHash, txHash)
+	}
+
+	return statusCode, txHash, nil
+}
+
+// isAbandonedTransaction returns true if the tracker stopped sending the transaction again after it left the pool, so
+// that a deliberate resend is not swallowed by the deduplication
+func (td *TransactionsDeduplicator) isAbandonedTransaction(txHash string) bool {
+	trackedTx, err := td.trackedTxsProvider.GetTrackedTransaction(txHash)
+	if err != nil {
+		return false
+	}
+
+	return trackedTx.Status == data.TrackedTxStatusAbandoned
+}
+
+// SendMultipleTransactions sends the transactions through the provided handler and remembers the hashes of the sent
+// ones. If an idempotency key is provided, a request repeated with the same key gets the original response, without
+// sending the transactions again
+func (td *TransactionsDeduplicator) SendMultipleTransactions(
+	txs []*data.Transaction,
+	idempotencyKey string,
+	sendHandler func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error),
+) (data.MultipleTransactionsResponseData, error) {
+	if sendHandler == nil {
+		return data.MultipleTransactionsResponseData{}, ErrNilTransactionSendHandler
+	}
+	if len(idempotencyKey) == 0 {
+		return td.sendMultipleTransactions(txs, sendHandler)
+	}
+
+	fingerprint, err := computeTransactionsFingerprint(txs)
+	if err != nil {
+		return data.MultipleTransactionsResponseData{}, err
+	}
+
+	// the key is marked as in progress before sending, so that a concurrent retry does not send the transactions again
+	cacheKey := idempotencyKeyPrefix + idempotencyKey
+	cachedValue, found := td.sentTxsCache.PutIfAbsent(cacheKey, &idempotentResponse{
+		fingerprint:  fingerprint,
+		isInProgress: true,
+	})
+	cachedResponse, ok := cachedValue.(*idempotentResponse)
+	if found && ok {
+		if cachedResponse.fingerprint != fingerprint {
+			return data.MultipleTransactionsResponseData{}, apiErrors.ErrIdempotencyKeyReused
+		}
+		if cachedResponse.isInProgress {
+			return data.MultipleTransactionsResponseData{}, apiErrors.ErrIdempotentRequestInProgress
+		}
+
+		log.Debug("transactions already sent, returning the original response", "idempotency key", idempotencyKey)
+		return cachedResponse.response, nil
+	}
+
+	response, err := td.sendMultipleTransactions(txs, sendHandler)
+	if err != nil {
+		td.sentTxsCache.Remove(cacheKey)
+		return response, err
+	}
+
+	td.sentTxsCache.Put(cacheKey, &idempotentResponse{
+		fingerprint: fingerprint,
+		response:    response,
+	})
+
+	return response, nil
+}
+
+func (td *TransactionsDeduplicator) sendMultipleTransactions(
+	txs []*data.Transaction,
+	sendHandler func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error),
+) (data.MultipleTransactionsResponseData, error) {
+	response, err := sendHandler(txs)
+	if err != nil {
+		return response, err
+	}
+
+	for _, txHash := range response.TxsHashes {
+		td.sentTxsCache.Put(txHash, txHash)
+	}
+
+	return response, nil
+}
+
+func computeTransactionsFingerprint(txs []*data.Transaction) (string, error) {
+	txsBytes, err := json.Marshal(txs)
+	if err != nil {
+		return "", err
+	}
+
+	fingerprint := sha256.Sum256(txsBytes)
+
+	return hex.EncodeToString(fingerprint[:]), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (td *TransactionsDeduplicator) IsInterfaceNil() bool {
+	return td == nil
+}
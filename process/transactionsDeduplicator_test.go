@@ -0,0 +1,287 @@
+package process_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createMapBackedSentTransactionsCacher() *mock.SentTransactionsCacherStub {
+	cachedValues := make(map[string]interface{})
+	return &mock.SentTransactionsCacherStub{
+		PutCalled: func(key string, value interface{}) {
+			cachedValues[key] = value
+		},
+		PutIfAbsentCalled: func(key string, value interface{}) (interface{}, bool) {
+			existingValue, found := cachedValues[key]
+			if found {
+				return existingValue, true
+			}
+
+			cachedValues[key] = value
+			return nil, false
+		},
+		RemoveCalled: func(key string) {
+			delete(cachedValues, key)
+		},
+	}
+}
+
+func createTransactionsDeduplicator(trackedTxsProvider process.TrackedTransactionsProvider) *process.TransactionsDeduplicator {
+	td, _ := process.NewTransactionsDeduplicator(
+		createMapBackedSentTransactionsCacher(),
+		&mock.TransactionHashComputerStub{
+			ComputeTransactionHashCalled: func(tx *data.Transaction) (string, error) {
+				return fmt.Sprintf("hash%d", tx.Nonce), nil
+			},
+		},
+		trackedTxsProvider,
+	)
+
+	return td
+}
+
+func TestNewTransactionsDeduplicator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil sent transactions cacher should error", func(t *testing.T) {
+		t.Parallel()
+
+		td, err := process.NewTransactionsDeduplicator(nil, &mock.TransactionHashComputerStub{}, &mock.TrackedTransactionsProviderStub{})
+		require.Nil(t, td)
+		require.Equal(t, process.ErrNilSentTransactionsCacher, err)
+	})
+	t.Run("nil transaction hash computer should error", func(t *testing.T) {
+		t.Parallel()
+
+		td, err := process.NewTransactionsDeduplicator(&mock.SentTransactionsCacherStub{}, nil, &mock.TrackedTransactionsProviderStub{})
+		require.Nil(t, td)
+		require.Equal(t, process.ErrNilTransactionHashComputer, err)
+	})
+	t.Run("nil tracked transactions provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		td, err := process.NewTransactionsDeduplicator(&mock.SentTransactionsCacherStub{}, &mock.TransactionHashComputerStub{}, nil)
+		require.Nil(t, td)
+		require.Equal(t, process.ErrNilTrackedTransactionsProvider, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		td, err := process.NewTransactionsDeduplicator(&mock.SentTransactionsCacherStub{}, &mock.TransactionHashComputerStub{}, &mock.TrackedTransactionsProviderStub{})
+		require.Nil(t, err)
+		require.False(t, td.IsInterfaceNil())
+	})
+}
+
+func TestTransactionsDeduplicator_SendTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("duplicate should not be sent again", func(t *testing.T) {
+		t.Parallel()
+
+		numSent := 0
+		sendHandler := func(tx *data.Transaction) (int, string, error) {
+			numSent++
+			return http.StatusOK, fmt.Sprintf("hash%d", tx.Nonce), nil
+		}
+		td := createTransactionsDeduplicator(&mock.TrackedTransactionsProviderStub{})
+
+		statusCode, txHash, err := td.SendTransaction(&data.Transaction{Nonce: 1}, sendHandler)
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, "hash1", txHash)
+
+		statusCode, txHash, err = td.SendTransaction(&data.Transaction{Nonce: 1}, sendHandler)
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, "hash1", txHash)
+		require.Equal(t, 1, numSent)
+
+		_, txHash, err = td.SendTransaction(&data.Transaction{Nonce: 2}, sendHandler)
+		require.Nil(t, err)
+		require.Equal(t, "hash2", txHash)
+		require.Equal(t, 2, numSent)
+	})
+	t.Run("transaction in progress should not be sent again", func(t *testing.T) {
+		t.Parallel()
+
+		numSent := 0
+		td := createTransactionsDeduplicator(&mock.TrackedTransactionsProviderStub{})
+		var sendHandler func(tx *data.Transaction) (int, string, error)
+		sendHandler = func(tx *data.Transaction) (int, string, error) {
+			numSent++
+
+			// the same transaction arrives while the first one is still being sent
+			statusCode, _, err := td.SendTransaction(tx, sendHandler)
+			require.Equal(t, apiErrors.ErrTransactionSendInProgress, err)
+			require.Equal(t, http.StatusConflict, statusCode)
+
+			return http.StatusOK, "hash1", nil
+		}
+
+		_, txHash, err := td.SendTransaction(&data.Transaction{Nonce: 1}, sendHandler)
+		require.Nil(t, err)
+		require.Equal(t, "hash1", txHash)
+		require.Equal(t, 1, numSent)
+	})
+	t.Run("failed transaction should be sent again", func(t *testing.T) {
+		t.Parallel()
+
+		numSent := 0
+		sendHandler := func(tx *data.Transaction) (int, string, error) {
+			numSent++
+			if numSent == 1 {
+				return http.StatusInternalServerError, "", errors.New("send error")
+			}
+
+			return http.StatusOK, "hash1", nil
+		}
+		td := createTransactionsDeduplicator(&mock.TrackedTransactionsProviderStub{})
+
+		_, _, err := td.SendTransaction(&data.Transaction{Nonce: 1}, sendHandler)
+		require.NotNil(t, err)
+
+		_, txHash, err := td.SendTransaction(&data.Transaction{Nonce: 1}, sendHandler)
+		require.Nil(t, err)
+		require.Equal(t, "hash1", txHash)
+		require.Equal(t, 2, numSent)
+	})
+	t.Run("transaction abandoned by the tracker should be sent again", func(t *testing.T) {
+		t.Parallel()
+
+		numSent := 0
+		sendHandler := func(tx *data.Transaction) (int, string, error) {
+			numSent++
+			return http.StatusOK, "hash1", nil
+		}
+		trackedStatus := data.TrackedTxStatusInPool
+		td := createTransactionsDeduplicator(&mock.TrackedTransactionsProviderStub{
+			GetTrackedTransactionCalled: func(txHash string) (*data.TrackedTransaction, error) {
+				return &data.TrackedTransaction{TxHash: txHash, Status: trackedStatus}, nil
+			},
+		})
+
+		_, _, err := td.SendTransaction(&data.Transaction{Nonce: 1}, sendHandler)
+		require.Nil(t, err)
+		_, _, err = td.SendTransaction(&data.Transaction{Nonce: 1}, sendHandler)
+		require.Nil(t, err)
+		require.Equal(t, 1, numSent)
+
+		trackedStatus = data.TrackedTxStatusAbandoned
+		_, txHash, err := td.SendTransaction(&data.Transaction{Nonce: 1}, sendHandler)
+		require.Nil(t, err)
+		require.Equal(t, "hash1", txHash)
+		require.Equal(t, 2, numSent)
+	})
+}
+
+func TestTransactionsDeduplicator_SendMultipleTransactions(t *testing.T) {
+	t.Parallel()
+
+	createSendHandler := func(numSent *int) func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+		return func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+			*numSent++
+			txsHashes := make(map[int]string)
+			for idx, tx := range txs {
+				txsHashes[idx] = fmt.Sprintf("hash%d", tx.Nonce)
+			}
+
+			return data.MultipleTransactionsResponseData{
+				NumOfTxs:  uint64(len(txs)),
+				TxsHashes: txsHashes,
+			}, nil
+		}
+	}
+
+	t.Run("same key should return the original response", func(t *testing.T) {
+		t.Parallel()
+
+		numSent := 0
+		sendHandler := createSendHandler(&numSent)
+		td := createTransactionsDeduplicator(&mock.TrackedTransactionsProviderStub{})
+		txs := []*data.Transaction{{Nonce: 1}, {Nonce: 2}}
+		expectedResponse := data.MultipleTransactionsResponseData{
+			NumOfTxs:  2,
+			TxsHashes: map[int]string{0: "hash1", 1: "hash2"},
+		}
+
+		response, err := td.SendMultipleTransactions(txs, "key1", sendHandler)
+		require.Nil(t, err)
+		require.Equal(t, expectedResponse, response)
+
+		response, err = td.SendMultipleTransactions(txs, "key1", sendHandler)
+		require.Nil(t, err)
+		require.Equal(t, expectedResponse, response)
+		require.Equal(t, 1, numSent)
+
+		response, err = td.SendMultipleTransactions([]*data.Transaction{{Nonce: 3}}, "key1", sendHandler)
+		require.Equal(t, apiErrors.ErrIdempotencyKeyReused, err)
+		require.Empty(t, response)
+		require.Equal(t, 1, numSent)
+	})
+	t.Run("without key should always send", func(t *testing.T) {
+		t.Parallel()
+
+		numSent := 0
+		sendHandler := createSendHandler(&numSent)
+		td := createTransactionsDeduplicator(&mock.TrackedTransactionsProviderStub{})
+
+		_, err := td.SendMultipleTransactions([]*data.Transaction{{Nonce: 4}}, "", sendHandler)
+		require.Nil(t, err)
+		_, err = td.SendMultipleTransactions([]*data.Transaction{{Nonce: 4}}, "", sendHandler)
+		require.Nil(t, err)
+		require.Equal(t, 2, numSent)
+	})
+	t.Run("sent transactions should not be sent again one by one", func(t *testing.T) {
+		t.Parallel()
+
+		numSent := 0
+		td := createTransactionsDeduplicator(&mock.TrackedTransactionsProviderStub{})
+
+		_, err := td.SendMultipleTransactions([]*data.Transaction{{Nonce: 5}}, "", createSendHandler(&numSent))
+		require.Nil(t, err)
+
+		_, txHash, err := td.SendTransaction(&data.Transaction{Nonce: 5}, func(tx *data.Transaction) (int, string, error) {
+			require.Fail(t, "should have not been called")
+			return http.StatusOK, "", nil
+		})
+		require.Nil(t, err)
+		require.Equal(t, "hash5", txHash)
+	})
+	t.Run("key in progress should error and a failed request should not keep the key", func(t *testing.T) {
+		t.Parallel()
+
+		numSent := 0
+		txs := []*data.Transaction{{Nonce: 1}}
+		td := createTransactionsDeduplicator(&mock.TrackedTransactionsProviderStub{})
+		var sendHandler func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
+		sendHandler = func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+			numSent++
+			if numSent == 1 {
+				return data.MultipleTransactionsResponseData{}, errors.New("send error")
+			}
+
+			// a retry with the same key arrives while the transactions are still being sent
+			_, err := td.SendMultipleTransactions(txs, "key", sendHandler)
+			require.Equal(t, apiErrors.ErrIdempotentRequestInProgress, err)
+
+			return data.MultipleTransactionsResponseData{NumOfTxs: 1, TxsHashes: map[int]string{0: "hash1"}}, nil
+		}
+
+		_, err := td.SendMultipleTransactions(txs, "key", sendHandler)
+		require.NotNil(t, err)
+
+		response, err := td.SendMultipleTransactions(txs, "key", sendHandler)
+		require.Nil(t, err)
+		require.Equal(t, uint64(1), response.NumOfTxs)
+		require.Equal(t, 2, numSent)
+	})
+}
@@ -0,0 +1,24 @@
+package txbuilder
+
+import "errors"
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrNilNetworkConfigProvider signals that a nil network config provider has been provided
+var ErrNilNetworkConfigProvider = errors.New("nil network config provider")
+
+// ErrNilNonceProvider signals that a nil nonce provider has been provided
+var ErrNilNonceProvider = errors.New("nil nonce provider")
+
+// ErrNilTransactionCostProvider signals that a nil transaction cost provider has been provided
+var ErrNilTransactionCostProvider = errors.New("nil transaction cost provider")
+
+// ErrNilSignMarshalizer signals that a nil sign marshalizer has been provided
+var ErrNilSignMarshalizer = errors.New("nil sign marshalizer")
+
+// ErrNilSignHasher signals that a nil sign hasher has been provided
+var ErrNilSignHasher = errors.New("nil sign hasher")
+
+// ErrCannotEstimateGasLimit signals that the gas limit of the transaction could not be estimated
+var ErrCannotEstimateGasLimit = errors.New("cannot estimate the gas limit")
@@ -0,0 +1,21 @@
+package txbuilder
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// NetworkConfigProvider defines what a network config provider should be able to do
+type NetworkConfigProvider interface {
+	GetNetworkConfig() (*data.NetworkConfig, error)
+}
+
+// NonceProvider defines what a component which recommends the next nonce of an address should be able to do
+type NonceProvider interface {
+	GetNextNonce(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error)
+}
+
+// TransactionCostProvider defines what a transaction cost provider should be able to do
+type TransactionCostProvider interface {
+	TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
+}
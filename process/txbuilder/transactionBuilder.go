@@ -0,0 +1,271 @@
+package txbuilder
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ArgsTransactionBuilder holds the arguments needed for creating a new transaction builder
+type ArgsTransactionBuilder struct {
+	PubKeyConverter       core.PubkeyConverter
+	NetworkConfigProvider NetworkConfigProvider
+	NonceProvider         NonceProvider
+	TxCostProvider        TransactionCostProvider
+	SignMarshalizer       marshal.Marshalizer
+	SignHasher            hashing.Hasher
+}
+
+type transactionBuilder struct {
+	pubKeyConverter       core.PubkeyConverter
+	networkConfigProvider NetworkConfigProvider
+	nonceProvider         NonceProvider
+	txCostProvider        TransactionCostProvider
+	signMarshalizer       marshal.Marshalizer
+	signHasher            hashing.Hasher
+}
+
+// NewTransactionBuilder will create a new instance of the transactionBuilder
+func NewTransactionBuilder(args ArgsTransactionBuilder) (*transactionBuilder, error) {
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if args.NetworkConfigProvider == nil {
+		return nil, ErrNilNetworkConfigProvider
+	}
+	if args.NonceProvider == nil {
+		return nil, ErrNilNonceProvider
+	}
+	if args.TxCostProvider == nil {
+		return nil, ErrNilTransactionCostProvider
+	}
+	if check.IfNil(args.SignMarshalizer) {
+		return nil, ErrNilSignMarshalizer
+	}
+	if check.IfNil(args.SignHasher) {
+		return nil, ErrNilSignHasher
+	}
+
+	return &transactionBuilder{
+		pubKeyConverter:       args.PubKeyConverter,
+		networkConfigProvider: args.NetworkConfigProvider,
+		nonceProvider:         args.NonceProvider,
+		txCostProvider:        args.TxCostProvider,
+		signMarshalizer:       args.SignMarshalizer,
+		signHasher:            args.SignHasher,
+	}, nil
+}
+
+// BuildTransaction creates an unsigned transaction out of the provided request, together with its signing payload.
+// The nonce is the next one recommended for the sender, the gas price defaults to the minimum one and the gas limit is
+// estimated by the observers for transactions with a data field. The chain ID and the version come from the network config
+func (tb *transactionBuilder) BuildTransaction(request *data.TransactionBuildRequest) (*data.TransactionBuildResponseData, error) {
+	err := tb.checkRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	networkConfig, err := tb.networkConfigProvider.GetNetworkConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &data.Transaction{
+		Value:            normalizeValue(request.Value),
+		Receiver:         request.Receiver,
+		Sender:           request.Sender,
+		SenderUsername:   request.SenderUsername,
+		ReceiverUsername: request.ReceiverUsername,
+		GasPrice:         request.GasPrice,
+		Data:             request.Data,
+		ChainID:          networkConfig.Config.ChainID,
+		Version:          request.Version,
+		Options:          request.Options,
+		GuardianAddr:     request.GuardianAddr,
+		RelayerAddr:      request.RelayerAddr,
+	}
+	err = setVersion(tx, networkConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if tx.GasPrice == 0 {
+		tx.GasPrice = networkConfig.Config.MinGasPrice
+	}
+	if tx.GasPrice < networkConfig.Config.MinGasPrice {
+		return nil, errors.NewErrInvalidTxFields(
+			errors.ErrInsufficientGasPrice.Error(),
+			fmt.Sprintf("minimum %d, got %d", networkConfig.Config.MinGasPrice, tx.GasPrice),
+		)
+	}
+
+	tx.Nonce, err = tb.getNonce(request)
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimitEstimated, err := tb.setGasLimit(tx, request.GasLimit, networkConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return tb.createResponse(tx, gasLimitEstimated)
+}
+
+func (tb *transactionBuilder) checkRequest(request *data.TransactionBuildRequest) error {
+	value, ok := big.NewInt(0).SetString(request.Value, 10)
+	if len(request.Value) > 0 && (!ok || value.Sign() < 0) {
+		return errors.NewErrInvalidTxFields(errors.ErrInvalidBuildRequest.Error(), fmt.Sprintf("invalid value %s", request.Value))
+	}
+
+	_, err := tb.pubKeyConverter.Decode(request.Sender)
+	if err != nil {
+		return errors.NewErrInvalidTxFields(errors.ErrInvalidSenderAddress.Error(), err.Error())
+	}
+
+	_, err = tb.pubKeyConverter.Decode(request.Receiver)
+	if err != nil {
+		return errors.NewErrInvalidTxFields(errors.ErrInvalidReceiverAddress.Error(), err.Error())
+	}
+
+	if len(request.GuardianAddr) > 0 {
+		_, err = tb.pubKeyConverter.Decode(request.GuardianAddr)
+		if err != nil {
+			return errors.NewErrInvalidTxFields(errors.ErrInvalidGuardianAddress.Error(), err.Error())
+		}
+	}
+	if len(request.RelayerAddr) > 0 {
+		_, err = tb.pubKeyConverter.Decode(request.RelayerAddr)
+		if err != nil {
+			return errors.NewErrInvalidTxFields(errors.ErrInvalidRelayerAddress.Error(), err.Error())
+		}
+	}
+
+	isGuarded := request.Options&transaction.MaskGuardedTransaction > 0
+	hasGuardian := len(request.GuardianAddr) > 0
+	if isGuarded != hasGuardian {
+		return errors.NewErrInvalidTxFields(
+			errors.ErrInvalidTransactionOptions.Error(),
+			"the guardian address should be provided if and only if the guarded option is set",
+		)
+	}
+
+	return nil
+}
+
+// normalizeValue returns the base 10 representation of an already validated value, without leading zeros, an empty
+// value being zero
+func normalizeValue(value string) string {
+	if len(value) == 0 {
+		return "0"
+	}
+
+	normalized, _ := big.NewInt(0).SetString(value, 10)
+	return normalized.String()
+}
+
+// setVersion uses the minimum version of the network if none was provided, raised to the first version which
+// supports options, if any option is set
+func setVersion(tx *data.Transaction, networkConfig *data.NetworkConfig) error {
+	if tx.Version == 0 {
+		tx.Version = networkConfig.Config.MinTransactionVersion
+		if tx.Options != 0 && tx.Version < common.MinVersionForOptions {
+			tx.Version = common.MinVersionForOptions
+		}
+	}
+
+	if tx.Version < networkConfig.Config.MinTransactionVersion {
+		return errors.NewErrInvalidTxFields(
+			errors.ErrInvalidTransactionVersion.Error(),
+			fmt.Sprintf("minimum %d, got %d", networkConfig.Config.MinTransactionVersion, tx.Version),
+		)
+	}
+	if tx.Options != 0 && tx.Version < common.MinVersionForOptions {
+		return errors.NewErrInvalidTxFields(
+			errors.ErrInvalidTransactionOptions.Error(),
+			fmt.Sprintf("options can only be used starting with version %d", common.MinVersionForOptions),
+		)
+	}
+
+	return nil
+}
+
+func (tb *transactionBuilder) getNonce(request *data.TransactionBuildRequest) (uint64, error) {
+	if request.Nonce != nil {
+		return *request.Nonce, nil
+	}
+
+	nextNonce, err := tb.nonceProvider.GetNextNonce(request.Sender, common.NextNonceOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	return nextNonce.Nonce, nil
+}
+
+// setGasLimit uses the provided gas limit, the move balance gas for transactions without a data field or the gas
+// estimated by the observers otherwise. It returns true if the gas limit was estimated
+func (tb *transactionBuilder) setGasLimit(tx *data.Transaction, gasLimit uint64, networkConfig *data.NetworkConfig) (bool, error) {
+	moveBalanceGas := common.ComputeTransactionMoveBalanceGas(tx, networkConfig)
+	if gasLimit > 0 {
+		if gasLimit < moveBalanceGas {
+			return false, errors.NewErrInvalidTxFields(
+				errors.ErrInsufficientGasLimit.Error(),
+				fmt.Sprintf("minimum %d for %d data bytes, got %d", moveBalanceGas, len(tx.Data), gasLimit),
+			)
+		}
+
+		tx.GasLimit = gasLimit
+		return false, nil
+	}
+
+	tx.GasLimit = moveBalanceGas
+	if len(tx.Data) == 0 {
+		return false, nil
+	}
+
+	cost, err := tb.txCostProvider.TransactionCostRequest(tx)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s", ErrCannotEstimateGasLimit, err.Error())
+	}
+	if len(cost.RetMessage) > 0 {
+		return false, fmt.Errorf("%w: %s", ErrCannotEstimateGasLimit, cost.RetMessage)
+	}
+
+	tx.GasLimit = core.MaxUint64(cost.TxCost, moveBalanceGas)
+
+	return true, nil
+}
+
+func (tb *transactionBuilder) createResponse(tx *data.Transaction, gasLimitEstimated bool) (*data.TransactionBuildResponseData, error) {
+	signingPayload, err := common.MarshalTransactionForSigning(tx, tb.signMarshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &data.TransactionBuildResponseData{
+		Transaction:       tx,
+		SigningPayload:    string(signingPayload),
+		SignOnHash:        tx.Version > core.InitialVersionOfTransaction && tx.Options&transaction.MaskSignedWithHash > 0,
+		GasLimitEstimated: gasLimitEstimated,
+	}
+	if response.SignOnHash {
+		response.SigningPayloadHash = hex.EncodeToString(tb.signHasher.Compute(string(signingPayload)))
+	}
+
+	return response, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tb *transactionBuilder) IsInterfaceNil() bool {
+	return tb == nil
+}
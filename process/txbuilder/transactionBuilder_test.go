@@ -0,0 +1,363 @@
+package txbuilder
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testNextNonce = 7
+	testTxCost    = 1500000
+)
+
+var (
+	testPubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	testSender             = testPubKeyConverter.SilentEncode(make([]byte, 32), nil)
+	testReceiver           = testPubKeyConverter.SilentEncode(append(make([]byte, 31), 1), nil)
+	testGuardian           = testPubKeyConverter.SilentEncode(append(make([]byte, 31), 2), nil)
+	expectedErr            = errors.New("expected error")
+)
+
+type networkConfigProviderStub struct {
+	getNetworkConfigCalled func() (*data.NetworkConfig, error)
+}
+
+func (stub *networkConfigProviderStub) GetNetworkConfig() (*data.NetworkConfig, error) {
+	if stub.getNetworkConfigCalled != nil {
+		return stub.getNetworkConfigCalled()
+	}
+
+	networkConfig := &data.NetworkConfig{}
+	networkConfig.Config.ChainID = "T"
+	networkConfig.Config.MinGasLimit = 50000
+	networkConfig.Config.MinGasPrice = 1000000000
+	networkConfig.Config.GasPerDataByte = 1500
+	networkConfig.Config.MinTransactionVersion = 1
+	networkConfig.Config.ExtraGasLimitGuardedTx = 50000
+
+	return networkConfig, nil
+}
+
+type nonceProviderStub struct {
+	getNextNonceCalled func(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error)
+}
+
+func (stub *nonceProviderStub) GetNextNonce(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error) {
+	if stub.getNextNonceCalled != nil {
+		return stub.getNextNonceCalled(address, options)
+	}
+
+	return &data.NextNonceResponseData{Nonce: testNextNonce}, nil
+}
+
+type txCostProviderStub struct {
+	transactionCostRequestCalled func(tx *data.Transaction) (*data.TxCostResponseData, error)
+}
+
+func (stub *txCostProviderStub) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
+	if stub.transactionCostRequestCalled != nil {
+		return stub.transactionCostRequestCalled(tx)
+	}
+
+	return &data.TxCostResponseData{TxCost: testTxCost}, nil
+}
+
+func createMockArgs() ArgsTransactionBuilder {
+	return ArgsTransactionBuilder{
+		PubKeyConverter:       testPubKeyConverter,
+		NetworkConfigProvider: &networkConfigProviderStub{},
+		NonceProvider:         &nonceProviderStub{},
+		TxCostProvider:        &txCostProviderStub{},
+		SignMarshalizer:       &marshal.JsonMarshalizer{},
+		SignHasher:            keccak.NewKeccak(),
+	}
+}
+
+func requireInvalidTxFieldsError(t *testing.T, err error, expectedMessage error) {
+	errInvalidTxFields, ok := err.(*apiErrors.ErrInvalidTxFields)
+	require.True(t, ok, "error %v is not ErrInvalidTxFields", err)
+	require.Equal(t, expectedMessage.Error(), errInvalidTxFields.Message)
+}
+
+func computeCoreDataForSigning(t *testing.T, tx *data.Transaction) []byte {
+	senderAddress, err := testPubKeyConverter.Decode(tx.Sender)
+	require.Nil(t, err)
+	receiverAddress, err := testPubKeyConverter.Decode(tx.Receiver)
+	require.Nil(t, err)
+	value, _ := big.NewInt(0).SetString(tx.Value, 10)
+
+	coreTx := &transaction.Transaction{
+		Nonce:    tx.Nonce,
+		Value:    value,
+		RcvAddr:  receiverAddress,
+		SndAddr:  senderAddress,
+		GasPrice: tx.GasPrice,
+		GasLimit: tx.GasLimit,
+		Data:     tx.Data,
+		ChainID:  []byte(tx.ChainID),
+		Version:  tx.Version,
+		Options:  tx.Options,
+	}
+	if len(tx.GuardianAddr) > 0 {
+		coreTx.GuardianAddr, err = testPubKeyConverter.Decode(tx.GuardianAddr)
+		require.Nil(t, err)
+	}
+
+	dataForSigning, err := coreTx.GetDataForSigning(testPubKeyConverter, &marshal.JsonMarshalizer{}, keccak.NewKeccak())
+	require.Nil(t, err)
+
+	return dataForSigning
+}
+
+func TestNewTransactionBuilder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.PubKeyConverter = nil
+		tb, err := NewTransactionBuilder(args)
+		require.Nil(t, tb)
+		require.Equal(t, ErrNilPubKeyConverter, err)
+	})
+	t.Run("nil network config provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.NetworkConfigProvider = nil
+		tb, err := NewTransactionBuilder(args)
+		require.Nil(t, tb)
+		require.Equal(t, ErrNilNetworkConfigProvider, err)
+	})
+	t.Run("nil nonce provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.NonceProvider = nil
+		tb, err := NewTransactionBuilder(args)
+		require.Nil(t, tb)
+		require.Equal(t, ErrNilNonceProvider, err)
+	})
+	t.Run("nil transaction cost provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.TxCostProvider = nil
+		tb, err := NewTransactionBuilder(args)
+		require.Nil(t, tb)
+		require.Equal(t, ErrNilTransactionCostProvider, err)
+	})
+	t.Run("nil sign marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.SignMarshalizer = nil
+		tb, err := NewTransactionBuilder(args)
+		require.Nil(t, tb)
+		require.Equal(t, ErrNilSignMarshalizer, err)
+	})
+	t.Run("nil sign hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.SignHasher = nil
+		tb, err := NewTransactionBuilder(args)
+		require.Nil(t, tb)
+		require.Equal(t, ErrNilSignHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tb, err := NewTransactionBuilder(createMockArgs())
+		require.Nil(t, err)
+		require.False(t, tb.IsInterfaceNil())
+	})
+}
+
+func TestTransactionBuilder_BuildTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid fields should error", func(t *testing.T) {
+		t.Parallel()
+
+		tb, _ := NewTransactionBuilder(createMockArgs())
+
+		_, err := tb.BuildTransaction(&data.TransactionBuildRequest{Sender: "invalid", Receiver: testReceiver})
+		requireInvalidTxFieldsError(t, err, apiErrors.ErrInvalidSenderAddress)
+
+		_, err = tb.BuildTransaction(&data.TransactionBuildRequest{Sender: testSender, Receiver: "invalid"})
+		requireInvalidTxFieldsError(t, err, apiErrors.ErrInvalidReceiverAddress)
+
+		_, err = tb.BuildTransaction(&data.TransactionBuildRequest{Sender: testSender, Receiver: testReceiver, Value: "-1"})
+		requireInvalidTxFieldsError(t, err, apiErrors.ErrInvalidBuildRequest)
+
+		_, err = tb.BuildTransaction(&data.TransactionBuildRequest{
+			Sender:   testSender,
+			Receiver: testReceiver,
+			Options:  transaction.MaskGuardedTransaction,
+		})
+		requireInvalidTxFieldsError(t, err, apiErrors.ErrInvalidTransactionOptions)
+
+		_, err = tb.BuildTransaction(&data.TransactionBuildRequest{
+			Sender:   testSender,
+			Receiver: testReceiver,
+			Version:  1,
+			Options:  transaction.MaskSignedWithHash,
+		})
+		requireInvalidTxFieldsError(t, err, apiErrors.ErrInvalidTransactionOptions)
+
+		_, err = tb.BuildTransaction(&data.TransactionBuildRequest{Sender: testSender, Receiver: testReceiver, GasPrice: 1})
+		requireInvalidTxFieldsError(t, err, apiErrors.ErrInsufficientGasPrice)
+
+		_, err = tb.BuildTransaction(&data.TransactionBuildRequest{Sender: testSender, Receiver: testReceiver, GasLimit: 1})
+		requireInvalidTxFieldsError(t, err, apiErrors.ErrInsufficientGasLimit)
+	})
+	t.Run("nonce provider error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.NonceProvider = &nonceProviderStub{
+			getNextNonceCalled: func(address string, options common.NextNonceOptions) (*data.NextNonceResponseData, error) {
+				return nil, expectedErr
+			},
+		}
+		tb, _ := NewTransactionBuilder(args)
+
+		response, err := tb.BuildTransaction(&data.TransactionBuildRequest{Sender: testSender, Receiver: testReceiver})
+		require.Nil(t, response)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("failed cost estimation should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.TxCostProvider = &txCostProviderStub{
+			transactionCostRequestCalled: func(tx *data.Transaction) (*data.TxCostResponseData, error) {
+				return &data.TxCostResponseData{RetMessage: "function not found"}, nil
+			},
+		}
+		tb, _ := NewTransactionBuilder(args)
+
+		response, err := tb.BuildTransaction(&data.TransactionBuildRequest{
+			Sender:   testSender,
+			Receiver: testReceiver,
+			Data:     []byte("missing"),
+		})
+		require.Nil(t, response)
+		require.ErrorIs(t, err, ErrCannotEstimateGasLimit)
+		require.Contains(t, err.Error(), "function not found")
+	})
+	t.Run("move balance should fill in the defaults", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.TxCostProvider = &txCostProviderStub{
+			transactionCostRequestCalled: func(tx *data.Transaction) (*data.TxCostResponseData, error) {
+				require.Fail(t, "should have not estimated the gas limit")
+				return nil, nil
+			},
+		}
+		tb, _ := NewTransactionBuilder(args)
+
+		response, err := tb.BuildTransaction(&data.TransactionBuildRequest{Sender: testSender, Receiver: testReceiver})
+		require.Nil(t, err)
+		require.Equal(t, &data.Transaction{
+			Nonce:    testNextNonce,
+			Value:    "0",
+			Receiver: testReceiver,
+			Sender:   testSender,
+			GasPrice: 1000000000,
+			GasLimit: 50000,
+			ChainID:  "T",
+			Version:  1,
+		}, response.Transaction)
+		require.False(t, response.GasLimitEstimated)
+		require.False(t, response.SignOnHash)
+		require.Empty(t, response.SigningPayloadHash)
+		require.Equal(t, string(computeCoreDataForSigning(t, response.Transaction)), response.SigningPayload)
+	})
+	t.Run("provided fields should be kept", func(t *testing.T) {
+		t.Parallel()
+
+		tb, _ := NewTransactionBuilder(createMockArgs())
+
+		nonce := uint64(3)
+		response, err := tb.BuildTransaction(&data.TransactionBuildRequest{
+			Sender:   testSender,
+			Receiver: testReceiver,
+			Value:    "1000",
+			Data:     []byte("test"),
+			Nonce:    &nonce,
+			GasPrice: 2000000000,
+			GasLimit: 100000,
+			Version:  2,
+		})
+		require.Nil(t, err)
+		require.Equal(t, uint64(3), response.Transaction.Nonce)
+		require.Equal(t, "1000", response.Transaction.Value)
+		require.Equal(t, uint64(2000000000), response.Transaction.GasPrice)
+		require.Equal(t, uint64(100000), response.Transaction.GasLimit)
+		require.Equal(t, uint32(2), response.Transaction.Version)
+		require.False(t, response.GasLimitEstimated)
+	})
+	t.Run("value should be normalized", func(t *testing.T) {
+		t.Parallel()
+
+		tb, _ := NewTransactionBuilder(createMockArgs())
+
+		response, err := tb.BuildTransaction(&data.TransactionBuildRequest{
+			Sender:   testSender,
+			Receiver: testReceiver,
+			Value:    "000100",
+		})
+		require.Nil(t, err)
+		require.Equal(t, "100", response.Transaction.Value)
+		require.Contains(t, response.SigningPayload, `"value":"100"`)
+	})
+	t.Run("data field should estimate the gas limit", func(t *testing.T) {
+		t.Parallel()
+
+		tb, _ := NewTransactionBuilder(createMockArgs())
+
+		response, err := tb.BuildTransaction(&data.TransactionBuildRequest{
+			Sender:   testSender,
+			Receiver: testReceiver,
+			Data:     []byte("add@01"),
+		})
+		require.Nil(t, err)
+		require.Equal(t, uint64(testTxCost), response.Transaction.GasLimit)
+		require.True(t, response.GasLimitEstimated)
+	})
+	t.Run("guarded transaction signed on hash should return the payload hash", func(t *testing.T) {
+		t.Parallel()
+
+		tb, _ := NewTransactionBuilder(createMockArgs())
+
+		response, err := tb.BuildTransaction(&data.TransactionBuildRequest{
+			Sender:       testSender,
+			Receiver:     testReceiver,
+			Options:      transaction.MaskGuardedTransaction | transaction.MaskSignedWithHash,
+			GuardianAddr: testGuardian,
+		})
+		require.Nil(t, err)
+		require.Equal(t, uint32(2), response.Transaction.Version)
+		require.Equal(t, uint64(50000+50000), response.Transaction.GasLimit)
+		require.True(t, response.SignOnHash)
+
+		dataForSigning := computeCoreDataForSigning(t, response.Transaction)
+		require.Equal(t, hex.EncodeToString(dataForSigning), response.SigningPayloadHash)
+		require.Equal(t, dataForSigning, keccak.NewKeccak().Compute(response.SigningPayload))
+	})
+}
@@ -3,6 +3,7 @@ package txcost
 import (
 	"strings"
 
+	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -32,7 +33,12 @@ func (tcp *transactionCostProcessor) computeSenderAndReceiverShardID(sender, rec
 }
 
 func convertSCRInTransaction(scr *data.ExtendedApiSmartContractResult, originalTx *data.Transaction) *data.Transaction {
-	newDataField := removeLatestArgumentFromDataField(scr.Data)
+	newDataField := scr.Data
+	// the token transfers followed by an execution (such as multi-transfer-and-execute) carry the call as it was sent
+	isTransferAndExecute := scr.CallType == vm.ESDTTransferAndExecute || len(scr.Tokens) > 0
+	if !isTransferAndExecute {
+		newDataField = removeLatestArgumentFromDataField(scr.Data)
+	}
 
 	return &data.Transaction{
 		Nonce:     scr.Nonce,
@@ -1,8 +1,12 @@
 package txcost
 
 import (
+	"math/big"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,3 +28,44 @@ func TestRemoveLatestArgumentFromDataField(t *testing.T) {
 	dataField = removeLatestArgumentFromDataField("the-field")
 	require.Equal(t, "the-field", dataField)
 }
+
+func TestConvertSCRInTransaction(t *testing.T) {
+	t.Parallel()
+
+	originalTx := &data.Transaction{
+		ChainID: "T",
+		Version: 2,
+	}
+	scr := &data.ExtendedApiSmartContractResult{
+		ApiSmartContractResult: &transaction.ApiSmartContractResult{
+			Nonce:    3,
+			Value:    big.NewInt(10),
+			SndAddr:  "sender",
+			RcvAddr:  "receiver",
+			GasLimit: 5000,
+			GasPrice: 1000000000,
+			Data:     "scCall@01@shouldBeRemoved",
+			CallType: vm.AsynchronousCall,
+		},
+	}
+
+	tx := convertSCRInTransaction(scr, originalTx)
+	require.Equal(t, []byte("scCall@01"), tx.Data)
+	require.Equal(t, "10", tx.Value)
+	require.Equal(t, "sender", tx.Sender)
+	require.Equal(t, "receiver", tx.Receiver)
+	require.Equal(t, uint64(5000), tx.GasLimit)
+	require.Equal(t, "T", tx.ChainID)
+	require.Equal(t, uint32(2), tx.Version)
+
+	scr.Data = "MultiESDTNFTTransfer@01@544b4e2d313233@@0a@deposit@02"
+	scr.CallType = vm.DirectCall
+	scr.Tokens = []string{"TKN-123"}
+	tx = convertSCRInTransaction(scr, originalTx)
+	require.Equal(t, []byte(scr.Data), tx.Data)
+
+	scr.Tokens = nil
+	scr.CallType = vm.ESDTTransferAndExecute
+	tx = convertSCRInTransaction(scr, originalTx)
+	require.Equal(t, []byte(scr.Data), tx.Data)
+}
@@ -2,7 +2,10 @@ package txcost
 
 import (
 	"bytes"
+	"math/big"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -17,6 +20,9 @@ const (
 	TransactionCostPath = "/transaction/cost"
 
 	tooMuchGasProvidedMessage = "@too much gas provided"
+
+	// maxHopsDepth limits how many levels of cross-shard smart contract results are followed
+	maxHopsDepth = 10
 )
 
 var log = logger.GetOrCreate("process/txcost")
@@ -27,6 +33,9 @@ type transactionCostProcessor struct {
 	responses       []*data.ResponseTxCost
 	txsFromSCR      []*data.Transaction
 	hasExecutedSCR  bool
+
+	gasBreakdown      *data.TxCostHop
+	depthLimitReached bool
 }
 
 // NewTransactionCostProcessor will create a new instance of the transactionCostProcessor
@@ -61,16 +70,29 @@ func (tcp *transactionCostProcessor) ResolveCostRequest(tx *data.Transaction) (*
 		return nil, err
 	}
 
+	res = tcp.computeCost(senderShardID, receiverShardID, getFeePayer(tx), res)
+	res.GasBreakdown = tcp.gasBreakdown
+	res.DepthLimitReached = tcp.depthLimitReached
+
+	return res, nil
+}
+
+func (tcp *transactionCostProcessor) computeCost(
+	senderShardID uint32,
+	receiverShardID uint32,
+	feePayer string,
+	res *data.TxCostResponseData,
+) *data.TxCostResponseData {
 	shouldReturn := len(tcp.responses) == 1 || (len(tcp.responses) == 2 && senderShardID != receiverShardID)
 	if shouldReturn {
-		return tcp.extractCorrectResponse(tx.Sender, res), nil
+		return tcp.extractCorrectResponse(feePayer, res)
 	}
 
 	for _, currentRes := range tcp.responses {
-		hasUnsupportedOperations := doEventsContainTopic(&currentRes.Data, tooMuchGasProvidedMessage) || hasSCRWithRefundForSender(tx.Sender, &currentRes.Data)
+		hasUnsupportedOperations := doEventsContainTopic(&currentRes.Data, tooMuchGasProvidedMessage) || hasSCRWithRefundForSender(feePayer, &currentRes.Data)
 		shouldReturn = hasUnsupportedOperations && !tcp.hasExecutedSCR
 		if shouldReturn {
-			return &currentRes.Data, nil
+			return &currentRes.Data
 		}
 
 		if currentRes.Data.RetMessage == "" {
@@ -79,12 +101,21 @@ func (tcp *transactionCostProcessor) ResolveCostRequest(tx *data.Transaction) (*
 
 		res.RetMessage = currentRes.Data.RetMessage
 		res.TxCost = 0
-		return res, nil
+		return res
 	}
 
 	tcp.prepareGasUsed(senderShardID, receiverShardID, res)
 
-	return res, nil
+	return res
+}
+
+// getFeePayer returns the address which pays the fee and receives the refunds. For relayed v3 transactions, it is the relayer
+func getFeePayer(tx *data.Transaction) string {
+	if len(tx.RelayerAddr) > 0 {
+		return tx.RelayerAddr
+	}
+
+	return tx.Sender
 }
 
 func (tcp *transactionCostProcessor) doCostRequests(senderShardID, receiverShardID uint32, tx *data.Transaction) (*data.TxCostResponseData, error) {
@@ -95,12 +126,14 @@ func (tcp *transactionCostProcessor) doCostRequests(senderShardID, receiverShard
 			return nil, errGet
 		}
 
-		res, errExe := tcp.executeRequest(senderShardID, receiverShardID, observers, tx)
+		sourceHop := newTxCostHop(senderShardID, tx)
+		res, errExe := tcp.executeRequest(senderShardID, receiverShardID, observers, tx, sourceHop, 0)
 		if errExe != nil {
 			return nil, errExe
 		}
 
 		if res.RetMessage != "" {
+			tcp.gasBreakdown = sourceHop
 			return res, nil
 		}
 	}
@@ -110,7 +143,18 @@ func (tcp *transactionCostProcessor) doCostRequests(senderShardID, receiverShard
 		return nil, err
 	}
 
-	return tcp.executeRequest(senderShardID, receiverShardID, observers, tx)
+	tcp.gasBreakdown = newTxCostHop(receiverShardID, tx)
+	return tcp.executeRequest(senderShardID, receiverShardID, observers, tx, tcp.gasBreakdown, 0)
+}
+
+func newTxCostHop(shardID uint32, tx *data.Transaction) *data.TxCostHop {
+	return &data.TxCostHop{
+		ShardID:  shardID,
+		Sender:   tx.Sender,
+		Receiver: tx.Receiver,
+		Function: extractFunction(string(tx.Data)),
+		GasLimit: tx.GasLimit,
+	}
 }
 
 func (tcp *transactionCostProcessor) executeRequest(
@@ -118,12 +162,14 @@ func (tcp *transactionCostProcessor) executeRequest(
 	receiverShardID uint32,
 	observers []*data.NodeData,
 	tx *data.Transaction,
+	hop *data.TxCostHop,
+	depth int,
 ) (*data.TxCostResponseData, error) {
 	txCostResponse := data.ResponseTxCost{}
 	for _, observer := range observers {
 		respCode, errCall := tcp.proc.CallPostRestEndPoint(observer.Address, TransactionCostPath, tx, &txCostResponse)
 		if respCode == http.StatusOK && errCall == nil {
-			return tcp.processResponse(senderShardID, receiverShardID, &txCostResponse, tx)
+			return tcp.processResponse(senderShardID, receiverShardID, &txCostResponse, tx, hop, depth)
 		}
 
 		// if observer was down (or didn't respond in time), skip to the next one
@@ -145,20 +191,33 @@ func (tcp *transactionCostProcessor) processResponse(
 	receiverShardID uint32,
 	response *data.ResponseTxCost,
 	originalTx *data.Transaction,
+	hop *data.TxCostHop,
+	depth int,
 ) (*data.TxCostResponseData, error) {
 	tcp.responses = append(tcp.responses, response)
 
+	hop.GasUsed = response.Data.TxCost
+	hop.ReturnMessage = response.Data.RetMessage
+	hop.Refund = computeRefund(response.Data.ScResults)
 	if len(response.Data.ScResults) == 0 || response.Data.RetMessage != "" {
 		return &response.Data, nil
 	}
 
-	for scrHash, scr := range response.Data.ScResults {
+	// the hashes are sorted so that the hops are always reported in the same order
+	scrHashes := make([]string, 0, len(response.Data.ScResults))
+	for scrHash := range response.Data.ScResults {
+		scrHashes = append(scrHashes, scrHash)
+	}
+	sort.Strings(scrHashes)
+
+	for _, scrHash := range scrHashes {
+		scr := response.Data.ScResults[scrHash]
 		if scr.Used {
 			continue
 		}
 
 		scr.Used = true
-		res, err := tcp.processScResult(senderShardID, receiverShardID, scr, originalTx)
+		res, err := tcp.processScResult(senderShardID, receiverShardID, scr, originalTx, hop, depth)
 		if err != nil {
 			log.Warn("cannot process smart contract result", "hash", scrHash, "error", err)
 			continue
@@ -186,6 +245,8 @@ func (tcp *transactionCostProcessor) processScResult(
 	receiverShardID uint32,
 	scr *data.ExtendedApiSmartContractResult,
 	originalTx *data.Transaction,
+	parentHop *data.TxCostHop,
+	depth int,
 ) (*data.TxCostResponseData, error) {
 	scrSenderShardID, scrReceiverShardID, err := tcp.computeSenderAndReceiverShardID(scr.SndAddr, scr.RcvAddr)
 	if err != nil {
@@ -202,6 +263,21 @@ func (tcp *transactionCostProcessor) processScResult(
 		return nil, nil
 	}
 
+	hop := &data.TxCostHop{
+		ShardID:  scrReceiverShardID,
+		Sender:   scr.SndAddr,
+		Receiver: scr.RcvAddr,
+		Function: getSCRFunction(scr),
+		GasLimit: scr.GasLimit,
+	}
+	parentHop.Hops = append(parentHop.Hops, hop)
+	if depth >= maxHopsDepth {
+		// the gas limit forwarded to this hop remains accounted in the parent hop
+		hop.DepthLimitReached = true
+		tcp.depthLimitReached = true
+		return nil, nil
+	}
+
 	txFromScr := convertSCRInTransaction(scr, originalTx)
 	tcp.txsFromSCR = append(tcp.txsFromSCR, txFromScr)
 
@@ -210,23 +286,55 @@ func (tcp *transactionCostProcessor) processScResult(
 		return nil, err
 	}
 
-	res, err := tcp.executeRequest(scrSenderShardID, scrReceiverShardID, observers, txFromScr)
+	res, err := tcp.executeRequest(scrSenderShardID, scrReceiverShardID, observers, txFromScr, hop, depth+1)
 	if err != nil {
+		hop.ReturnMessage = err.Error()
 		return nil, err
 	}
 
 	tcp.hasExecutedSCR = true
+	if parentHop.GasUsed >= txFromScr.GasLimit {
+		parentHop.GasUsed -= txFromScr.GasLimit
+	}
 
 	return res, nil
 }
 
-func (tcp *transactionCostProcessor) extractCorrectResponse(txSender string, currentRes *data.TxCostResponseData) *data.TxCostResponseData {
+func getSCRFunction(scr *data.ExtendedApiSmartContractResult) string {
+	if len(scr.Function) > 0 {
+		return scr.Function
+	}
+
+	return extractFunction(scr.Data)
+}
+
+func extractFunction(dataField string) string {
+	function, _, _ := strings.Cut(dataField, argsSeparator)
+	return function
+}
+
+func computeRefund(scResults map[string]*data.ExtendedApiSmartContractResult) string {
+	refund := big.NewInt(0)
+	for _, scr := range scResults {
+		if scr.IsRefund && scr.Value != nil {
+			refund.Add(refund, scr.Value)
+		}
+	}
+
+	if refund.Sign() == 0 {
+		return ""
+	}
+
+	return refund.String()
+}
+
+func (tcp *transactionCostProcessor) extractCorrectResponse(feePayer string, currentRes *data.TxCostResponseData) *data.TxCostResponseData {
 	if len(tcp.responses) == 1 {
 		return currentRes
 	}
 
 	for _, res := range tcp.responses {
-		if doEventsContainTopic(&res.Data, tooMuchGasProvidedMessage) || hasSCRWithRefundForSender(txSender, &res.Data) {
+		if doEventsContainTopic(&res.Data, tooMuchGasProvidedMessage) || hasSCRWithRefundForSender(feePayer, &res.Data) {
 			return &res.Data
 		}
 	}
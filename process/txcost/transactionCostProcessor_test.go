@@ -3,10 +3,12 @@ package txcost
 import (
 	"bytes"
 	"encoding/hex"
+	"math/big"
 	"net/http"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/require"
@@ -133,4 +135,160 @@ func TestTransactionCostProcessor_RezolveCostRequestWith3LevelsOfAsyncCalls(t *t
 	require.Nil(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedGas, res.TxCost)
+	require.False(t, res.DepthLimitReached)
+
+	hop := res.GasBreakdown
+	require.NotNil(t, hop)
+	expectedHops := []struct {
+		shardID  uint32
+		receiver string
+		function string
+		gasUsed  uint64
+	}{
+		{shardID: 1, receiver: rcvTx, function: "scCall1", gasUsed: 4000},
+		{shardID: 2, receiver: rcvSCR1, function: "scCall2", gasUsed: 2000},
+		{shardID: 3, receiver: rcvSCR2, function: "scCall3", gasUsed: 5000},
+		{shardID: 4, receiver: rcvSCR3, function: "scCall4", gasUsed: 3000},
+	}
+	totalGasUsed := uint64(0)
+	for idx, expectedHop := range expectedHops {
+		require.Equal(t, expectedHop.shardID, hop.ShardID)
+		require.Equal(t, expectedHop.receiver, hop.Receiver)
+		require.Equal(t, expectedHop.function, hop.Function)
+		require.Equal(t, expectedHop.gasUsed, hop.GasUsed)
+		totalGasUsed += hop.GasUsed
+
+		if idx == len(expectedHops)-1 {
+			require.Empty(t, hop.Hops)
+			break
+		}
+		require.Len(t, hop.Hops, 1)
+		hop = hop.Hops[0]
+	}
+	require.Equal(t, res.TxCost, totalGasUsed)
+}
+
+func TestTransactionCostProcessor_ResolveCostRequestShouldStopAtMaxDepth(t *testing.T) {
+	t.Parallel()
+
+	contract1 := "0201"
+	contract2 := "0202"
+	numCalls := 0
+	coreProc := &mock.ProcessorStub{
+		GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{}}, nil
+		},
+		ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+			return uint32(addressBuff[1]), nil
+		},
+		CallPostRestEndPointCalled: func(address string, path string, req interface{}, response interface{}) (int, error) {
+			numCalls++
+			tx := req.(*data.Transaction)
+			nextReceiver := contract1
+			if tx.Receiver == contract1 {
+				nextReceiver = contract2
+			}
+
+			// the contracts keep calling each other
+			responseTxCost := response.(*data.ResponseTxCost)
+			responseTxCost.Data.TxCost = tx.GasLimit
+			responseTxCost.Data.ScResults = map[string]*data.ExtendedApiSmartContractResult{
+				"scr": {
+					ApiSmartContractResult: &transaction.ApiSmartContractResult{
+						CallType: vm.AsynchronousCall,
+						SndAddr:  tx.Receiver,
+						RcvAddr:  nextReceiver,
+						Data:     "ping@00",
+						GasLimit: tx.GasLimit - 1000,
+					},
+				},
+			}
+
+			return http.StatusOK, nil
+		},
+	}
+
+	txCostProcessor, _ := NewTransactionCostProcessor(coreProc, &mock.PubKeyConverterMock{})
+	tx := &data.Transaction{
+		Data:     []byte("ping"),
+		Sender:   "0001",
+		Receiver: contract1,
+		GasLimit: 100000,
+	}
+
+	res, err := txCostProcessor.ResolveCostRequest(tx)
+	require.Nil(t, err)
+	require.True(t, res.DepthLimitReached)
+	require.Equal(t, maxHopsDepth+1, numCalls)
+
+	depth := 0
+	hop := res.GasBreakdown
+	for len(hop.Hops) > 0 {
+		require.False(t, hop.DepthLimitReached)
+		hop = hop.Hops[0]
+		depth++
+	}
+	require.Equal(t, maxHopsDepth+1, depth)
+	require.True(t, hop.DepthLimitReached)
+	require.Equal(t, "ping", hop.Function)
+}
+
+func TestTransactionCostProcessor_ResolveCostRequestRelayedV3ShouldReportRefundForRelayer(t *testing.T) {
+	t.Parallel()
+
+	sender := "0300"
+	receiver := "0301"
+	relayer := "0400"
+	coreProc := &mock.ProcessorStub{
+		GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{}}, nil
+		},
+		ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+			return uint32(addressBuff[1]), nil
+		},
+		CallPostRestEndPointCalled: func(address string, path string, req interface{}, response interface{}) (int, error) {
+			tx := req.(*data.Transaction)
+			require.Equal(t, relayer, tx.RelayerAddr)
+
+			responseTxCost := response.(*data.ResponseTxCost)
+			responseTxCost.Data.TxCost = 50000
+			responseTxCost.Data.ScResults = map[string]*data.ExtendedApiSmartContractResult{
+				"refund": {
+					ApiSmartContractResult: &transaction.ApiSmartContractResult{
+						SndAddr:  receiver,
+						RcvAddr:  relayer,
+						Value:    big.NewInt(1000),
+						IsRefund: true,
+					},
+				},
+			}
+
+			return http.StatusOK, nil
+		},
+	}
+
+	txCostProcessor, _ := NewTransactionCostProcessor(coreProc, &mock.PubKeyConverterMock{})
+	tx := &data.Transaction{
+		Data:        []byte("call@01"),
+		Sender:      sender,
+		Receiver:    receiver,
+		RelayerAddr: relayer,
+		GasLimit:    100000,
+	}
+
+	res, err := txCostProcessor.ResolveCostRequest(tx)
+	require.Nil(t, err)
+	require.Equal(t, uint64(50000), res.TxCost)
+	require.Equal(t, uint32(1), res.GasBreakdown.ShardID)
+	require.Equal(t, "call", res.GasBreakdown.Function)
+	require.Equal(t, uint64(50000), res.GasBreakdown.GasUsed)
+	require.Equal(t, "1000", res.GasBreakdown.Refund)
+	require.Empty(t, res.GasBreakdown.Hops)
+}
+
+func TestGetFeePayer(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "sender", getFeePayer(&data.Transaction{Sender: "sender"}))
+	require.Equal(t, "relayer", getFeePayer(&data.Transaction{Sender: "sender", RelayerAddr: "relayer"}))
 }
@@ -0,0 +1,15 @@
+package txfee
+
+import "errors"
+
+// ErrNilNetworkConfigProvider signals that a nil network config provider has been provided
+var ErrNilNetworkConfigProvider = errors.New("nil network config provider")
+
+// ErrNilTransactionCostProvider signals that a nil transaction cost provider has been provided
+var ErrNilTransactionCostProvider = errors.New("nil transaction cost provider")
+
+// ErrInvalidGasPriceModifier signals that the network reported an invalid gas price modifier
+var ErrInvalidGasPriceModifier = errors.New("invalid gas price modifier")
+
+// ErrCannotEstimateGasUsed signals that the gas used by the transaction could not be estimated
+var ErrCannotEstimateGasUsed = errors.New("cannot estimate the gas used")
@@ -0,0 +1,175 @@
+package txfee
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type feeComputer struct {
+	networkConfigProvider NetworkConfigProvider
+	txCostProvider        TransactionCostProvider
+}
+
+type feeInput struct {
+	gasLimit    uint64
+	gasPrice    uint64
+	dataLength  int
+	isGuarded   bool
+	isRelayedV3 bool
+}
+
+// NewFeeComputer will create a new instance of the feeComputer
+func NewFeeComputer(
+	networkConfigProvider NetworkConfigProvider,
+	txCostProvider TransactionCostProvider,
+) (*feeComputer, error) {
+	if networkConfigProvider == nil {
+		return nil, ErrNilNetworkConfigProvider
+	}
+	if txCostProvider == nil {
+		return nil, ErrNilTransactionCostProvider
+	}
+
+	return &feeComputer{
+		networkConfigProvider: networkConfigProvider,
+		txCostProvider:        txCostProvider,
+	}, nil
+}
+
+// ComputeTransactionFee computes the initially paid fee, the refund and the final fee of a transaction. The gas needed
+// for moving the balance and for the data field is paid at full gas price, while the rest of the gas is paid at the
+// price adjusted by the gas price modifier. The unused gas of smart contract calls is refunded
+func (fc *feeComputer) ComputeTransactionFee(request *data.TransactionFeeRequest) (*data.TransactionFeeResponseData, error) {
+	networkConfig, err := fc.networkConfigProvider.GetNetworkConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	gasPriceModifier, err := getGasPriceModifier(networkConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	input := createFeeInput(request)
+	if input.gasLimit == 0 {
+		return nil, newInvalidFeeRequestError("the gas limit or the transaction must be provided")
+	}
+	if input.gasPrice == 0 {
+		input.gasPrice = networkConfig.Config.MinGasPrice
+	}
+	if input.gasPrice < networkConfig.Config.MinGasPrice {
+		return nil, errors.NewErrInvalidTxFields(
+			errors.ErrInsufficientGasPrice.Error(),
+			fmt.Sprintf("minimum %d, got %d", networkConfig.Config.MinGasPrice, input.gasPrice),
+		)
+	}
+
+	moveBalanceGas := common.ComputeMoveBalanceGas(input.dataLength, input.isGuarded, input.isRelayedV3, networkConfig)
+	if input.gasLimit < moveBalanceGas {
+		return nil, errors.NewErrInvalidTxFields(
+			errors.ErrInsufficientGasLimit.Error(),
+			fmt.Sprintf("minimum %d for %d data bytes, got %d", moveBalanceGas, input.dataLength, input.gasLimit),
+		)
+	}
+
+	gasUsed, err := fc.getGasUsed(request, input.gasLimit, moveBalanceGas)
+	if err != nil {
+		return nil, err
+	}
+
+	processingGasPrice := uint64(float64(input.gasPrice) * gasPriceModifier)
+	initiallyPaidFee := computeFee(moveBalanceGas, input.gasPrice, input.gasLimit, processingGasPrice)
+	fee := computeFee(moveBalanceGas, input.gasPrice, gasUsed, processingGasPrice)
+	refund := big.NewInt(0).Sub(initiallyPaidFee, fee)
+
+	return &data.TransactionFeeResponseData{
+		GasLimit:         input.gasLimit,
+		GasPrice:         input.gasPrice,
+		GasUsed:          gasUsed,
+		InitiallyPaidFee: initiallyPaidFee.String(),
+		Refund:           refund.String(),
+		Fee:              fee.String(),
+	}, nil
+}
+
+func createFeeInput(request *data.TransactionFeeRequest) feeInput {
+	tx := request.Transaction
+	if tx == nil {
+		return feeInput{
+			gasLimit:   request.GasLimit,
+			gasPrice:   request.GasPrice,
+			dataLength: len(request.Data),
+		}
+	}
+
+	return feeInput{
+		gasLimit:    tx.GasLimit,
+		gasPrice:    tx.GasPrice,
+		dataLength:  len(tx.Data),
+		isGuarded:   tx.Options&transaction.MaskGuardedTransaction > 0,
+		isRelayedV3: len(tx.RelayerAddr) > 0,
+	}
+}
+
+// getGasUsed returns the provided gas used, or estimates it for transactions with a data field. The whole gas limit
+// is consumed by move balance transactions, so nothing gets refunded for them
+func (fc *feeComputer) getGasUsed(request *data.TransactionFeeRequest, gasLimit uint64, moveBalanceGas uint64) (uint64, error) {
+	if request.GasUsed > 0 {
+		if request.GasUsed < moveBalanceGas || request.GasUsed > gasLimit {
+			return 0, newInvalidFeeRequestError(fmt.Sprintf("the gas used should be between %d and %d", moveBalanceGas, gasLimit))
+		}
+
+		return request.GasUsed, nil
+	}
+
+	tx := request.Transaction
+	if tx == nil || len(tx.Data) == 0 {
+		return gasLimit, nil
+	}
+
+	cost, err := fc.txCostProvider.TransactionCostRequest(tx)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrCannotEstimateGasUsed, err.Error())
+	}
+	if len(cost.RetMessage) > 0 {
+		return 0, fmt.Errorf("%w: %s", ErrCannotEstimateGasUsed, cost.RetMessage)
+	}
+
+	isMoveBalance := cost.TxCost <= moveBalanceGas
+	if isMoveBalance || cost.TxCost > gasLimit {
+		return gasLimit, nil
+	}
+
+	return cost.TxCost, nil
+}
+
+func computeFee(moveBalanceGas uint64, gasPrice uint64, gasUsed uint64, processingGasPrice uint64) *big.Int {
+	fee := big.NewInt(0).Mul(big.NewInt(0).SetUint64(moveBalanceGas), big.NewInt(0).SetUint64(gasPrice))
+	processingGas := gasUsed - moveBalanceGas
+	processingFee := big.NewInt(0).Mul(big.NewInt(0).SetUint64(processingGas), big.NewInt(0).SetUint64(processingGasPrice))
+
+	return fee.Add(fee, processingFee)
+}
+
+func getGasPriceModifier(networkConfig *data.NetworkConfig) (float64, error) {
+	gasPriceModifier, err := networkConfig.Config.GasPriceModifier.Float64()
+	if err != nil || gasPriceModifier < 0 || gasPriceModifier > 1 {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidGasPriceModifier, networkConfig.Config.GasPriceModifier)
+	}
+
+	return gasPriceModifier, nil
+}
+
+func newInvalidFeeRequestError(reason string) error {
+	return errors.NewErrInvalidTxFields(errors.ErrInvalidFeeRequest.Error(), reason)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fc *feeComputer) IsInterfaceNil() bool {
+	return fc == nil
+}
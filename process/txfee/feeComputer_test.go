@@ -0,0 +1,204 @@
+package txfee
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+type networkConfigProviderStub struct {
+	getNetworkConfigCalled func() (*data.NetworkConfig, error)
+}
+
+func (stub *networkConfigProviderStub) GetNetworkConfig() (*data.NetworkConfig, error) {
+	return stub.getNetworkConfigCalled()
+}
+
+type transactionCostProviderStub struct {
+	transactionCostRequestCalled func(tx *data.Transaction) (*data.TxCostResponseData, error)
+}
+
+func (stub *transactionCostProviderStub) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
+	if stub.transactionCostRequestCalled != nil {
+		return stub.transactionCostRequestCalled(tx)
+	}
+
+	return &data.TxCostResponseData{}, nil
+}
+
+func createNetworkConfigProvider() *networkConfigProviderStub {
+	networkConfig := &data.NetworkConfig{}
+	networkConfig.Config.MinGasLimit = 50000
+	networkConfig.Config.MinGasPrice = 1000000000
+	networkConfig.Config.GasPerDataByte = 1500
+	networkConfig.Config.ExtraGasLimitGuardedTx = 50000
+	networkConfig.Config.GasPriceModifier = "0.01"
+
+	return &networkConfigProviderStub{
+		getNetworkConfigCalled: func() (*data.NetworkConfig, error) {
+			return networkConfig, nil
+		},
+	}
+}
+
+func createFeeComputer(t *testing.T, txCostProvider TransactionCostProvider) *feeComputer {
+	fc, err := NewFeeComputer(createNetworkConfigProvider(), txCostProvider)
+	require.Nil(t, err)
+
+	return fc
+}
+
+func requireInvalidTxFieldsError(t *testing.T, err error, expectedMessage string) {
+	var errInvalidTxFields *apiErrors.ErrInvalidTxFields
+	require.True(t, errors.As(err, &errInvalidTxFields))
+	require.Equal(t, expectedMessage, errInvalidTxFields.Message)
+}
+
+func TestNewFeeComputer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil network config provider should error", func(t *testing.T) {
+		fc, err := NewFeeComputer(nil, &transactionCostProviderStub{})
+		require.Nil(t, fc)
+		require.Equal(t, ErrNilNetworkConfigProvider, err)
+	})
+	t.Run("nil transaction cost provider should error", func(t *testing.T) {
+		fc, err := NewFeeComputer(createNetworkConfigProvider(), nil)
+		require.Nil(t, fc)
+		require.Equal(t, ErrNilTransactionCostProvider, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		fc, err := NewFeeComputer(createNetworkConfigProvider(), &transactionCostProviderStub{})
+		require.Nil(t, err)
+		require.False(t, fc.IsInterfaceNil())
+	})
+}
+
+func TestFeeComputer_ComputeTransactionFee(t *testing.T) {
+	t.Parallel()
+
+	t.Run("move balance should consume the whole gas limit", func(t *testing.T) {
+		fc := createFeeComputer(t, &transactionCostProviderStub{
+			transactionCostRequestCalled: func(tx *data.Transaction) (*data.TxCostResponseData, error) {
+				require.Fail(t, "should not estimate the cost of a move balance")
+				return nil, nil
+			},
+		})
+
+		response, err := fc.ComputeTransactionFee(&data.TransactionFeeRequest{
+			Transaction: &data.Transaction{GasLimit: 70000, GasPrice: 1000000000},
+		})
+		require.Nil(t, err)
+		require.Equal(t, &data.TransactionFeeResponseData{
+			GasLimit:         70000,
+			GasPrice:         1000000000,
+			GasUsed:          70000,
+			InitiallyPaidFee: "50200000000000",
+			Refund:           "0",
+			Fee:              "50200000000000",
+		}, response)
+	})
+	t.Run("gas limit and data should use the minimum gas price", func(t *testing.T) {
+		fc := createFeeComputer(t, &transactionCostProviderStub{})
+
+		response, err := fc.ComputeTransactionFee(&data.TransactionFeeRequest{
+			GasLimit: 56000,
+			Data:     []byte("test"),
+		})
+		require.Nil(t, err)
+		require.Equal(t, uint64(1000000000), response.GasPrice)
+		require.Equal(t, "56000000000000", response.InitiallyPaidFee)
+		require.Equal(t, "56000000000000", response.Fee)
+	})
+	t.Run("smart contract call should refund the unused gas", func(t *testing.T) {
+		fc := createFeeComputer(t, &transactionCostProviderStub{
+			transactionCostRequestCalled: func(tx *data.Transaction) (*data.TxCostResponseData, error) {
+				return &data.TxCostResponseData{TxCost: 1000000}, nil
+			},
+		})
+
+		response, err := fc.ComputeTransactionFee(&data.TransactionFeeRequest{
+			Transaction: &data.Transaction{
+				GasLimit: 5000000,
+				GasPrice: 1000000000,
+				Data:     []byte("add@01"),
+			},
+		})
+		require.Nil(t, err)
+		// move balance: 50000 + 6 * 1500 = 59000 gas at full price
+		require.Equal(t, uint64(1000000), response.GasUsed)
+		require.Equal(t, "108410000000000", response.InitiallyPaidFee)
+		require.Equal(t, "68410000000000", response.Fee)
+		require.Equal(t, "40000000000000", response.Refund)
+	})
+	t.Run("estimated cost of a data only transaction should not refund", func(t *testing.T) {
+		fc := createFeeComputer(t, &transactionCostProviderStub{
+			transactionCostRequestCalled: func(tx *data.Transaction) (*data.TxCostResponseData, error) {
+				return &data.TxCostResponseData{TxCost: 56000}, nil
+			},
+		})
+
+		response, err := fc.ComputeTransactionFee(&data.TransactionFeeRequest{
+			Transaction: &data.Transaction{GasLimit: 100000, GasPrice: 1000000000, Data: []byte("note")},
+		})
+		require.Nil(t, err)
+		require.Equal(t, uint64(100000), response.GasUsed)
+		require.Equal(t, "0", response.Refund)
+	})
+	t.Run("guarded relayed transaction should pay for the extra gas", func(t *testing.T) {
+		fc := createFeeComputer(t, &transactionCostProviderStub{})
+
+		response, err := fc.ComputeTransactionFee(&data.TransactionFeeRequest{
+			Transaction: &data.Transaction{
+				GasLimit:    150000,
+				GasPrice:    1000000000,
+				Options:     transaction.MaskGuardedTransaction,
+				RelayerAddr: "relayer",
+			},
+		})
+		require.Nil(t, err)
+		require.Equal(t, "150000000000000", response.Fee)
+	})
+	t.Run("provided gas used should be used", func(t *testing.T) {
+		fc := createFeeComputer(t, &transactionCostProviderStub{})
+
+		response, err := fc.ComputeTransactionFee(&data.TransactionFeeRequest{
+			GasLimit: 150000,
+			GasUsed:  100000,
+		})
+		require.Nil(t, err)
+		require.Equal(t, "50500000000000", response.Fee)
+		require.Equal(t, "500000000000", response.Refund)
+	})
+	t.Run("invalid requests should error", func(t *testing.T) {
+		fc := createFeeComputer(t, &transactionCostProviderStub{})
+
+		_, err := fc.ComputeTransactionFee(&data.TransactionFeeRequest{})
+		requireInvalidTxFieldsError(t, err, apiErrors.ErrInvalidFeeRequest.Error())
+
+		_, err = fc.ComputeTransactionFee(&data.TransactionFeeRequest{GasLimit: 50000, GasPrice: 10})
+		requireInvalidTxFieldsError(t, err, apiErrors.ErrInsufficientGasPrice.Error())
+
+		_, err = fc.ComputeTransactionFee(&data.TransactionFeeRequest{GasLimit: 50000, Data: []byte("data")})
+		requireInvalidTxFieldsError(t, err, apiErrors.ErrInsufficientGasLimit.Error())
+
+		_, err = fc.ComputeTransactionFee(&data.TransactionFeeRequest{GasLimit: 50000, GasUsed: 60000})
+		requireInvalidTxFieldsError(t, err, apiErrors.ErrInvalidFeeRequest.Error())
+	})
+	t.Run("failed cost estimation should error", func(t *testing.T) {
+		fc := createFeeComputer(t, &transactionCostProviderStub{
+			transactionCostRequestCalled: func(tx *data.Transaction) (*data.TxCostResponseData, error) {
+				return &data.TxCostResponseData{RetMessage: "function not found"}, nil
+			},
+		})
+
+		_, err := fc.ComputeTransactionFee(&data.TransactionFeeRequest{
+			Transaction: &data.Transaction{GasLimit: 5000000, GasPrice: 1000000000, Data: []byte("missing")},
+		})
+		require.ErrorIs(t, err, ErrCannotEstimateGasUsed)
+	})
+}
@@ -0,0 +1,13 @@
+package txfee
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// NetworkConfigProvider defines what a network config provider should be able to do
+type NetworkConfigProvider interface {
+	GetNetworkConfig() (*data.NetworkConfig, error)
+}
+
+// TransactionCostProvider defines what a transaction cost provider should be able to do
+type TransactionCostProvider interface {
+	TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
+}
@@ -0,0 +1,21 @@
+package txtracker
+
+import "errors"
+
+// ErrNilTransactionsHandler signals that a nil transactions handler has been provided
+var ErrNilTransactionsHandler = errors.New("nil transactions handler")
+
+// ErrNilAccountsHandler signals that a nil accounts handler has been provided
+var ErrNilAccountsHandler = errors.New("nil accounts handler")
+
+// ErrInvalidTrackingWindow signals that an invalid tracking window has been provided
+var ErrInvalidTrackingWindow = errors.New("invalid tracking window")
+
+// ErrInvalidCheckInterval signals that an invalid check interval has been provided
+var ErrInvalidCheckInterval = errors.New("invalid check interval")
+
+// ErrInvalidMaxRebroadcasts signals that an invalid maximum number of rebroadcasts has been provided
+var ErrInvalidMaxRebroadcasts = errors.New("invalid maximum number of rebroadcasts")
+
+// ErrInvalidMaxTrackedTxs signals that an invalid maximum number of tracked transactions has been provided
+var ErrInvalidMaxTrackedTxs = errors.New("invalid maximum number of tracked transactions")
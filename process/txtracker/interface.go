@@ -0,0 +1,19 @@
+package txtracker
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// TransactionsHandler defines the transactions related actions needed by the tracker
+type TransactionsHandler interface {
+	SendTransaction(tx *data.Transaction) (int, string, error)
+	GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error)
+}
+
+// AccountsHandler defines the accounts related actions needed by the tracker
+type AccountsHandler interface {
+	GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+}
@@ -0,0 +1,331 @@
+package txtracker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const poolHashField = "hash"
+
+var log = logger.GetOrCreate("process/txtracker")
+
+// ArgsTransactionsTracker holds the arguments needed for creating a new transactions tracker
+type ArgsTransactionsTracker struct {
+	TransactionsHandler TransactionsHandler
+	AccountsHandler     AccountsHandler
+	TrackingWindow      time.Duration
+	CheckInterval       time.Duration
+	MaxRebroadcasts     int
+	MaxTrackedTxs       int
+}
+
+type trackedTransaction struct {
+	tx           *data.Transaction
+	info         *data.TrackedTransaction
+	trackedUntil time.Time
+}
+
+type transactionsTracker struct {
+	txHandler       TransactionsHandler
+	accountsHandler AccountsHandler
+	trackingWindow  time.Duration
+	checkInterval   time.Duration
+	maxRebroadcasts int
+	maxTrackedTxs   int
+
+	mutTrackedTxs sync.RWMutex
+	trackedTxs    map[string]*trackedTransaction
+	trackingOrder []string
+	cancelFunc    func()
+}
+
+// NewTransactionsTracker creates a new instance of transactionsTracker
+func NewTransactionsTracker(args ArgsTransactionsTracker) (*transactionsTracker, error) {
+	if args.TransactionsHandler == nil {
+		return nil, ErrNilTransactionsHandler
+	}
+	if args.AccountsHandler == nil {
+		return nil, ErrNilAccountsHandler
+	}
+	if args.TrackingWindow <= 0 {
+		return nil, ErrInvalidTrackingWindow
+	}
+	if args.CheckInterval <= 0 {
+		return nil, ErrInvalidCheckInterval
+	}
+	if args.MaxRebroadcasts < 0 {
+		return nil, ErrInvalidMaxRebroadcasts
+	}
+	if args.MaxTrackedTxs <= 0 {
+		return nil, ErrInvalidMaxTrackedTxs
+	}
+
+	return &transactionsTracker{
+		txHandler:       args.TransactionsHandler,
+		accountsHandler: args.AccountsHandler,
+		trackingWindow:  args.TrackingWindow,
+		checkInterval:   args.CheckInterval,
+		maxRebroadcasts: args.MaxRebroadcasts,
+		maxTrackedTxs:   args.MaxTrackedTxs,
+		trackedTxs:      make(map[string]*trackedTransaction),
+		trackingOrder:   make([]string, 0),
+	}, nil
+}
+
+// Track will start tracking the provided transaction, which was just sent under the provided hash. When the maximum
+// number of tracked transactions is reached, the oldest ones are not tracked anymore
+func (tt *transactionsTracker) Track(tx *data.Transaction, txHash string) {
+	now := time.Now()
+	trackedTx := &trackedTransaction{
+		tx: tx,
+		info: &data.TrackedTransaction{
+			TxHash:       txHash,
+			Sender:       tx.Sender,
+			Nonce:        tx.Nonce,
+			Status:       data.TrackedTxStatusSent,
+			TrackedSince: now.Unix(),
+			TrackedUntil: now.Add(tt.trackingWindow).Unix(),
+			Events: []data.TrackedTransactionEvent{
+				{Timestamp: now.Unix(), Status: data.TrackedTxStatusSent},
+			},
+		},
+		trackedUntil: now.Add(tt.trackingWindow),
+	}
+
+	tt.mutTrackedTxs.Lock()
+	defer tt.mutTrackedTxs.Unlock()
+
+	_, isTracked := tt.trackedTxs[txHash]
+	if !isTracked {
+		for len(tt.trackingOrder) >= tt.maxTrackedTxs {
+			delete(tt.trackedTxs, tt.trackingOrder[0])
+			tt.trackingOrder = tt.trackingOrder[1:]
+		}
+		tt.trackingOrder = append(tt.trackingOrder, txHash)
+	}
+	tt.trackedTxs[txHash] = trackedTx
+}
+
+// GetTrackedTransaction returns the lifecycle of a tracked transaction
+func (tt *transactionsTracker) GetTrackedTransaction(txHash string) (*data.TrackedTransaction, error) {
+	tt.mutTrackedTxs.RLock()
+	defer tt.mutTrackedTxs.RUnlock()
+
+	trackedTx, found := tt.trackedTxs[txHash]
+	if !found {
+		return nil, apiErrors.ErrTransactionNotTracked
+	}
+
+	info := *trackedTx.info
+	info.Events = make([]data.TrackedTransactionEvent, len(trackedTx.info.Events))
+	copy(info.Events, trackedTx.info.Events)
+
+	return &info, nil
+}
+
+// StartMonitoring will start checking the tracked transactions periodically
+func (tt *transactionsTracker) StartMonitoring() {
+	if tt.cancelFunc != nil {
+		log.Error("transactionsTracker - monitoring already started")
+		return
+	}
+
+	var ctx context.Context
+	ctx, tt.cancelFunc = context.WithCancel(context.Background())
+
+	go func(ctx context.Context) {
+		timer := time.NewTimer(tt.checkInterval)
+		defer timer.Stop()
+
+		for {
+			timer.Reset(tt.checkInterval)
+
+			select {
+			case <-timer.C:
+				tt.checkTrackedTransactions()
+			case <-ctx.Done():
+				log.Debug("finishing transactionsTracker monitoring...")
+				return
+			}
+		}
+	}(ctx)
+}
+
+func (tt *transactionsTracker) checkTrackedTransactions() {
+	now := time.Now()
+	txsToCheck := tt.getTransactionsToCheck(now)
+
+	for _, trackedTx := range txsToCheck {
+		status, details := tt.checkTransaction(trackedTx)
+		tt.updateStatus(trackedTx, status, details, time.Now())
+	}
+}
+
+// getTransactionsToCheck marks the transactions which reached the end of the tracking window as expired, removes the
+// ones which stayed in a final state for another window and returns the ones still in need of checks
+func (tt *transactionsTracker) getTransactionsToCheck(now time.Time) []*trackedTransaction {
+	tt.mutTrackedTxs.Lock()
+	defer tt.mutTrackedTxs.Unlock()
+
+	txsToCheck := make([]*trackedTransaction, 0, len(tt.trackedTxs))
+	numRemoved := 0
+	for txHash, trackedTx := range tt.trackedTxs {
+		if now.After(trackedTx.trackedUntil.Add(tt.trackingWindow)) {
+			delete(tt.trackedTxs, txHash)
+			numRemoved++
+			continue
+		}
+		if isFinalStatus(trackedTx.info.Status) {
+			continue
+		}
+		if now.After(trackedTx.trackedUntil) {
+			setStatus(trackedTx.info, data.TrackedTxStatusExpired, "", now)
+			continue
+		}
+
+		txsToCheck = append(txsToCheck, trackedTx)
+	}
+
+	if numRemoved > 0 {
+		tt.removeUntrackedFromOrder()
+	}
+
+	return txsToCheck
+}
+
+func (tt *transactionsTracker) removeUntrackedFromOrder() {
+	trackingOrder := make([]string, 0, len(tt.trackedTxs))
+	for _, txHash := range tt.trackingOrder {
+		_, isTracked := tt.trackedTxs[txHash]
+		if isTracked {
+			trackingOrder = append(trackingOrder, txHash)
+		}
+	}
+
+	tt.trackingOrder = trackingOrder
+}
+
+func (tt *transactionsTracker) checkTransaction(trackedTx *trackedTransaction) (string, string) {
+	txHash := trackedTx.info.TxHash
+	tx, err := tt.txHandler.GetTransaction(txHash, false)
+	if err == nil {
+		if tx.Status == transaction.TxStatusPending {
+			return data.TrackedTxStatusInPool, ""
+		}
+
+		return data.TrackedTxStatusExecuted, string(tx.Status)
+	}
+	if err != apiErrors.ErrTransactionNotFound {
+		log.Debug("transactionsTracker: cannot get transaction", "hash", txHash, "error", err)
+		return trackedTx.info.Status, ""
+	}
+
+	isInPool, err := tt.isInPool(trackedTx.tx.Sender, txHash)
+	if err != nil {
+		log.Debug("transactionsTracker: cannot get transactions pool for sender", "sender", trackedTx.tx.Sender, "error", err)
+		return trackedTx.info.Status, ""
+	}
+	if isInPool {
+		return data.TrackedTxStatusInPool, ""
+	}
+
+	account, err := tt.accountsHandler.GetAccount(trackedTx.tx.Sender, common.AccountQueryOptions{})
+	if err != nil {
+		log.Debug("transactionsTracker: cannot get account", "address", trackedTx.tx.Sender, "error", err)
+		return trackedTx.info.Status, ""
+	}
+	if account.Account.Nonce > trackedTx.tx.Nonce {
+		return data.TrackedTxStatusInvalidated, fmt.Sprintf("account nonce is %d", account.Account.Nonce)
+	}
+
+	return tt.rebroadcast(trackedTx)
+}
+
+func (tt *transactionsTracker) isInPool(sender string, txHash string) (bool, error) {
+	txPool, err := tt.txHandler.GetTransactionsPoolForSender(sender, poolHashField)
+	if err != nil {
+		return false, err
+	}
+
+	for _, tx := range txPool.Transactions {
+		if tx.TxFields[poolHashField] == txHash {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (tt *transactionsTracker) rebroadcast(trackedTx *trackedTransaction) (string, string) {
+	tt.mutTrackedTxs.RLock()
+	numRebroadcasts := trackedTx.info.NumRebroadcasts
+	tt.mutTrackedTxs.RUnlock()
+
+	if numRebroadcasts >= tt.maxRebroadcasts {
+		return data.TrackedTxStatusAbandoned, fmt.Sprintf("transaction was sent again %d times", numRebroadcasts)
+	}
+
+	_, _, err := tt.txHandler.SendTransaction(trackedTx.tx)
+	if err != nil {
+		log.Debug("transactionsTracker: cannot rebroadcast transaction", "hash", trackedTx.info.TxHash, "error", err)
+		return trackedTx.info.Status, ""
+	}
+
+	tt.mutTrackedTxs.Lock()
+	trackedTx.info.NumRebroadcasts++
+	tt.mutTrackedTxs.Unlock()
+
+	log.Debug("transactionsTracker: transaction sent again", "hash", trackedTx.info.TxHash)
+	return data.TrackedTxStatusRebroadcast, ""
+}
+
+func (tt *transactionsTracker) updateStatus(trackedTx *trackedTransaction, status string, details string, now time.Time) {
+	tt.mutTrackedTxs.Lock()
+	defer tt.mutTrackedTxs.Unlock()
+
+	trackedTx.info.LastCheckedAt = now.Unix()
+	isNewStatus := trackedTx.info.Status != status || status == data.TrackedTxStatusRebroadcast
+	if isNewStatus {
+		setStatus(trackedTx.info, status, details, now)
+	}
+}
+
+func setStatus(info *data.TrackedTransaction, status string, details string, now time.Time) {
+	info.Status = status
+	info.Events = append(info.Events, data.TrackedTransactionEvent{
+		Timestamp: now.Unix(),
+		Status:    status,
+		Details:   details,
+	})
+}
+
+func isFinalStatus(status string) bool {
+	switch status {
+	case data.TrackedTxStatusExecuted, data.TrackedTxStatusInvalidated, data.TrackedTxStatusAbandoned, data.TrackedTxStatusExpired:
+		return true
+	default:
+		return false
+	}
+}
+
+// Close will stop the monitoring of the tracked transactions
+func (tt *transactionsTracker) Close() error {
+	if tt.cancelFunc != nil {
+		tt.cancelFunc()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tt *transactionsTracker) IsInterfaceNil() bool {
+	return tt == nil
+}
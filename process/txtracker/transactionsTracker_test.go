@@ -0,0 +1,309 @@
+package txtracker
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSender = "erd1sender"
+	testTxHash = "aabbcc"
+)
+
+type transactionsHandlerStub struct {
+	sendTransactionCalled              func(tx *data.Transaction) (int, string, error)
+	getTransactionCalled               func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	getTransactionsPoolForSenderCalled func(sender, fields string) (*data.TransactionsPoolForSender, error)
+}
+
+func (stub *transactionsHandlerStub) SendTransaction(tx *data.Transaction) (int, string, error) {
+	if stub.sendTransactionCalled != nil {
+		return stub.sendTransactionCalled(tx)
+	}
+
+	return 0, testTxHash, nil
+}
+
+func (stub *transactionsHandlerStub) GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+	if stub.getTransactionCalled != nil {
+		return stub.getTransactionCalled(txHash, withResults)
+	}
+
+	return nil, apiErrors.ErrTransactionNotFound
+}
+
+func (stub *transactionsHandlerStub) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
+	if stub.getTransactionsPoolForSenderCalled != nil {
+		return stub.getTransactionsPoolForSenderCalled(sender, fields)
+	}
+
+	return &data.TransactionsPoolForSender{}, nil
+}
+
+func createMockArgs() ArgsTransactionsTracker {
+	return ArgsTransactionsTracker{
+		TransactionsHandler: &transactionsHandlerStub{},
+		AccountsHandler:     &mock.AccountsHandlerStub{},
+		TrackingWindow:      time.Minute,
+		CheckInterval:       time.Second,
+		MaxRebroadcasts:     2,
+		MaxTrackedTxs:       10,
+	}
+}
+
+func createTrackerWithTrackedTx(t *testing.T, args ArgsTransactionsTracker) *transactionsTracker {
+	tt, err := NewTransactionsTracker(args)
+	require.Nil(t, err)
+
+	tt.Track(&data.Transaction{Sender: testSender, Nonce: 5}, testTxHash)
+
+	return tt
+}
+
+func getStatuses(t *testing.T, tt *transactionsTracker) []string {
+	trackedTx, err := tt.GetTrackedTransaction(testTxHash)
+	require.Nil(t, err)
+
+	statuses := make([]string, 0, len(trackedTx.Events))
+	for _, event := range trackedTx.Events {
+		statuses = append(statuses, event.Status)
+	}
+
+	return statuses
+}
+
+func TestNewTransactionsTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transactions handler should error", func(t *testing.T) {
+		args := createMockArgs()
+		args.TransactionsHandler = nil
+		tt, err := NewTransactionsTracker(args)
+		require.Nil(t, tt)
+		require.Equal(t, ErrNilTransactionsHandler, err)
+	})
+	t.Run("nil accounts handler should error", func(t *testing.T) {
+		args := createMockArgs()
+		args.AccountsHandler = nil
+		tt, err := NewTransactionsTracker(args)
+		require.Nil(t, tt)
+		require.Equal(t, ErrNilAccountsHandler, err)
+	})
+	t.Run("invalid tracking window should error", func(t *testing.T) {
+		args := createMockArgs()
+		args.TrackingWindow = 0
+		tt, err := NewTransactionsTracker(args)
+		require.Nil(t, tt)
+		require.Equal(t, ErrInvalidTrackingWindow, err)
+	})
+	t.Run("invalid check interval should error", func(t *testing.T) {
+		args := createMockArgs()
+		args.CheckInterval = 0
+		tt, err := NewTransactionsTracker(args)
+		require.Nil(t, tt)
+		require.Equal(t, ErrInvalidCheckInterval, err)
+	})
+	t.Run("invalid max rebroadcasts should error", func(t *testing.T) {
+		args := createMockArgs()
+		args.MaxRebroadcasts = -1
+		tt, err := NewTransactionsTracker(args)
+		require.Nil(t, tt)
+		require.Equal(t, ErrInvalidMaxRebroadcasts, err)
+	})
+	t.Run("invalid max tracked transactions should error", func(t *testing.T) {
+		args := createMockArgs()
+		args.MaxTrackedTxs = 0
+		tt, err := NewTransactionsTracker(args)
+		require.Nil(t, tt)
+		require.Equal(t, ErrInvalidMaxTrackedTxs, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		tt, err := NewTransactionsTracker(createMockArgs())
+		require.Nil(t, err)
+		require.False(t, tt.IsInterfaceNil())
+	})
+}
+
+func TestTransactionsTracker_GetTrackedTransaction(t *testing.T) {
+	t.Parallel()
+
+	tt := createTrackerWithTrackedTx(t, createMockArgs())
+
+	trackedTx, err := tt.GetTrackedTransaction("missing")
+	require.Nil(t, trackedTx)
+	require.Equal(t, apiErrors.ErrTransactionNotTracked, err)
+
+	trackedTx, err = tt.GetTrackedTransaction(testTxHash)
+	require.Nil(t, err)
+	require.Equal(t, testTxHash, trackedTx.TxHash)
+	require.Equal(t, testSender, trackedTx.Sender)
+	require.Equal(t, uint64(5), trackedTx.Nonce)
+	require.Equal(t, data.TrackedTxStatusSent, trackedTx.Status)
+	require.Equal(t, trackedTx.TrackedSince+60, trackedTx.TrackedUntil)
+}
+
+func TestTransactionsTracker_TrackShouldEvictTheOldest(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.MaxTrackedTxs = 2
+	tt, _ := NewTransactionsTracker(args)
+
+	tt.Track(&data.Transaction{Sender: testSender, Nonce: 1}, "hash1")
+	tt.Track(&data.Transaction{Sender: testSender, Nonce: 2}, "hash2")
+	tt.Track(&data.Transaction{Sender: testSender, Nonce: 2}, "hash2")
+	tt.Track(&data.Transaction{Sender: testSender, Nonce: 3}, "hash3")
+
+	_, err := tt.GetTrackedTransaction("hash1")
+	require.Equal(t, apiErrors.ErrTransactionNotTracked, err)
+	for _, txHash := range []string{"hash2", "hash3"} {
+		_, err = tt.GetTrackedTransaction(txHash)
+		require.Nil(t, err)
+	}
+	require.Equal(t, []string{"hash2", "hash3"}, tt.trackingOrder)
+}
+
+func TestTransactionsTracker_CheckTrackedTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pending transaction should be marked as in pool", func(t *testing.T) {
+		args := createMockArgs()
+		args.TransactionsHandler = &transactionsHandlerStub{
+			getTransactionCalled: func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return &transaction.ApiTransactionResult{Status: transaction.TxStatusPending}, nil
+			},
+		}
+		tt := createTrackerWithTrackedTx(t, args)
+
+		tt.checkTrackedTransactions()
+		tt.checkTrackedTransactions()
+		require.Equal(t, []string{data.TrackedTxStatusSent, data.TrackedTxStatusInPool}, getStatuses(t, tt))
+	})
+	t.Run("executed transaction should not be checked anymore", func(t *testing.T) {
+		numGetCalls := 0
+		args := createMockArgs()
+		args.TransactionsHandler = &transactionsHandlerStub{
+			getTransactionCalled: func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				numGetCalls++
+				return &transaction.ApiTransactionResult{Status: transaction.TxStatusSuccess}, nil
+			},
+		}
+		tt := createTrackerWithTrackedTx(t, args)
+
+		tt.checkTrackedTransactions()
+		tt.checkTrackedTransactions()
+		require.Equal(t, 1, numGetCalls)
+		require.Equal(t, []string{data.TrackedTxStatusSent, data.TrackedTxStatusExecuted}, getStatuses(t, tt))
+	})
+	t.Run("transaction found in sender's pool should be marked as in pool", func(t *testing.T) {
+		args := createMockArgs()
+		args.TransactionsHandler = &transactionsHandlerStub{
+			getTransactionsPoolForSenderCalled: func(sender, fields string) (*data.TransactionsPoolForSender, error) {
+				require.Equal(t, testSender, sender)
+				return &data.TransactionsPoolForSender{
+					Transactions: []data.WrappedTransaction{{TxFields: map[string]interface{}{"hash": testTxHash}}},
+				}, nil
+			},
+		}
+		tt := createTrackerWithTrackedTx(t, args)
+
+		tt.checkTrackedTransactions()
+		require.Equal(t, []string{data.TrackedTxStatusSent, data.TrackedTxStatusInPool}, getStatuses(t, tt))
+	})
+	t.Run("transaction with consumed nonce should be invalidated", func(t *testing.T) {
+		args := createMockArgs()
+		args.AccountsHandler = &mock.AccountsHandlerStub{
+			GetAccountCalled: func(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+				return &data.AccountModel{Account: data.Account{Nonce: 6}}, nil
+			},
+		}
+		tt := createTrackerWithTrackedTx(t, args)
+
+		tt.checkTrackedTransactions()
+		require.Equal(t, []string{data.TrackedTxStatusSent, data.TrackedTxStatusInvalidated}, getStatuses(t, tt))
+	})
+	t.Run("dropped transaction should be sent again until abandoned", func(t *testing.T) {
+		numSendCalls := 0
+		args := createMockArgs()
+		args.TransactionsHandler = &transactionsHandlerStub{
+			sendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				numSendCalls++
+				return 0, testTxHash, nil
+			},
+		}
+		tt := createTrackerWithTrackedTx(t, args)
+
+		for i := 0; i < 4; i++ {
+			tt.checkTrackedTransactions()
+		}
+
+		require.Equal(t, 2, numSendCalls)
+		expectedStatuses := []string{
+			data.TrackedTxStatusSent,
+			data.TrackedTxStatusRebroadcast,
+			data.TrackedTxStatusRebroadcast,
+			data.TrackedTxStatusAbandoned,
+		}
+		require.Equal(t, expectedStatuses, getStatuses(t, tt))
+
+		trackedTx, _ := tt.GetTrackedTransaction(testTxHash)
+		require.Equal(t, 2, trackedTx.NumRebroadcasts)
+	})
+	t.Run("errors while checking should keep the status", func(t *testing.T) {
+		args := createMockArgs()
+		args.TransactionsHandler = &transactionsHandlerStub{
+			getTransactionCalled: func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return nil, errors.New("observers unavailable")
+			},
+		}
+		tt := createTrackerWithTrackedTx(t, args)
+
+		tt.checkTrackedTransactions()
+		require.Equal(t, []string{data.TrackedTxStatusSent}, getStatuses(t, tt))
+
+		trackedTx, _ := tt.GetTrackedTransaction(testTxHash)
+		require.NotZero(t, trackedTx.LastCheckedAt)
+	})
+	t.Run("transaction outside the tracking window should expire, then be removed", func(t *testing.T) {
+		args := createMockArgs()
+		tt := createTrackerWithTrackedTx(t, args)
+
+		txsToCheck := tt.getTransactionsToCheck(time.Now().Add(args.TrackingWindow + time.Second))
+		require.Empty(t, txsToCheck)
+		require.Equal(t, []string{data.TrackedTxStatusSent, data.TrackedTxStatusExpired}, getStatuses(t, tt))
+
+		_ = tt.getTransactionsToCheck(time.Now().Add(2*args.TrackingWindow + time.Second))
+		_, err := tt.GetTrackedTransaction(testTxHash)
+		require.Equal(t, apiErrors.ErrTransactionNotTracked, err)
+		require.Empty(t, tt.trackingOrder)
+	})
+}
+
+func TestTransactionsTracker_StartMonitoringAndClose(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.CheckInterval = 10 * time.Millisecond
+	args.TransactionsHandler = &transactionsHandlerStub{
+		getTransactionCalled: func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			return &transaction.ApiTransactionResult{Status: transaction.TxStatusSuccess}, nil
+		},
+	}
+	tt := createTrackerWithTrackedTx(t, args)
+
+	tt.StartMonitoring()
+	require.Eventually(t, func() bool {
+		trackedTx, _ := tt.GetTrackedTransaction(testTxHash)
+		return trackedTx.Status == data.TrackedTxStatusExecuted
+	}, time.Second, 10*time.Millisecond)
+
+	require.Nil(t, tt.Close())
+}
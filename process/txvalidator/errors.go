@@ -0,0 +1,15 @@
+package txvalidator
+
+import "errors"
+
+// ErrNilPubKeyConverter signals that a nil pub key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil pub key converter provided")
+
+// ErrNilNetworkConfigProvider signals that a nil network config provider has been provided
+var ErrNilNetworkConfigProvider = errors.New("nil network config provider")
+
+// ErrNilSignMarshalizer signals that a nil sign marshalizer has been provided
+var ErrNilSignMarshalizer = errors.New("nil sign marshalizer")
+
+// ErrNilSignHasher signals that a nil sign hasher has been provided
+var ErrNilSignHasher = errors.New("nil sign hasher")
@@ -0,0 +1,8 @@
+package txvalidator
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// NetworkConfigProvider defines what a network config provider should be able to do
+type NetworkConfigProvider interface {
+	GetNetworkConfig() (*data.NetworkConfig, error)
+}
@@ -0,0 +1,269 @@
+package txvalidator
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	ed25519SingleSigner "github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type transactionValidator struct {
+	pubKeyConverter       core.PubkeyConverter
+	networkConfigProvider NetworkConfigProvider
+	signMarshalizer       marshal.Marshalizer
+	signHasher            hashing.Hasher
+	keyGen                crypto.KeyGenerator
+	singleSigner          crypto.SingleSigner
+}
+
+// NewTransactionValidator will create a new instance of the transactionValidator
+func NewTransactionValidator(
+	pubKeyConverter core.PubkeyConverter,
+	networkConfigProvider NetworkConfigProvider,
+	signMarshalizer marshal.Marshalizer,
+	signHasher hashing.Hasher,
+) (*transactionValidator, error) {
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if networkConfigProvider == nil {
+		return nil, ErrNilNetworkConfigProvider
+	}
+	if check.IfNil(signMarshalizer) {
+		return nil, ErrNilSignMarshalizer
+	}
+	if check.IfNil(signHasher) {
+		return nil, ErrNilSignHasher
+	}
+
+	return &transactionValidator{
+		pubKeyConverter:       pubKeyConverter,
+		networkConfigProvider: networkConfigProvider,
+		signMarshalizer:       signMarshalizer,
+		signHasher:            signHasher,
+		keyGen:                signing.NewKeyGenerator(ed25519.NewEd25519()),
+		singleSigner:          &ed25519SingleSigner.Ed25519Signer{},
+	}, nil
+}
+
+// ValidateTransaction checks the transaction against the network config and verifies its signatures.
+// Validation failures are returned as *errors.ErrInvalidTxFields
+func (tv *transactionValidator) ValidateTransaction(tx *data.Transaction) error {
+	networkConfig, err := tv.networkConfigProvider.GetNetworkConfig()
+	if err != nil {
+		return err
+	}
+
+	coreTx, err := tv.createCoreTransaction(tx)
+	if err != nil {
+		return err
+	}
+
+	err = checkChainIDAndVersion(tx, networkConfig)
+	if err != nil {
+		return err
+	}
+
+	err = checkOptions(tx)
+	if err != nil {
+		return err
+	}
+
+	err = checkGas(tx, networkConfig)
+	if err != nil {
+		return err
+	}
+
+	return tv.checkSignatures(coreTx)
+}
+
+func (tv *transactionValidator) createCoreTransaction(tx *data.Transaction) (*transaction.Transaction, error) {
+	value, ok := big.NewInt(0).SetString(tx.Value, 10)
+	if !ok || value.Sign() < 0 {
+		return nil, errors.NewErrInvalidTxFields("invalid value", fmt.Sprintf("cannot use %s as value", tx.Value))
+	}
+
+	senderAddress, err := tv.pubKeyConverter.Decode(tx.Sender)
+	if err != nil {
+		return nil, errors.NewErrInvalidTxFields(errors.ErrInvalidSenderAddress.Error(), err.Error())
+	}
+
+	receiverAddress, err := tv.pubKeyConverter.Decode(tx.Receiver)
+	if err != nil {
+		return nil, errors.NewErrInvalidTxFields(errors.ErrInvalidReceiverAddress.Error(), err.Error())
+	}
+
+	signature, err := hex.DecodeString(tx.Signature)
+	if err != nil {
+		return nil, errors.NewErrInvalidTxFields(errors.ErrInvalidSignatureHex.Error(), err.Error())
+	}
+
+	coreTx := &transaction.Transaction{
+		Nonce:       tx.Nonce,
+		Value:       value,
+		RcvAddr:     receiverAddress,
+		RcvUserName: tx.ReceiverUsername,
+		SndAddr:     senderAddress,
+		SndUserName: tx.SenderUsername,
+		GasPrice:    tx.GasPrice,
+		GasLimit:    tx.GasLimit,
+		Data:        tx.Data,
+		ChainID:     []byte(tx.ChainID),
+		Version:     tx.Version,
+		Signature:   signature,
+		Options:     tx.Options,
+	}
+
+	if len(tx.GuardianAddr) > 0 {
+		coreTx.GuardianAddr, err = tv.pubKeyConverter.Decode(tx.GuardianAddr)
+		if err != nil {
+			return nil, errors.NewErrInvalidTxFields(errors.ErrInvalidGuardianAddress.Error(), err.Error())
+		}
+	}
+	if len(tx.GuardianSignature) > 0 {
+		coreTx.GuardianSignature, err = hex.DecodeString(tx.GuardianSignature)
+		if err != nil {
+			return nil, errors.NewErrInvalidTxFields(errors.ErrInvalidGuardianSignatureHex.Error(), err.Error())
+		}
+	}
+	if len(tx.RelayerAddr) > 0 {
+		coreTx.RelayerAddr, err = tv.pubKeyConverter.Decode(tx.RelayerAddr)
+		if err != nil {
+			return nil, errors.NewErrInvalidTxFields(errors.ErrInvalidRelayerAddress.Error(), err.Error())
+		}
+	}
+	if len(tx.RelayerSignature) > 0 {
+		coreTx.RelayerSignature, err = hex.DecodeString(tx.RelayerSignature)
+		if err != nil {
+			return nil, errors.NewErrInvalidTxFields(errors.ErrInvalidRelayerSignature.Error(), err.Error())
+		}
+	}
+
+	return coreTx, nil
+}
+
+func checkChainIDAndVersion(tx *data.Transaction, networkConfig *data.NetworkConfig) error {
+	if tx.ChainID != networkConfig.Config.ChainID {
+		return errors.NewErrInvalidTxFields(
+			errors.ErrInvalidChainID.Error(),
+			fmt.Sprintf("expected %s, got %s", networkConfig.Config.ChainID, tx.ChainID),
+		)
+	}
+
+	if tx.Version < networkConfig.Config.MinTransactionVersion {
+		return errors.NewErrInvalidTxFields(
+			errors.ErrInvalidTransactionVersion.Error(),
+			fmt.Sprintf("minimum %d, got %d", networkConfig.Config.MinTransactionVersion, tx.Version),
+		)
+	}
+
+	return nil
+}
+
+func checkOptions(tx *data.Transaction) error {
+	if tx.Options != 0 && tx.Version < common.MinVersionForOptions {
+		return errors.NewErrInvalidTxFields(
+			errors.ErrInvalidTransactionOptions.Error(),
+			fmt.Sprintf("options can only be used starting with version %d", common.MinVersionForOptions),
+		)
+	}
+
+	isGuarded := tx.Options&transaction.MaskGuardedTransaction > 0
+	hasGuardianFields := len(tx.GuardianAddr) > 0 || len(tx.GuardianSignature) > 0
+	if isGuarded && (len(tx.GuardianAddr) == 0 || len(tx.GuardianSignature) == 0) {
+		return errors.NewErrInvalidTxFields(
+			errors.ErrInvalidTransactionOptions.Error(),
+			"guarded transaction without guardian address or guardian signature",
+		)
+	}
+	if !isGuarded && hasGuardianFields {
+		return errors.NewErrInvalidTxFields(
+			errors.ErrInvalidTransactionOptions.Error(),
+			"guardian provided but the guarded option is not set",
+		)
+	}
+
+	hasRelayerAddress := len(tx.RelayerAddr) > 0
+	hasRelayerSignature := len(tx.RelayerSignature) > 0
+	if hasRelayerAddress != hasRelayerSignature {
+		return errors.NewErrInvalidTxFields(
+			errors.ErrInvalidRelayerSignature.Error(),
+			"relayer address and relayer signature must be provided together",
+		)
+	}
+
+	return nil
+}
+
+func checkGas(tx *data.Transaction, networkConfig *data.NetworkConfig) error {
+	if tx.GasPrice < networkConfig.Config.MinGasPrice {
+		return errors.NewErrInvalidTxFields(
+			errors.ErrInsufficientGasPrice.Error(),
+			fmt.Sprintf("minimum %d, got %d", networkConfig.Config.MinGasPrice, tx.GasPrice),
+		)
+	}
+
+	minGasLimit := common.ComputeTransactionMoveBalanceGas(tx, networkConfig)
+	if tx.GasLimit < minGasLimit {
+		return errors.NewErrInvalidTxFields(
+			errors.ErrInsufficientGasLimit.Error(),
+			fmt.Sprintf("minimum %d for %d data bytes, got %d", minGasLimit, len(tx.Data), tx.GasLimit),
+		)
+	}
+
+	return nil
+}
+
+func (tv *transactionValidator) checkSignatures(coreTx *transaction.Transaction) error {
+	dataForSigning, err := coreTx.GetDataForSigning(tv.pubKeyConverter, tv.signMarshalizer, tv.signHasher)
+	if err != nil {
+		return errors.NewErrInvalidTxFields(errors.ErrInvalidSignature.Error(), err.Error())
+	}
+
+	err = tv.verifySignature(coreTx.SndAddr, dataForSigning, coreTx.Signature)
+	if err != nil {
+		return errors.NewErrInvalidTxFields(errors.ErrInvalidSignature.Error(), err.Error())
+	}
+
+	if len(coreTx.GuardianAddr) > 0 {
+		err = tv.verifySignature(coreTx.GuardianAddr, dataForSigning, coreTx.GuardianSignature)
+		if err != nil {
+			return errors.NewErrInvalidTxFields(errors.ErrInvalidGuardianSignature.Error(), err.Error())
+		}
+	}
+
+	if len(coreTx.RelayerAddr) > 0 {
+		err = tv.verifySignature(coreTx.RelayerAddr, dataForSigning, coreTx.RelayerSignature)
+		if err != nil {
+			return errors.NewErrInvalidTxFields(errors.ErrInvalidRelayerSignature.Error(), err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (tv *transactionValidator) verifySignature(pubKeyBytes []byte, message []byte, signature []byte) error {
+	pubKey, err := tv.keyGen.PublicKeyFromByteArray(pubKeyBytes)
+	if err != nil {
+		return err
+	}
+
+	return tv.singleSigner.Verify(pubKey, message, signature)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tv *transactionValidator) IsInterfaceNil() bool {
+	return tv == nil
+}
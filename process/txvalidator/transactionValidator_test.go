@@ -0,0 +1,289 @@
+package txvalidator
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	ed25519SingleSigner "github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+var testPubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+
+type networkConfigProviderStub struct {
+	getNetworkConfigCalled func() (*data.NetworkConfig, error)
+}
+
+func (stub *networkConfigProviderStub) GetNetworkConfig() (*data.NetworkConfig, error) {
+	return stub.getNetworkConfigCalled()
+}
+
+func createNetworkConfigProvider() *networkConfigProviderStub {
+	networkConfig := &data.NetworkConfig{}
+	networkConfig.Config.ChainID = "T"
+	networkConfig.Config.MinGasLimit = 50000
+	networkConfig.Config.MinGasPrice = 1000000000
+	networkConfig.Config.GasPerDataByte = 1500
+	networkConfig.Config.MinTransactionVersion = 1
+	networkConfig.Config.ExtraGasLimitGuardedTx = 50000
+
+	return &networkConfigProviderStub{
+		getNetworkConfigCalled: func() (*data.NetworkConfig, error) {
+			return networkConfig, nil
+		},
+	}
+}
+
+func createTestValidator(t *testing.T) *transactionValidator {
+	tv, err := NewTransactionValidator(
+		testPubKeyConverter,
+		createNetworkConfigProvider(),
+		&marshal.JsonMarshalizer{},
+		keccak.NewKeccak(),
+	)
+	require.Nil(t, err)
+
+	return tv
+}
+
+type testAccount struct {
+	sk      crypto.PrivateKey
+	address string
+}
+
+func createTestAccount() testAccount {
+	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
+	sk, pk := keyGen.GeneratePair()
+	pkBytes, _ := pk.ToByteArray()
+
+	return testAccount{
+		sk:      sk,
+		address: testPubKeyConverter.SilentEncode(pkBytes, nil),
+	}
+}
+
+func signTx(t *testing.T, tv *transactionValidator, tx *data.Transaction, sender testAccount, guardian *testAccount) {
+	coreTx, err := tv.createCoreTransaction(tx)
+	require.Nil(t, err)
+	dataForSigning, err := coreTx.GetDataForSigning(tv.pubKeyConverter, tv.signMarshalizer, tv.signHasher)
+	require.Nil(t, err)
+
+	signer := &ed25519SingleSigner.Ed25519Signer{}
+	signature, err := signer.Sign(sender.sk, dataForSigning)
+	require.Nil(t, err)
+	tx.Signature = hex.EncodeToString(signature)
+
+	if guardian != nil {
+		guardianSignature, errSign := signer.Sign(guardian.sk, dataForSigning)
+		require.Nil(t, errSign)
+		tx.GuardianSignature = hex.EncodeToString(guardianSignature)
+	}
+}
+
+func createValidTx(sender testAccount) *data.Transaction {
+	return &data.Transaction{
+		Nonce:    1,
+		Value:    "1000",
+		Receiver: sender.address,
+		Sender:   sender.address,
+		GasPrice: 1000000000,
+		GasLimit: 50000 + 1500*4,
+		Data:     []byte("test"),
+		ChainID:  "T",
+		Version:  1,
+	}
+}
+
+func requireInvalidTxFieldsError(t *testing.T, err error, expectedMessage error) {
+	errInvalidTxFields, ok := err.(*apiErrors.ErrInvalidTxFields)
+	require.True(t, ok, "error %v is not ErrInvalidTxFields", err)
+	require.Equal(t, expectedMessage.Error(), errInvalidTxFields.Message)
+}
+
+func TestNewTransactionValidator(t *testing.T) {
+	t.Parallel()
+
+	tv, err := NewTransactionValidator(nil, createNetworkConfigProvider(), &marshal.JsonMarshalizer{}, keccak.NewKeccak())
+	require.Nil(t, tv)
+	require.Equal(t, ErrNilPubKeyConverter, err)
+
+	tv, err = NewTransactionValidator(testPubKeyConverter, nil, &marshal.JsonMarshalizer{}, keccak.NewKeccak())
+	require.Nil(t, tv)
+	require.Equal(t, ErrNilNetworkConfigProvider, err)
+
+	tv, err = NewTransactionValidator(testPubKeyConverter, createNetworkConfigProvider(), nil, keccak.NewKeccak())
+	require.Nil(t, tv)
+	require.Equal(t, ErrNilSignMarshalizer, err)
+
+	tv, err = NewTransactionValidator(testPubKeyConverter, createNetworkConfigProvider(), &marshal.JsonMarshalizer{}, nil)
+	require.Nil(t, tv)
+	require.Equal(t, ErrNilSignHasher, err)
+
+	tv, err = NewTransactionValidator(testPubKeyConverter, createNetworkConfigProvider(), &marshal.JsonMarshalizer{}, keccak.NewKeccak())
+	require.NotNil(t, tv)
+	require.Nil(t, err)
+}
+
+func TestTransactionValidator_ValidateTransaction(t *testing.T) {
+	t.Parallel()
+
+	sender := createTestAccount()
+	guardian := createTestAccount()
+
+	t.Run("network config error should return it", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		tv, _ := NewTransactionValidator(
+			testPubKeyConverter,
+			&networkConfigProviderStub{
+				getNetworkConfigCalled: func() (*data.NetworkConfig, error) {
+					return nil, expectedErr
+				},
+			},
+			&marshal.JsonMarshalizer{},
+			keccak.NewKeccak(),
+		)
+
+		err := tv.ValidateTransaction(createValidTx(sender))
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("valid transaction should work", func(t *testing.T) {
+		t.Parallel()
+
+		tv := createTestValidator(t)
+		tx := createValidTx(sender)
+		signTx(t, tv, tx, sender, nil)
+
+		require.Nil(t, tv.ValidateTransaction(tx))
+	})
+	t.Run("valid transaction signed with hash should work", func(t *testing.T) {
+		t.Parallel()
+
+		tv := createTestValidator(t)
+		tx := createValidTx(sender)
+		tx.Version = 2
+		tx.Options = transaction.MaskSignedWithHash
+		signTx(t, tv, tx, sender, nil)
+
+		require.Nil(t, tv.ValidateTransaction(tx))
+	})
+	t.Run("valid guarded transaction should work", func(t *testing.T) {
+		t.Parallel()
+
+		tv := createTestValidator(t)
+		tx := createValidTx(sender)
+		tx.Version = 2
+		tx.Options = transaction.MaskGuardedTransaction
+		tx.GuardianAddr = guardian.address
+		tx.GasLimit += 50000
+		signTx(t, tv, tx, sender, &guardian)
+
+		require.Nil(t, tv.ValidateTransaction(tx))
+	})
+	t.Run("tampered transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		tv := createTestValidator(t)
+		tx := createValidTx(sender)
+		signTx(t, tv, tx, sender, nil)
+		tx.Value = "1001"
+
+		requireInvalidTxFieldsError(t, tv.ValidateTransaction(tx), apiErrors.ErrInvalidSignature)
+	})
+	t.Run("invalid guardian signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		tv := createTestValidator(t)
+		tx := createValidTx(sender)
+		tx.Version = 2
+		tx.Options = transaction.MaskGuardedTransaction
+		tx.GuardianAddr = guardian.address
+		tx.GasLimit += 50000
+		signTx(t, tv, tx, sender, &sender)
+
+		requireInvalidTxFieldsError(t, tv.ValidateTransaction(tx), apiErrors.ErrInvalidGuardianSignature)
+	})
+	t.Run("wrong chain ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		tv := createTestValidator(t)
+		tx := createValidTx(sender)
+		tx.ChainID = "1"
+		signTx(t, tv, tx, sender, nil)
+
+		requireInvalidTxFieldsError(t, tv.ValidateTransaction(tx), apiErrors.ErrInvalidChainID)
+	})
+	t.Run("options on first version should error", func(t *testing.T) {
+		t.Parallel()
+
+		tv := createTestValidator(t)
+		tx := createValidTx(sender)
+		tx.Options = transaction.MaskSignedWithHash
+		signTx(t, tv, tx, sender, nil)
+
+		requireInvalidTxFieldsError(t, tv.ValidateTransaction(tx), apiErrors.ErrInvalidTransactionOptions)
+	})
+	t.Run("guarded option without guardian should error", func(t *testing.T) {
+		t.Parallel()
+
+		tv := createTestValidator(t)
+		tx := createValidTx(sender)
+		tx.Version = 2
+		tx.Options = transaction.MaskGuardedTransaction
+		tx.GasLimit += 50000
+		signTx(t, tv, tx, sender, nil)
+
+		requireInvalidTxFieldsError(t, tv.ValidateTransaction(tx), apiErrors.ErrInvalidTransactionOptions)
+	})
+	t.Run("low gas price should error", func(t *testing.T) {
+		t.Parallel()
+
+		tv := createTestValidator(t)
+		tx := createValidTx(sender)
+		tx.GasPrice = 1
+		signTx(t, tv, tx, sender, nil)
+
+		requireInvalidTxFieldsError(t, tv.ValidateTransaction(tx), apiErrors.ErrInsufficientGasPrice)
+	})
+	t.Run("gas limit too low for data should error", func(t *testing.T) {
+		t.Parallel()
+
+		tv := createTestValidator(t)
+		tx := createValidTx(sender)
+		tx.Data = []byte("longer data field")
+		signTx(t, tv, tx, sender, nil)
+
+		requireInvalidTxFieldsError(t, tv.ValidateTransaction(tx), apiErrors.ErrInsufficientGasLimit)
+	})
+	t.Run("relayed v3 without the extra gas limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		tv := createTestValidator(t)
+		tx := createValidTx(sender)
+		tx.RelayerAddr = sender.address
+		tx.RelayerSignature = "aa"
+		signTx(t, tv, tx, sender, nil)
+
+		requireInvalidTxFieldsError(t, tv.ValidateTransaction(tx), apiErrors.ErrInsufficientGasLimit)
+	})
+	t.Run("invalid value should error", func(t *testing.T) {
+		t.Parallel()
+
+		tv := createTestValidator(t)
+		tx := createValidTx(sender)
+		tx.Value = "-1"
+
+		requireInvalidTxFieldsError(t, tv.ValidateTransaction(tx), errors.New("invalid value"))
+	})
+}
@@ -0,0 +1,27 @@
+package usernames
+
+import "errors"
+
+// ErrNilSCQueryService signals that a nil smart contract query service has been provided
+var ErrNilSCQueryService = errors.New("nil smart contract query service")
+
+// ErrNilShardIDComputer signals that a nil shard ID computer has been provided
+var ErrNilShardIDComputer = errors.New("nil shard ID computer")
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrInvalidMaxUsernamesInBatch signals that an invalid maximum number of usernames in a batch has been provided
+var ErrInvalidMaxUsernamesInBatch = errors.New("invalid maximum number of usernames in a batch")
+
+// ErrInvalidCacheValidity signals that an invalid cache validity has been provided
+var ErrInvalidCacheValidity = errors.New("invalid cache validity")
+
+// ErrInvalidNegativeCacheValidity signals that an invalid negative cache validity has been provided
+var ErrInvalidNegativeCacheValidity = errors.New("invalid negative cache validity")
+
+// ErrDnsQueryFailed signals that the resolve query of the DNS contract did not succeed
+var ErrDnsQueryFailed = errors.New("DNS contract query failed")
@@ -0,0 +1,21 @@
+package usernames
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// SCQueryService defines what a component which executes vm-queries should do
+type SCQueryService interface {
+	ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+}
+
+// ShardIDComputer defines what a component which computes the shard of an address should do
+type ShardIDComputer interface {
+	ComputeShardId(addressBuff []byte) (uint32, error)
+}
+
+type resolutionsCacher interface {
+	Get(key string) (interface{}, bool)
+	Put(key string, value interface{})
+}
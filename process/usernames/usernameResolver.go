@@ -0,0 +1,266 @@
+package usernames
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+)
+
+const (
+	resolveFunc    = "resolve"
+	vmOutputOkCode = "ok"
+
+	numDnsContracts      = 256
+	addressLength        = 32
+	shardIdentifierLen   = 2
+	maxConcurrentQueries = 10
+)
+
+var log = logger.GetOrCreate("process/usernames")
+
+// ArgsUsernameResolver holds the arguments needed for creating a new username resolver
+type ArgsUsernameResolver struct {
+	SCQueryService        SCQueryService
+	ShardIDComputer       ShardIDComputer
+	PubKeyConverter       core.PubkeyConverter
+	Hasher                hashing.Hasher
+	CacheValidity         time.Duration
+	NegativeCacheValidity time.Duration
+	MaxUsernamesInBatch   int
+}
+
+type usernameResolver struct {
+	scQueryService      SCQueryService
+	shardIDComputer     ShardIDComputer
+	pubKeyConverter     core.PubkeyConverter
+	hasher              hashing.Hasher
+	maxUsernamesInBatch int
+	dnsAddresses        []string
+
+	resolutions           resolutionsCacher
+	unregisteredUsernames resolutionsCacher
+}
+
+// NewUsernameResolver creates a new instance of usernameResolver
+func NewUsernameResolver(args ArgsUsernameResolver) (*usernameResolver, error) {
+	if args.SCQueryService == nil {
+		return nil, ErrNilSCQueryService
+	}
+	if args.ShardIDComputer == nil {
+		return nil, ErrNilShardIDComputer
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if args.CacheValidity <= 0 {
+		return nil, ErrInvalidCacheValidity
+	}
+	if args.NegativeCacheValidity <= 0 {
+		return nil, ErrInvalidNegativeCacheValidity
+	}
+	if args.MaxUsernamesInBatch <= 0 {
+		return nil, ErrInvalidMaxUsernamesInBatch
+	}
+
+	resolutions, err := cache.NewTimedMemoryCacher(args.CacheValidity)
+	if err != nil {
+		return nil, err
+	}
+	unregisteredUsernames, err := cache.NewTimedMemoryCacher(args.NegativeCacheValidity)
+	if err != nil {
+		return nil, err
+	}
+
+	dnsAddresses, err := computeDnsAddresses(args.Hasher, args.PubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usernameResolver{
+		scQueryService:        args.SCQueryService,
+		shardIDComputer:       args.ShardIDComputer,
+		pubKeyConverter:       args.PubKeyConverter,
+		hasher:                args.Hasher,
+		maxUsernamesInBatch:   args.MaxUsernamesInBatch,
+		dnsAddresses:          dnsAddresses,
+		resolutions:           resolutions,
+		unregisteredUsernames: unregisteredUsernames,
+	}, nil
+}
+
+// ResolveUsername returns the address the provided username resolves to. A username which is not registered is
+// returned as not found, without an error
+func (ur *usernameResolver) ResolveUsername(username string) (*data.UsernameResolution, error) {
+	username = normalizeUsername(username)
+	if len(username) == 0 {
+		return nil, newInvalidUsernamesRequestError("empty username")
+	}
+
+	return ur.resolve(username)
+}
+
+// ResolveUsernames returns the addresses the provided usernames resolve to, indexed by the requested usernames
+func (ur *usernameResolver) ResolveUsernames(usernames []string) (*data.UsernamesResolutionResponseData, error) {
+	if len(usernames) == 0 {
+		return nil, newInvalidUsernamesRequestError("no username provided")
+	}
+	if len(usernames) > ur.maxUsernamesInBatch {
+		return nil, newInvalidUsernamesRequestError("at most " + strconv.Itoa(ur.maxUsernamesInBatch) + " usernames can be resolved at once")
+	}
+	for _, username := range usernames {
+		if len(normalizeUsername(username)) == 0 {
+			return nil, newInvalidUsernamesRequestError("empty username")
+		}
+	}
+
+	var wg sync.WaitGroup
+	var mut sync.Mutex
+	var resolveErr error
+	resolutions := make(map[string]*data.UsernameResolution, len(usernames))
+	throttler := make(chan struct{}, maxConcurrentQueries)
+	for _, username := range usernames {
+		wg.Add(1)
+		throttler <- struct{}{}
+		go func(username string) {
+			defer func() {
+				<-throttler
+				wg.Done()
+			}()
+
+			resolution, err := ur.resolve(normalizeUsername(username))
+
+			mut.Lock()
+			defer mut.Unlock()
+
+			if err != nil {
+				resolveErr = err
+				return
+			}
+			resolutions[username] = resolution
+		}(username)
+	}
+
+	wg.Wait()
+
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+
+	return &data.UsernamesResolutionResponseData{
+		Usernames: resolutions,
+	}, nil
+}
+
+func (ur *usernameResolver) resolve(username string) (*data.UsernameResolution, error) {
+	cachedResolution, found := ur.resolutions.Get(username)
+	if found {
+		return cachedResolution.(*data.UsernameResolution), nil
+	}
+	cachedResolution, found = ur.unregisteredUsernames.Get(username)
+	if found {
+		return cachedResolution.(*data.UsernameResolution), nil
+	}
+
+	resolution, err := ur.queryDnsContract(username)
+	if err != nil {
+		return nil, err
+	}
+
+	if resolution.Found {
+		ur.resolutions.Put(username, resolution)
+	} else {
+		ur.unregisteredUsernames.Put(username, resolution)
+	}
+
+	return resolution, nil
+}
+
+func (ur *usernameResolver) queryDnsContract(username string) (*data.UsernameResolution, error) {
+	resolution := &data.UsernameResolution{
+		Username:   username,
+		DnsAddress: ur.dnsAddresses[computeDnsAddressIndex(ur.hasher, username)],
+	}
+
+	vmOutput, _, err := ur.scQueryService.ExecuteQuery(&data.SCQuery{
+		ScAddress: resolution.DnsAddress,
+		FuncName:  resolveFunc,
+		Arguments: [][]byte{[]byte(username)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmOutputOkCode {
+		return nil, fmt.Errorf("%w: %s %s", ErrDnsQueryFailed, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	if len(vmOutput.ReturnData) == 0 || len(vmOutput.ReturnData[0]) == 0 {
+		log.Trace("username not registered", "username", username, "dns", resolution.DnsAddress)
+		return resolution, nil
+	}
+
+	addressBytes := vmOutput.ReturnData[0]
+	resolution.Address, err = ur.pubKeyConverter.Encode(addressBytes)
+	if err != nil {
+		return nil, err
+	}
+	resolution.ShardID, err = ur.shardIDComputer.ComputeShardId(addressBytes)
+	if err != nil {
+		return nil, err
+	}
+	resolution.Found = true
+
+	return resolution, nil
+}
+
+// computeDnsAddresses computes the addresses of the DNS contracts, deployed at genesis by the addresses made of 30 bytes
+// of 1 followed by the 2 bytes of the contract index
+func computeDnsAddresses(hasher hashing.Hasher, pubKeyConverter core.PubkeyConverter) ([]string, error) {
+	dnsAddresses := make([]string, 0, numDnsContracts)
+	for index := 0; index < numDnsContracts; index++ {
+		deployer := append(bytes.Repeat([]byte{1}, addressLength-shardIdentifierLen), 0, byte(index))
+		dnsAddress, err := pubKeyConverter.Encode(common.ComputeContractAddress(hasher, deployer, 0, common.WasmVMType))
+		if err != nil {
+			return nil, err
+		}
+
+		dnsAddresses = append(dnsAddresses, dnsAddress)
+	}
+
+	return dnsAddresses, nil
+}
+
+func computeDnsAddressIndex(hasher hashing.Hasher, username string) byte {
+	hash := hasher.Compute(username)
+	return hash[len(hash)-1]
+}
+
+func normalizeUsername(username string) string {
+	return strings.ToLower(strings.TrimSpace(username))
+}
+
+func newInvalidUsernamesRequestError(reason string) error {
+	return &apiErrors.ErrInvalidRequest{
+		Message: apiErrors.ErrInvalidUsernamesRequest.Error(),
+		Reason:  reason,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ur *usernameResolver) IsInterfaceNil() bool {
+	return ur == nil
+}
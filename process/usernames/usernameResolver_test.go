@@ -0,0 +1,295 @@
+package usernames
+
+import (
+	"encoding/hex"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testUsername   = "test.elrond"
+	testDnsAddress = "erd1qqqqqqqqqqqqqpgqx4ca3eu4k6w63hl8pjjyq2cp7ul7a4ukqz0skq6fxj"
+	testAddressHex = "0139472eff6886771a982f3083da5d421f24c29181e63888228dc81ca60d69e1"
+)
+
+type shardIDComputerStub struct{}
+
+func (stub *shardIDComputerStub) ComputeShardId(addressBuff []byte) (uint32, error) {
+	return uint32(addressBuff[len(addressBuff)-1] % 3), nil
+}
+
+func createMockArgs() ArgsUsernameResolver {
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+
+	return ArgsUsernameResolver{
+		SCQueryService: &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				return &vm.VMOutputApi{ReturnCode: vmOutputOkCode}, data.BlockInfo{}, nil
+			},
+		},
+		ShardIDComputer:       &shardIDComputerStub{},
+		PubKeyConverter:       converter,
+		Hasher:                keccak.NewKeccak(),
+		CacheValidity:         time.Minute,
+		NegativeCacheValidity: time.Minute,
+		MaxUsernamesInBatch:   2,
+	}
+}
+
+func createResolveQueryService(numQueries *uint32) *mock.SCQueryServiceStub {
+	addressBytes, _ := hex.DecodeString(testAddressHex)
+
+	return &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+			atomic.AddUint32(numQueries, 1)
+			if string(query.Arguments[0]) != testUsername {
+				return &vm.VMOutputApi{ReturnCode: vmOutputOkCode, ReturnData: [][]byte{{}}}, data.BlockInfo{}, nil
+			}
+
+			return &vm.VMOutputApi{ReturnCode: vmOutputOkCode, ReturnData: [][]byte{addressBytes}}, data.BlockInfo{}, nil
+		},
+	}
+}
+
+func TestNewUsernameResolver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil sc query service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.SCQueryService = nil
+		ur, err := NewUsernameResolver(args)
+		require.Nil(t, ur)
+		require.Equal(t, ErrNilSCQueryService, err)
+	})
+	t.Run("nil shard ID computer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.ShardIDComputer = nil
+		ur, err := NewUsernameResolver(args)
+		require.Nil(t, ur)
+		require.Equal(t, ErrNilShardIDComputer, err)
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.PubKeyConverter = nil
+		ur, err := NewUsernameResolver(args)
+		require.Nil(t, ur)
+		require.Equal(t, ErrNilPubKeyConverter, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Hasher = nil
+		ur, err := NewUsernameResolver(args)
+		require.Nil(t, ur)
+		require.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("invalid cache validity should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.CacheValidity = 0
+		ur, err := NewUsernameResolver(args)
+		require.Nil(t, ur)
+		require.Equal(t, ErrInvalidCacheValidity, err)
+	})
+	t.Run("invalid negative cache validity should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.NegativeCacheValidity = 0
+		ur, err := NewUsernameResolver(args)
+		require.Nil(t, ur)
+		require.Equal(t, ErrInvalidNegativeCacheValidity, err)
+	})
+	t.Run("invalid max usernames in batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.MaxUsernamesInBatch = 0
+		ur, err := NewUsernameResolver(args)
+		require.Nil(t, ur)
+		require.Equal(t, ErrInvalidMaxUsernamesInBatch, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ur, err := NewUsernameResolver(createMockArgs())
+		require.Nil(t, err)
+		require.False(t, ur.IsInterfaceNil())
+		require.Len(t, ur.dnsAddresses, numDnsContracts)
+	})
+}
+
+func TestComputeDnsAddresses(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	dnsAddresses, err := computeDnsAddresses(args.Hasher, args.PubKeyConverter)
+	require.Nil(t, err)
+
+	index := computeDnsAddressIndex(args.Hasher, testUsername)
+	require.Equal(t, testDnsAddress, dnsAddresses[index])
+
+	for i, dnsAddress := range dnsAddresses {
+		addressBytes, errDecode := args.PubKeyConverter.Decode(dnsAddress)
+		require.Nil(t, errDecode)
+		numInitZeroBytes := core.NumInitCharactersForScAddress - core.VMTypeLen
+		require.Equal(t, make([]byte, numInitZeroBytes), addressBytes[:numInitZeroBytes])
+		require.Equal(t, common.WasmVMType, addressBytes[numInitZeroBytes:core.NumInitCharactersForScAddress])
+		require.Equal(t, []byte{0, byte(i)}, addressBytes[addressLength-shardIdentifierLen:])
+	}
+}
+
+func TestUsernameResolver_ResolveUsername(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty username should error", func(t *testing.T) {
+		t.Parallel()
+
+		ur, _ := NewUsernameResolver(createMockArgs())
+
+		resolution, err := ur.ResolveUsername(" ")
+		require.Nil(t, resolution)
+		_, isInvalidRequest := err.(*apiErrors.ErrInvalidRequest)
+		require.True(t, isInvalidRequest)
+	})
+	t.Run("query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("observers offline")
+		args := createMockArgs()
+		args.SCQueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				return nil, data.BlockInfo{}, expectedErr
+			},
+		}
+		ur, _ := NewUsernameResolver(args)
+
+		resolution, err := ur.ResolveUsername(testUsername)
+		require.Nil(t, resolution)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("failed query should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.SCQueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				return &vm.VMOutputApi{ReturnCode: "user error", ReturnMessage: "out of gas"}, data.BlockInfo{}, nil
+			},
+		}
+		ur, _ := NewUsernameResolver(args)
+
+		resolution, err := ur.ResolveUsername(testUsername)
+		require.Nil(t, resolution)
+		require.ErrorIs(t, err, ErrDnsQueryFailed)
+	})
+	t.Run("should query the DNS contract handling the username and cache the result", func(t *testing.T) {
+		t.Parallel()
+
+		numQueries := uint32(0)
+		args := createMockArgs()
+		addressBytes, _ := hex.DecodeString(testAddressHex)
+		args.SCQueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				numQueries++
+				require.Equal(t, testDnsAddress, query.ScAddress)
+				require.Equal(t, resolveFunc, query.FuncName)
+				require.Equal(t, [][]byte{[]byte(testUsername)}, query.Arguments)
+
+				return &vm.VMOutputApi{ReturnCode: vmOutputOkCode, ReturnData: [][]byte{addressBytes}}, data.BlockInfo{}, nil
+			},
+		}
+		ur, _ := NewUsernameResolver(args)
+
+		expectedAddress, _ := args.PubKeyConverter.Encode(addressBytes)
+		expectedResolution := &data.UsernameResolution{
+			Username:   testUsername,
+			DnsAddress: testDnsAddress,
+			Found:      true,
+			Address:    expectedAddress,
+			ShardID:    uint32(addressBytes[len(addressBytes)-1] % 3),
+		}
+
+		resolution, err := ur.ResolveUsername(" Test.Elrond")
+		require.Nil(t, err)
+		require.Equal(t, expectedResolution, resolution)
+
+		resolution, err = ur.ResolveUsername(testUsername)
+		require.Nil(t, err)
+		require.Equal(t, expectedResolution, resolution)
+		require.Equal(t, uint32(1), numQueries)
+	})
+	t.Run("username not registered should be cached as not found", func(t *testing.T) {
+		t.Parallel()
+
+		numQueries := uint32(0)
+		args := createMockArgs()
+		args.SCQueryService = createResolveQueryService(&numQueries)
+		ur, _ := NewUsernameResolver(args)
+
+		resolution, err := ur.ResolveUsername("missing.elrond")
+		require.Nil(t, err)
+		require.False(t, resolution.Found)
+		require.Empty(t, resolution.Address)
+
+		_, _ = ur.ResolveUsername("missing.elrond")
+		require.Equal(t, uint32(1), numQueries)
+	})
+}
+
+func TestUsernameResolver_ResolveUsernames(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		ur, _ := NewUsernameResolver(createMockArgs())
+
+		batches := [][]string{
+			nil,
+			{"a.elrond", "b.elrond", "c.elrond"},
+			{"a.elrond", ""},
+		}
+		for _, batch := range batches {
+			resolutions, err := ur.ResolveUsernames(batch)
+			require.Nil(t, resolutions)
+			_, isInvalidRequest := err.(*apiErrors.ErrInvalidRequest)
+			require.True(t, isInvalidRequest)
+		}
+	})
+	t.Run("should index the resolutions by the requested usernames", func(t *testing.T) {
+		t.Parallel()
+
+		numQueries := uint32(0)
+		args := createMockArgs()
+		args.SCQueryService = createResolveQueryService(&numQueries)
+		ur, _ := NewUsernameResolver(args)
+
+		resolutions, err := ur.ResolveUsernames([]string{"TEST.elrond", "missing.elrond"})
+		require.Nil(t, err)
+		require.Len(t, resolutions.Usernames, 2)
+		require.True(t, resolutions.Usernames["TEST.elrond"].Found)
+		require.False(t, resolutions.Usernames["missing.elrond"].Found)
+		require.Equal(t, uint32(2), atomic.LoadUint32(&numQueries))
+	})
+}
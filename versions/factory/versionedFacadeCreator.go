@@ -29,6 +29,24 @@ type FacadeArgs struct {
 	ESDTSuppliesProcessor        facade.ESDTSupplyProcessor
 	StatusProcessor              facade.StatusProcessor
 	AboutInfoProcessor           facade.AboutInfoProcessor
+	NonceProcessor               facade.NonceProcessor
+	TransactionValidator         facade.TransactionValidator
+	DataFieldDecoder             facade.DataFieldDecoder
+	DatabaseConnector            facade.DatabaseConnector
+	TransactionsTracker          facade.TransactionsTracker
+	FeeComputer                  facade.FeeComputer
+	TransactionsDeduplicator     facade.TransactionsDeduplicator
+	MempoolExplorer              facade.MempoolExplorer
+	GasPriceRecommender          facade.GasPriceRecommender
+	TransactionBuilder           facade.TransactionBuilder
+	AddressWatcher               facade.AddressWatcher
+	UsernameResolver             facade.UsernameResolver
+	TokenProfileProcessor        facade.TokenProfileProcessor
+	StakingPositionProcessor     facade.StakingPositionProcessor
+	AddressUtilsProcessor        facade.AddressUtilsProcessor
+	NetworkConfigProvider        facade.NetworkConfigProvider
+	TransactionWaiter            facade.TransactionWaiter
+	TransactionsBatchProcessor   facade.TransactionsBatchProcessor
 }
 
 // CreateVersionsRegistry creates the version registry instances and populates it with the versions and their handlers
@@ -111,6 +129,24 @@ func createVersionV1_0Facade(facadeArgs FacadeArgs) (*facadeVersions.ProxyFacade
 		ESDTSuppliesProcessor:        facadeArgs.ESDTSuppliesProcessor,
 		StatusProcessor:              facadeArgs.StatusProcessor,
 		AboutInfoProcessor:           facadeArgs.AboutInfoProcessor,
+		NonceProcessor:               facadeArgs.NonceProcessor,
+		TransactionValidator:         facadeArgs.TransactionValidator,
+		DataFieldDecoder:             facadeArgs.DataFieldDecoder,
+		DatabaseConnector:            facadeArgs.DatabaseConnector,
+		TransactionsTracker:          facadeArgs.TransactionsTracker,
+		FeeComputer:                  facadeArgs.FeeComputer,
+		TransactionsDeduplicator:     facadeArgs.TransactionsDeduplicator,
+		MempoolExplorer:              facadeArgs.MempoolExplorer,
+		GasPriceRecommender:          facadeArgs.GasPriceRecommender,
+		TransactionBuilder:           facadeArgs.TransactionBuilder,
+		AddressWatcher:               facadeArgs.AddressWatcher,
+		UsernameResolver:             facadeArgs.UsernameResolver,
+		TokenProfileProcessor:        facadeArgs.TokenProfileProcessor,
+		StakingPositionProcessor:     facadeArgs.StakingPositionProcessor,
+		AddressUtilsProcessor:        facadeArgs.AddressUtilsProcessor,
+		NetworkConfigProvider:        facadeArgs.NetworkConfigProvider,
+		TransactionWaiter:            facadeArgs.TransactionWaiter,
+		TransactionsBatchProcessor:   facadeArgs.TransactionsBatchProcessor,
 	}
 
 	commonFacade, err := createVersionedFacade(v1_0HandlerArgs)
@@ -169,6 +205,24 @@ func createVersionV_nextFacade(facadeArgs FacadeArgs) (data.FacadeHandler, error
 		PubKeyConverter:              facadeArgs.PubKeyConverter,
 		ESDTSuppliesProcessor:        facadeArgs.ESDTSuppliesProcessor,
 		StatusProcessor:              facadeArgs.StatusProcessor,
+		NonceProcessor:               facadeArgs.NonceProcessor,
+		TransactionValidator:         facadeArgs.TransactionValidator,
+		DataFieldDecoder:             facadeArgs.DataFieldDecoder,
+		DatabaseConnector:            facadeArgs.DatabaseConnector,
+		TransactionsTracker:          facadeArgs.TransactionsTracker,
+		FeeComputer:                  facadeArgs.FeeComputer,
+		TransactionsDeduplicator:     facadeArgs.TransactionsDeduplicator,
+		MempoolExplorer:              facadeArgs.MempoolExplorer,
+		GasPriceRecommender:          facadeArgs.GasPriceRecommender,
+		TransactionBuilder:           facadeArgs.TransactionBuilder,
+		AddressWatcher:               facadeArgs.AddressWatcher,
+		UsernameResolver:             facadeArgs.UsernameResolver,
+		TokenProfileProcessor:        facadeArgs.TokenProfileProcessor,
+		StakingPositionProcessor:     facadeArgs.StakingPositionProcessor,
+		AddressUtilsProcessor:        facadeArgs.AddressUtilsProcessor,
+		NetworkConfigProvider:        facadeArgs.NetworkConfigProvider,
+		TransactionWaiter:            facadeArgs.TransactionWaiter,
+		TransactionsBatchProcessor:   facadeArgs.TransactionsBatchProcessor,
 	}
 
 	commonFacade, err := createVersionedFacade(v_nextHandlerArgs)
@@ -208,5 +262,23 @@ func createVersionedFacade(args FacadeArgs) (data.FacadeHandler, error) {
 		args.ESDTSuppliesProcessor,
 		args.StatusProcessor,
 		args.AboutInfoProcessor,
+		args.NonceProcessor,
+		args.TransactionValidator,
+		args.DataFieldDecoder,
+		args.DatabaseConnector,
+		args.TransactionsTracker,
+		args.FeeComputer,
+		args.TransactionsDeduplicator,
+		args.MempoolExplorer,
+		args.GasPriceRecommender,
+		args.TransactionBuilder,
+		args.AddressWatcher,
+		args.UsernameResolver,
+		args.TokenProfileProcessor,
+		args.StakingPositionProcessor,
+		args.AddressUtilsProcessor,
+		args.NetworkConfigProvider,
+		args.TransactionWaiter,
+		args.TransactionsBatchProcessor,
 	)
 }